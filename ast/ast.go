@@ -98,6 +98,17 @@ type (
 		Comparator string    `json:"comparator"`
 		Field      FieldExpr `json:"field"`
 		Value      Literal   `json:"value"`
+		// ValueField is the field named by Value when Value is a bare
+		// word on the right-hand side of a relative comparison, e.g.,
+		// "orig_bytes > resp_bytes".  Field is compared with ValueField
+		// in the records that have it and with Value in the others.
+		ValueField FieldExpr `json:"value_field,omitempty"`
+	}
+	// An Evaluate node represents a filter that matches the records for
+	// which an expression, e.g., "orig_bytes > resp_bytes", is true.
+	Evaluate struct {
+		Node
+		Expr Expression `json:"expr"`
 	}
 )
 
@@ -111,6 +122,7 @@ func (*LogicalNot) booleanExprNode()   {}
 func (*MatchAll) booleanExprNode()     {}
 func (*CompareAny) booleanExprNode()   {}
 func (*CompareField) booleanExprNode() {}
+func (*Evaluate) booleanExprNode()     {}
 
 // A FieldExpr is any expression that refers to a field.
 type (
@@ -165,6 +177,39 @@ func (*ConditionalExpression) exprNode() {}
 func (*FunctionCall) exprNode()          {}
 func (*Literal) exprNode()               {}
 func (*FieldRead) exprNode()             {}
+func (*FieldCall) exprNode()             {}
+
+// An ExpressionAssignment is an expression whose value is given the field
+// name Target, e.g., a groupby key of the form "name=expression".  When the
+// expression is a field expression, Target may be empty, in which case
+// the name is derived from the field.
+type ExpressionAssignment struct {
+	Target string     `json:"target,omitempty"`
+	Expr   Expression `json:"expression"`
+}
+
+// UnmarshalJSON unmarshals either an expression assignment or, as in the
+// keys of a groupby before keys could be expressions, a bare field
+// expression.  As with any node whose fields are interfaces, Expr must be
+// populated with the node to unmarshal into, e.g., by UnpackProc.
+func (e *ExpressionAssignment) UnmarshalJSON(b []byte) error {
+	var node Node
+	if err := json.Unmarshal(b, &node); err != nil {
+		return err
+	}
+	if node.Op != "" {
+		return json.Unmarshal(b, e.Expr)
+	}
+	type assignment ExpressionAssignment
+	return json.Unmarshal(b, (*assignment)(e))
+}
+
+// A FieldAssignment assigns the value of the field Source to the field
+// Target.
+type FieldAssignment struct {
+	Target FieldExpr `json:"target"`
+	Source FieldExpr `json:"source"`
+}
 
 // ----------------------------------------------------------------------------
 // Procs
@@ -187,13 +232,17 @@ type (
 		Node
 		Procs []Proc `json:"procs"`
 	}
-	// A SortProc node represents a proc that sorts records.
+	// A SortProc node represents a proc that sorts records.  If Head is
+	// non-zero, only the first Head records of the sorted output are
+	// needed, e.g., because a head proc follows the sort, and the sort
+	// may discard the others as it reads its input.
 	SortProc struct {
 		Node
 		Limit      int         `json:"limit,omitempty"`
 		Fields     []FieldExpr `json:"fields"`
 		SortDir    int         `json:"sortdir"`
 		NullsFirst bool        `json:"nullsfirst"`
+		Head       int         `json:"head,omitempty"`
 	}
 	// A CutProc node represents a proc that removes fields from each
 	// input record where each removed field matches one of the named fields
@@ -202,6 +251,19 @@ type (
 		Node
 		Fields []FieldExpr `json:"fields"`
 	}
+	// A DropProc node represents a proc that removes the fields matching
+	// any of the named fields, which may contain glob wildcards, from each
+	// input record.
+	DropProc struct {
+		Node
+		Fields []FieldExpr `json:"fields"`
+	}
+	// A RenameProc node represents a proc that renames fields of each
+	// input record, which may move them into or out of nested records.
+	RenameProc struct {
+		Node
+		Fields []FieldAssignment `json:"fields"`
+	}
 	// A HeadProc node represents a proc that forwards the indicated number
 	// of records then terminates.
 	HeadProc struct {
@@ -252,13 +314,21 @@ type (
 	// large time ranges are processed and streamed efficiently.
 	// The limit parameter specifies the number of different groups that can
 	// be aggregated over. When absent, the runtime defaults to an appropriate value.
+	// If emit_part is set, the proc outputs the partial result of each
+	// reducer in place of its result, and if consume_part is set, the proc
+	// combines the partial results in its input in place of applying the
+	// reducers to the records, so that an aggregation can be decomposed
+	// into groupbys over pieces of the input and a groupby that combines
+	// their results.
 	GroupByProc struct {
 		Node
-		Duration       Duration    `json:"duration"`
-		UpdateInterval Duration    `json:"update_interval"`
-		Limit          int         `json:"limit,omitempty"`
-		Keys           []FieldExpr `json:"keys"`
-		Reducers       []Reducer   `json:"reducers"`
+		Duration       Duration               `json:"duration"`
+		UpdateInterval Duration               `json:"update_interval"`
+		Limit          int                    `json:"limit,omitempty"`
+		Keys           []ExpressionAssignment `json:"keys"`
+		Reducers       []Reducer              `json:"reducers"`
+		ConsumePart    bool                   `json:"consume_part,omitempty"`
+		EmitPart       bool                   `json:"emit_part,omitempty"`
 	}
 	// TopProc is similar to proc.SortProc with a few key differences:
 	// - It only sorts in descending order.
@@ -277,6 +347,19 @@ type (
 		Target string     `json:"target"`
 		Expr   Expression `json:"expression"`
 	}
+	// A JoinProc node represents a proc that combines the records of the
+	// two parallel inputs that precede it.  Each record from the left input
+	// whose LeftKey matches the RightKey of a record from the right input
+	// is extended with the listed Fields of that right record, or all of its
+	// fields when Fields is empty.  Kind is either "inner", which drops left
+	// records without a match, or "left", which passes them through unchanged.
+	JoinProc struct {
+		Node
+		Kind     string      `json:"kind"`
+		LeftKey  FieldExpr   `json:"left_key"`
+		RightKey FieldExpr   `json:"right_key"`
+		Fields   []FieldExpr `json:"fields,omitempty"`
+	}
 )
 
 //XXX TBD: chance to nano.Duration
@@ -303,6 +386,8 @@ func (*SequentialProc) ProcNode() {}
 func (*ParallelProc) ProcNode()   {}
 func (*SortProc) ProcNode()       {}
 func (*CutProc) ProcNode()        {}
+func (*DropProc) ProcNode()       {}
+func (*RenameProc) ProcNode()     {}
 func (*HeadProc) ProcNode()       {}
 func (*TailProc) ProcNode()       {}
 func (*PassProc) ProcNode()       {}
@@ -312,13 +397,19 @@ func (*ReducerProc) ProcNode()    {}
 func (*GroupByProc) ProcNode()    {}
 func (*TopProc) ProcNode()        {}
 func (*PutProc) ProcNode()        {}
+func (*JoinProc) ProcNode()       {}
 
 // A Reducer is an AST node that represents any of the boom reducers.  The Op
 // parameter indicates the specific reducer while the Field parameter indicates
 // which field of the incoming records should be operated upon by the reducer.
-// The result is given the field name specified by the Var parameter.
+// Alternatively, the reducer operates on the value of the Expr parameter,
+// which is any expression computed from the incoming records.  The result is
+// given the field name specified by the Var parameter.  Param is a numeric
+// argument for reducers that take one, e.g., the quantile to compute.
 type Reducer struct {
 	Node
-	Var   string    `json:"var"`
-	Field FieldExpr `json:"field,omitempty"`
+	Var   string     `json:"var"`
+	Field FieldExpr  `json:"field,omitempty"`
+	Expr  Expression `json:"expr,omitempty"`
+	Param float64    `json:"param,omitempty"`
 }
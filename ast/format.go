@@ -0,0 +1,331 @@
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatProc returns the ZQL source of the query whose flowgraph is rooted
+// at p.  The PassProc, which has no ZQL syntax, is written as "pass".
+func FormatProc(p Proc) string {
+	return formatProc(p, true)
+}
+
+// formatProc returns the ZQL source of p where top is true if p is at the
+// start of the query, where a filter is written as a bare search.
+func formatProc(p Proc, top bool) string {
+	switch p := p.(type) {
+	case *SequentialProc:
+		procs := make([]string, 0, len(p.Procs))
+		for k, child := range p.Procs {
+			s := formatProc(child, top && k == 0)
+			if _, ok := child.(*SequentialProc); ok {
+				s = "(" + s + ")"
+			}
+			procs = append(procs, s)
+		}
+		return strings.Join(procs, " | ")
+	case *ParallelProc:
+		procs := make([]string, 0, len(p.Procs))
+		for _, child := range p.Procs {
+			procs = append(procs, formatProc(child, false))
+		}
+		return "(" + strings.Join(procs, "; ") + ")"
+	case *SortProc:
+		s := "sort"
+		if p.Limit != 0 {
+			s += fmt.Sprintf(" -limit %d", p.Limit)
+		}
+		if p.SortDir < 0 {
+			s += " -r"
+		}
+		if p.NullsFirst {
+			s += " -nulls first"
+		}
+		if len(p.Fields) > 0 {
+			s += " " + formatFields(p.Fields)
+		}
+		return s
+	case *CutProc:
+		return "cut " + formatFields(p.Fields)
+	case *DropProc:
+		return "drop " + formatFields(p.Fields)
+	case *RenameProc:
+		fields := make([]string, 0, len(p.Fields))
+		for _, fa := range p.Fields {
+			fields = append(fields, FormatField(fa.Target)+"="+FormatField(fa.Source))
+		}
+		return "rename " + strings.Join(fields, ",")
+	case *HeadProc:
+		return fmt.Sprintf("head %d", p.Count)
+	case *TailProc:
+		return fmt.Sprintf("tail %d", p.Count)
+	case *FilterProc:
+		if top {
+			return FormatFilter(p.Filter)
+		}
+		return "filter " + FormatFilter(p.Filter)
+	case *PassProc:
+		return "pass"
+	case *UniqProc:
+		if p.Cflag {
+			return "uniq -c"
+		}
+		return "uniq"
+	case *ReducerProc:
+		return formatReducers(p.Reducers)
+	case *GroupByProc:
+		var s string
+		if p.Duration.Seconds != 0 {
+			s = fmt.Sprintf("every %ds ", p.Duration.Seconds)
+		}
+		s += formatReducers(p.Reducers)
+		if len(p.Keys) > 0 {
+			keys := make([]string, 0, len(p.Keys))
+			for _, key := range p.Keys {
+				k := FormatExpr(key.Expr)
+				if key.Target != "" {
+					k = key.Target + "=" + k
+				}
+				keys = append(keys, k)
+			}
+			s += " by " + strings.Join(keys, ",")
+		}
+		if p.Limit != 0 {
+			s += fmt.Sprintf(" -limit %d", p.Limit)
+		}
+		return s
+	case *TopProc:
+		s := "top"
+		if p.Limit != 0 {
+			s += fmt.Sprintf(" %d", p.Limit)
+		}
+		if p.Flush {
+			s += " -flush"
+		}
+		if len(p.Fields) > 0 {
+			s += " " + formatFields(p.Fields)
+		}
+		return s
+	case *PutProc:
+		return "put " + p.Target + "=" + FormatExpr(p.Expr)
+	case *JoinProc:
+		s := "join"
+		if p.Kind != "" {
+			s += " -" + p.Kind
+		}
+		s += " " + FormatField(p.LeftKey) + "=" + FormatField(p.RightKey)
+		if len(p.Fields) > 0 {
+			s += " " + formatFields(p.Fields)
+		}
+		return s
+	}
+	return fmt.Sprintf("%T", p)
+}
+
+func formatFields(fields []FieldExpr) string {
+	s := make([]string, 0, len(fields))
+	for _, f := range fields {
+		s = append(s, FormatField(f))
+	}
+	return strings.Join(s, ",")
+}
+
+func formatReducers(reducers []Reducer) string {
+	s := make([]string, 0, len(reducers))
+	for _, r := range reducers {
+		s = append(s, FormatReducer(r))
+	}
+	return strings.Join(s, ",")
+}
+
+// FormatReducer returns the ZQL source of the reducer r, which is
+// prefixed with the name of its output field when that differs from the
+// reducer's default.
+func FormatReducer(r Reducer) string {
+	name := strings.ToLower(r.Op)
+	var arg string
+	if r.Field != nil {
+		arg = FormatField(r.Field)
+	} else if r.Expr != nil {
+		arg = FormatExpr(r.Expr)
+	}
+	if r.Param != 0 {
+		arg += ", " + strconv.FormatFloat(r.Param, 'g', -1, 64)
+	}
+	s := name + "(" + arg + ")"
+	if r.Var != "" && r.Var != name {
+		s = r.Var + "=" + s
+	}
+	return s
+}
+
+// FormatField returns the ZQL source of the field expression e.
+func FormatField(e FieldExpr) string {
+	switch e := e.(type) {
+	case *FieldRead:
+		return e.Field
+	case *FieldCall:
+		switch e.Fn {
+		case "RecordFieldRead":
+			return FormatField(e.Field) + "." + e.Param
+		case "Index":
+			return FormatField(e.Field) + "[" + e.Param + "]"
+		}
+		return strings.ToLower(e.Fn) + "(" + FormatField(e.Field) + ")"
+	}
+	return fmt.Sprintf("%T", e)
+}
+
+// FormatFilter returns the ZQL source of the search expression e.
+func FormatFilter(e BooleanExpr) string {
+	switch e := e.(type) {
+	case *Search:
+		return e.Text
+	case *MatchAll:
+		return "*"
+	case *CompareAny:
+		any := "*"
+		if e.Recursive {
+			any = "**"
+		}
+		if e.Comparator == "in" {
+			return formatLiteral(e.Value) + " in " + any
+		}
+		return any + e.Comparator + formatLiteral(e.Value)
+	case *CompareField:
+		if e.Comparator == "in" {
+			return formatLiteral(e.Value) + " in " + FormatField(e.Field)
+		}
+		if e.ValueField != nil {
+			return FormatField(e.Field) + e.Comparator + e.Value.Value
+		}
+		return FormatField(e.Field) + e.Comparator + formatLiteral(e.Value)
+	case *LogicalAnd:
+		return formatFilterOperand(e.Left) + " " + formatFilterOperand(e.Right)
+	case *LogicalOr:
+		return FormatFilter(e.Left) + " or " + FormatFilter(e.Right)
+	case *LogicalNot:
+		return "not " + formatFilterOperand(e.Expr)
+	case *Evaluate:
+		// A bare field on the right of a comparison in a search
+		// would be parsed as a string, so it's parenthesized.
+		if b, ok := e.Expr.(*BinaryExpression); ok && isField(b.RHS) {
+			op := strings.ToLower(b.Operator)
+			if p := precedence[op]; p == 3 || p == 4 {
+				return formatExpr(b.LHS, p) + " " + op + " (" + FormatExpr(b.RHS) + ")"
+			}
+		}
+		return FormatExpr(e.Expr)
+	}
+	return fmt.Sprintf("%T", e)
+}
+
+// isField returns true if e is a field or a dereference of one.
+func isField(e Expression) bool {
+	switch e := e.(type) {
+	case *FieldRead, *FieldCall:
+		return true
+	case *BinaryExpression:
+		return (e.Operator == "." || e.Operator == "[") && isField(e.LHS)
+	}
+	return false
+}
+
+// formatFilterOperand returns the source of e parenthesized if e is an or
+// expression, which binds less tightly than and and not.
+func formatFilterOperand(e BooleanExpr) string {
+	if _, ok := e.(*LogicalOr); ok {
+		return "(" + FormatFilter(e) + ")"
+	}
+	return FormatFilter(e)
+}
+
+func formatLiteral(l Literal) string {
+	switch l.Type {
+	case "string":
+		return strconv.Quote(l.Value)
+	case "regexp":
+		return "/" + l.Value + "/"
+	case "port":
+		return ":" + l.Value
+	case "null":
+		return "null"
+	}
+	return l.Value
+}
+
+// The precedence of the expression operators, where the operators "." and
+// "[" dereference a record field and an array element.
+var precedence = map[string]int{
+	"or":  1,
+	"and": 2,
+	"=":   3,
+	"!=":  3,
+	"in":  3,
+	"<":   4,
+	"<=":  4,
+	">":   4,
+	">=":  4,
+	"+":   5,
+	"-":   5,
+	"*":   6,
+	"/":   6,
+	".":   8,
+	"[":   8,
+}
+
+const unaryPrecedence = 7
+
+// FormatExpr returns the ZQL source of the expression e.
+func FormatExpr(e Expression) string {
+	return formatExpr(e, 0)
+}
+
+// formatExpr returns the source of e, which is parenthesized if its
+// operator binds less tightly than prec.
+func formatExpr(e Expression, prec int) string {
+	var s string
+	var p int
+	switch e := e.(type) {
+	case *Literal:
+		return formatLiteral(*e)
+	case *FieldRead, *FieldCall:
+		return FormatField(e)
+	case *FunctionCall:
+		args := make([]string, 0, len(e.Args))
+		for _, arg := range e.Args {
+			args = append(args, FormatExpr(arg))
+		}
+		return e.Function + "(" + strings.Join(args, ", ") + ")"
+	case *UnaryExpression:
+		s = e.Operator + formatExpr(e.Operand, unaryPrecedence)
+		p = unaryPrecedence
+	case *BinaryExpression:
+		op := strings.ToLower(e.Operator)
+		p = precedence[op]
+		lhs := formatExpr(e.LHS, p)
+		switch op {
+		case ".":
+			if l, ok := e.RHS.(*Literal); ok {
+				return lhs + "." + l.Value
+			}
+			s = lhs + "." + formatExpr(e.RHS, p+1)
+		case "[":
+			return lhs + "[" + FormatExpr(e.RHS) + "]"
+		default:
+			// The operators are left associative so an operand
+			// on the right of the same precedence needs parens.
+			s = lhs + " " + op + " " + formatExpr(e.RHS, p+1)
+		}
+	case *ConditionalExpression:
+		s = formatExpr(e.Condition, 1) + " ? " + FormatExpr(e.Then) + " : " + FormatExpr(e.Else)
+	default:
+		return fmt.Sprintf("%T", e)
+	}
+	if p < prec {
+		return "(" + s + ")"
+	}
+	return s
+}
@@ -72,6 +72,18 @@ func unpackProc(custom Unpacker, node joe.JSON) (Proc, error) {
 			return nil, err
 		}
 		return &CutProc{Fields: fields}, nil
+	case "DropProc":
+		fields, err := unpackFieldExprArray(node.Get("fields"))
+		if err != nil {
+			return nil, err
+		}
+		return &DropProc{Fields: fields}, nil
+	case "RenameProc":
+		fields, err := unpackFieldAssignments(node.Get("fields"))
+		if err != nil {
+			return nil, err
+		}
+		return &RenameProc{Fields: fields}, nil
 	case "HeadProc":
 		return &HeadProc{}, nil
 	case "TailProc":
@@ -101,7 +113,7 @@ func unpackProc(custom Unpacker, node joe.JSON) (Proc, error) {
 		}
 		return &ReducerProc{Reducers: reducers}, nil
 	case "GroupByProc":
-		keys, err := unpackFieldExprArray(node.Get("keys"))
+		keys, err := unpackExpressionAssignments(node.Get("keys"))
 		if err != nil {
 			return nil, err
 		}
@@ -116,6 +128,20 @@ func unpackProc(custom Unpacker, node joe.JSON) (Proc, error) {
 			return nil, err
 		}
 		return &TopProc{Fields: fields}, nil
+	case "JoinProc":
+		leftKey, err := unpackFieldExpr(node.Get("left_key"))
+		if err != nil {
+			return nil, err
+		}
+		rightKey, err := unpackFieldExpr(node.Get("right_key"))
+		if err != nil {
+			return nil, err
+		}
+		fields, err := unpackFieldExprArray(node.Get("fields"))
+		if err != nil {
+			return nil, err
+		}
+		return &JoinProc{LeftKey: leftKey, RightKey: rightKey, Fields: fields}, nil
 	default:
 		return nil, fmt.Errorf("unknown proc op: %s", op)
 	}
@@ -212,6 +238,12 @@ func unpackExpression(node joe.JSON) (Expression, error) {
 		return &Literal{}, nil
 	case "FieldRead":
 		return &FieldRead{}, nil
+	case "FieldCall":
+		field, err := unpackFieldExpr(node)
+		if err != nil {
+			return nil, err
+		}
+		return field.(*FieldCall), nil
 	default:
 		return nil, fmt.Errorf("unknown Expression op %s", op)
 	}
@@ -276,7 +308,24 @@ func unpackBooleanExpr(node joe.JSON) (BooleanExpr, error) {
 		if err != nil {
 			return nil, err
 		}
-		return &CompareField{Field: field}, nil
+		var valueField FieldExpr
+		if child := node.Get("value_field"); child != joe.Undefined {
+			valueField, err = unpackFieldExpr(child)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &CompareField{Field: field, ValueField: valueField}, nil
+	case "Evaluate":
+		child := node.Get("expr")
+		if child == joe.Undefined {
+			return nil, errors.New("Evaluate missing expr property")
+		}
+		expr, err := unpackExpression(child)
+		if err != nil {
+			return nil, err
+		}
+		return &Evaluate{Expr: expr}, nil
 
 	default:
 		return nil, fmt.Errorf("unknown op: %s", op)
@@ -335,17 +384,72 @@ func unpackReducers(node joe.JSON) ([]Reducer, error) {
 	n := node.Len()
 	reducers := make([]Reducer, n)
 	for k := 0; k < n; k++ {
-		fld := node.Index(k).Get("field")
-		if fld == joe.Undefined {
-			continue
+		var err error
+		if fld := node.Index(k).Get("field"); fld != joe.Undefined {
+			reducers[k].Field, err = unpackFieldExpr(fld)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if e := node.Index(k).Get("expr"); e != joe.Undefined {
+			reducers[k].Expr, err = unpackExpression(e)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return reducers, nil
+}
+
+func unpackFieldAssignments(node joe.JSON) ([]FieldAssignment, error) {
+	if node == joe.Undefined {
+		return nil, nil
+	}
+	if !node.IsArray() {
+		return nil, errors.New("array of field assignments expected")
+	}
+	n := node.Len()
+	assignments := make([]FieldAssignment, n)
+	for k := 0; k < n; k++ {
+		var err error
+		assignments[k].Target, err = unpackFieldExpr(node.Index(k).Get("target"))
+		if err != nil {
+			return nil, err
+		}
+		assignments[k].Source, err = unpackFieldExpr(node.Index(k).Get("source"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return assignments, nil
+}
+
+func unpackExpressionAssignments(node joe.JSON) ([]ExpressionAssignment, error) {
+	if node == joe.Undefined {
+		return nil, nil
+	}
+	if !node.IsArray() {
+		return nil, errors.New("array of expression assignments expected")
+	}
+	n := node.Len()
+	assignments := make([]ExpressionAssignment, n)
+	for k := 0; k < n; k++ {
+		e := node.Index(k).Get("expression")
+		if e == joe.Undefined {
+			// A bare field expression is a key of a groupby from
+			// before keys could be expressions.
+			if _, ok := node.Index(k).Get("op").String(); !ok {
+				return nil, errors.New("expression assignment missing expression")
+			}
+			e = node.Index(k)
 		}
 		var err error
-		reducers[k].Field, err = unpackFieldExpr(fld)
+		assignments[k].Expr, err = unpackExpression(e)
 		if err != nil {
 			return nil, err
 		}
 	}
-	return reducers, nil
+	return assignments, nil
 }
 
 // UnpackProc transforms a JSON representation of a proc into an ast.Proc.
@@ -0,0 +1,408 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/brimsec/zq/driver"
+	"github.com/brimsec/zq/emitter"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/scanner"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/bzngio"
+	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zql"
+	"github.com/peterh/liner"
+	"go.uber.org/zap"
+)
+
+// replStreamRecords is the number of records in each stream of the bzng
+// file holding the input when -b is not given.  Each stream is an entry
+// in the time index used by queries with a \span.
+const replStreamRecords = 5000
+
+const replHelp = `Enter a ZQL query to run it over the input, or one of these commands:
+
+\format [fmt]         show or set the output format (default "table")
+\span [start end]     show or limit the time span of queries, where start and
+                      end are epoch seconds or RFC 3339 times
+\span all             remove the limit on the time span of queries
+\fields               list the field names of the input
+\help                 show this help
+\quit                 exit
+
+Press tab to complete field names.  Press Ctrl-C to stop a running query.
+`
+
+var replCommands = []string{`\fields`, `\format`, `\help`, `\quit`, `\span`}
+
+// A repl runs queries typed by the user over input that is loaded once
+// into a temporary bzng file.
+type repl struct {
+	c           *Command
+	path        string
+	index       bzngio.TimeIndex
+	sorted      bool
+	span        nano.Span
+	format      string
+	interactive bool
+}
+
+func (c *Command) runREPL(paths []string) error {
+	readers, err := c.inputReaders(context.Background(), paths)
+	if err != nil {
+		return err
+	}
+	reader := scanner.NewCombiner(readers)
+	defer reader.Close()
+	f, err := ioutil.TempFile("", "zq-repl-*.bzng")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	r := &repl{
+		c:           c,
+		path:        f.Name(),
+		index:       bzngio.NewTimeIndex(),
+		span:        nano.MaxSpan,
+		format:      "table",
+		interactive: isTerminal(os.Stdin),
+	}
+	n, err := r.load(f, reader)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if r.interactive {
+		fmt.Printf("loaded %d records; type \\help for help\n", n)
+	}
+	return r.loop()
+}
+
+// load writes the records of reader to f and returns their number.
+func (r *repl) load(f *os.File, reader zbuf.Reader) (int, error) {
+	flags := r.c.Flags
+	if flags.StreamRecordsMax == 0 {
+		flags.StreamRecordsMax = replStreamRecords
+	}
+	bw := bufio.NewWriter(f)
+	w := bzngio.NewWriter(bw, flags)
+	r.sorted = true
+	var n int
+	var last nano.Ts
+	for {
+		rec, err := reader.Read()
+		if err != nil {
+			return 0, err
+		}
+		if rec == nil {
+			break
+		}
+		if rec.Ts < last {
+			r.sorted = false
+		}
+		last = rec.Ts
+		if err := w.Write(rec); err != nil {
+			return 0, err
+		}
+		n++
+	}
+	if err := w.Flush(); err != nil {
+		return 0, err
+	}
+	return n, bw.Flush()
+}
+
+func (r *repl) loop() error {
+	rl := liner.NewLiner()
+	defer rl.Close()
+	rl.SetCtrlCAborts(true)
+	rl.SetWordCompleter(r.complete)
+	// Keep the history of interactive sessions only so that queries
+	// piped to zq aren't recorded.
+	var history, prompt string
+	if r.interactive {
+		history = historyPath()
+		prompt = "zq> "
+	}
+	if f, err := os.Open(history); err == nil {
+		rl.ReadHistory(f)
+		f.Close()
+	}
+	for {
+		line, err := rl.Prompt(prompt)
+		if err == liner.ErrPromptAborted {
+			continue
+		}
+		if err == io.EOF {
+			if r.interactive {
+				fmt.Println()
+			}
+			break
+		}
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		rl.AppendHistory(line)
+		if line == `\quit` || line == `\q` {
+			break
+		}
+		if strings.HasPrefix(line, `\`) {
+			err = r.command(line)
+		} else {
+			err = r.run(line)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}
+	if history != "" {
+		if f, err := os.Create(history); err == nil {
+			rl.WriteHistory(f)
+			f.Close()
+		}
+	}
+	return nil
+}
+
+// command runs the meta command in line.
+func (r *repl) command(line string) error {
+	args := strings.Fields(line)
+	switch args[0] {
+	case `\help`:
+		fmt.Print(replHelp)
+	case `\fields`:
+		for _, name := range r.fields() {
+			fmt.Println(name)
+		}
+	case `\format`:
+		if len(args) == 1 {
+			fmt.Println(r.format)
+			return nil
+		}
+		if detector.LookupWriter(args[1], &nopCloser{ioutil.Discard}, nil) == nil {
+			return fmt.Errorf("unknown format: %s", args[1])
+		}
+		r.format = args[1]
+	case `\span`:
+		switch {
+		case len(args) == 1:
+			if r.span == nano.MaxSpan {
+				fmt.Println("all")
+			} else {
+				fmt.Printf("%s %s\n", r.span.Ts.Pretty(), r.span.End().Pretty())
+			}
+		case len(args) == 2 && args[1] == "all":
+			r.span = nano.MaxSpan
+		case len(args) == 3:
+			start, err := parseTime(args[1])
+			if err != nil {
+				return err
+			}
+			end, err := parseTime(args[2])
+			if err != nil {
+				return err
+			}
+			if end < start {
+				return errors.New("end of span precedes its start")
+			}
+			r.span = nano.NewSpanTs(start, end+1)
+		default:
+			return errors.New(`usage: \span [start end | all]`)
+		}
+	default:
+		return fmt.Errorf(`unknown command %s: type \help for help`, args[0])
+	}
+	return nil
+}
+
+func parseTime(s string) (nano.Ts, error) {
+	if ts, err := nano.ParseRFC3339Nano([]byte(s)); err == nil {
+		return ts, nil
+	}
+	ts, err := nano.ParseTs(s)
+	if err != nil {
+		return 0, fmt.Errorf("bad time: %s", s)
+	}
+	return ts, nil
+}
+
+// run runs query over the input and writes its results to standard output.
+// An interrupt stops the query.
+func (r *repl) run(query string) error {
+	program, err := zql.ParseProc(query)
+	if err != nil {
+		return fmt.Errorf("parse error: %s", err)
+	}
+	f, err := os.Open(r.path)
+	if err != nil {
+		return err
+	}
+	// The time index can only locate the span of records in time
+	// order, so otherwise each query scans all of the input.
+	index := &r.index
+	if !r.sorted {
+		unindexed := bzngio.NewTimeIndex()
+		index = &unindexed
+	}
+	reader, err := index.NewReader(f, r.c.zctx, r.span)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	defer reader.Close()
+	flags := r.c.Flags
+	flags.Compression = ""
+	w, err := emitter.NewFile("", r.format, &flags)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	defer signal.Stop(sigs)
+	go func() {
+		select {
+		case <-sigs:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	mux, err := driver.Compile(ctx, program, &cancelReader{ctx, reader}, false, r.span, zap.NewNop())
+	if err != nil {
+		w.Close()
+		return err
+	}
+	d := driver.NewCLI(w)
+	if !r.c.quiet {
+		d.SetWarningsWriter(os.Stderr)
+	}
+	err = driver.Run(mux, d, 0)
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if err == context.Canceled {
+		return errors.New("interrupted")
+	}
+	return err
+}
+
+// cancelReader is a zbuf.Reader that stops reading once its context is
+// canceled.
+type cancelReader struct {
+	ctx context.Context
+	zbuf.Reader
+}
+
+func (c *cancelReader) Read() (*zng.Record, error) {
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.Reader.Read()
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (*nopCloser) Close() error {
+	return nil
+}
+
+// fields returns the sorted names of the fields of the record types in
+// the type context, where the fields of nested records are named with
+// dotted paths.
+func (r *repl) fields() []string {
+	zctx := r.c.zctx
+	var types []*zng.TypeRecord
+	nested := make(map[zng.Type]bool)
+	for id := zng.IdTypeDef; id < zctx.Len(); id++ {
+		if typ := zctx.Lookup(id); typ != nil {
+			types = append(types, typ)
+			for _, col := range typ.Columns {
+				nested[zng.AliasedType(col.Type)] = true
+			}
+		}
+	}
+	names := make(map[string]struct{})
+	for _, typ := range types {
+		// The type of a nested record is also in the context but
+		// its fields are named by the paths of the records holding it.
+		if !nested[typ] {
+			addFields(names, "", typ)
+		}
+	}
+	fields := make([]string, 0, len(names))
+	for name := range names {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func addFields(names map[string]struct{}, prefix string, typ *zng.TypeRecord) {
+	for _, col := range typ.Columns {
+		name := prefix + col.Name
+		names[name] = struct{}{}
+		if inner, ok := zng.AliasedType(col.Type).(*zng.TypeRecord); ok {
+			addFields(names, name+".", inner)
+		}
+	}
+}
+
+// complete is a liner.WordCompleter that completes meta commands at the
+// start of a line and field names elsewhere.
+func (r *repl) complete(line string, pos int) (string, []string, string) {
+	start := pos
+	for start > 0 && isFieldChar(line[start-1]) {
+		start--
+	}
+	word := line[start:pos]
+	candidates := r.fields()
+	if start == 1 && line[0] == '\\' {
+		start, word, candidates = 0, line[:pos], replCommands
+	}
+	var matches []string
+	for _, s := range candidates {
+		if strings.HasPrefix(s, word) {
+			matches = append(matches, s)
+		}
+	}
+	return line[:start], matches, line[pos:]
+}
+
+func isFieldChar(c byte) bool {
+	return c == '_' || c == '.' || c == '$' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// historyPath returns the path of the file holding the history of queries
+// or, if there is no home directory, an empty string.
+func historyPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".zq_history")
+}
@@ -6,17 +6,22 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"os/signal"
 	"regexp"
+	"syscall"
 
 	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/emitter"
+	"github.com/brimsec/zq/filter"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/scanner"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/bzngio"
 	"github.com/brimsec/zq/zio/detector"
 	"github.com/brimsec/zq/zio/ndjsonio"
 	"github.com/brimsec/zq/zng/resolver"
@@ -47,7 +52,10 @@ sent to the indicated file comforming to the type implied by the extension (unle
 
 Supported input formats include zng (.zng), NDJSON (.ndjson), and
 the Zeek log format (.log).  Supported output formats include
-all the input formats along with text and tabular formats.
+all the input formats along with text, tabular, and Parquet formats.
+
+Input files compressed with gzip, bzip2, or zstd are decompressed
+transparently, and output may be compressed with gzip or zstd using -z.
 
 The input file format is inferred from the data.  If multiple files are
 specified, each file format is determined independently so you can mix and
@@ -57,6 +65,31 @@ beginning of stream will determine the format.
 
 The output format is zng by default, but can be overridden with -f.
 
+With -repl, zq loads the input files once and then runs each query entered
+at its prompt over them, writing the results to standard output.  Queries
+are kept in a history, tab completes field names, and commands such as
+\format change the output format; enter \help for the list of commands.
+
+With -explain, zq prints the flowgraph compiled from the query instead of
+running it, and input files need not be given.  Each proc is listed with
+the procs it reads from, including the filter and time span pushed into the
+scanner, the limits of procs such as sort and group-by, and the split and
+merge of parallel branches.
+
+With -P, zq splits each bzng input file into runs of its streams, which
+are decoded and filtered by the given number of goroutines, and merges
+the results in the order of the file.  Only files written with more than
+one stream, e.g., using -b, are split.  When there are multiple input
+files and the query begins with an aggregation such as "count() by
+_path", each file is also aggregated concurrently, and the partial
+results are combined into the final result.
+
+With -follow, zq keeps reading each input file as data is appended to it,
+reopening the file if it is rotated or truncated, and writes results as
+they are computed, e.g., as each interval of an "every" group-by closes.
+zq runs until it is interrupted, at which point the remaining results are
+written.
+
 After the options, the query may be specified as a
 single argument conforming with ZQL syntax; i.e., it should be quoted as
 a single string in the shell.
@@ -93,6 +126,10 @@ type Command struct {
 	quiet          bool
 	showVersion    bool
 	stopErr        bool
+	follow         bool
+	repl           bool
+	explain        bool
+	workers        int
 	zio.Flags
 }
 
@@ -105,8 +142,8 @@ func New(f *flag.FlagSet) (charm.Command, error) {
 	// Flags added for writers are -T, -F, -E, -U, and -b
 	c.Flags.SetFlags(f)
 
-	f.StringVar(&c.ifmt, "i", "auto", "format of input data [auto,bzng,ndjson,zeek,zjson,zng]")
-	f.StringVar(&c.ofmt, "f", "zng", "format for output data [bzng,ndjson,table,text,types,zeek,zjson,zng]")
+	f.StringVar(&c.ifmt, "i", "auto", "format of input data [auto,bzng,csv,ndjson,tsv,zeek,zjson,zng]")
+	f.StringVar(&c.ofmt, "f", "zng", "format for output data [bzng,csv,ndjson,parquet,table,text,tsv,types,zeek,zjson,zng]")
 	f.StringVar(&c.path, "p", cwd, "path for input")
 	f.StringVar(&c.dir, "d", "", "directory for output data files")
 	f.StringVar(&c.outputFile, "o", "", "write data to output file")
@@ -117,6 +154,10 @@ func New(f *flag.FlagSet) (charm.Command, error) {
 	f.BoolVar(&c.quiet, "q", false, "don't display zql warnings")
 	f.BoolVar(&c.stopErr, "e", true, "don't stop upon input errors")
 	f.BoolVar(&c.showVersion, "version", false, "print version and exit")
+	f.BoolVar(&c.follow, "follow", false, "keep reading input files as they grow until interrupted")
+	f.BoolVar(&c.repl, "repl", false, "load the input files and run queries read interactively")
+	f.BoolVar(&c.explain, "explain", false, "print the compiled flowgraph of the query instead of running it")
+	f.IntVar(&c.workers, "P", 1, "number of goroutines decoding and filtering each bzng input file")
 	return c, nil
 }
 
@@ -169,6 +210,12 @@ func (c *Command) Run(args []string) error {
 		}
 		c.jsonTypeConfig = tc
 	}
+	if c.repl {
+		if c.follow {
+			return errors.New("-repl cannot be used with -follow")
+		}
+		return c.runREPL(args)
+	}
 	paths := args
 	var query ast.Proc
 	var err error
@@ -179,7 +226,7 @@ func (c *Command) Run(args []string) error {
 		}
 	} else {
 		paths = args[1:]
-		if len(paths) == 0 {
+		if len(paths) == 0 && !c.explain {
 			return fmt.Errorf("file not found: %s", args[0])
 		}
 		query, err = zql.ParseProc(args[0])
@@ -187,6 +234,14 @@ func (c *Command) Run(args []string) error {
 			return fmt.Errorf("parse error: %s", err)
 		}
 	}
+	if c.explain {
+		plan, err := driver.Explain(query, false, nano.MaxSpan, c.workers)
+		if err != nil {
+			return err
+		}
+		fmt.Print(plan)
+		return nil
+	}
 	if c.ofmt == "types" {
 		logger, err := emitter.NewTypeLogger(c.outputFile, c.verbose)
 		if err != nil {
@@ -197,7 +252,23 @@ func (c *Command) Run(args []string) error {
 		defer logger.Close()
 	}
 
-	readers, err := c.inputReaders(paths)
+	ctx := context.Background()
+	if c.follow {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		// Stop following the input upon interrupt so that the
+		// remaining results are written.  A second interrupt
+		// terminates zq.
+		sigs := make(chan os.Signal, 1)
+		signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-sigs
+			signal.Stop(sigs)
+			cancel()
+		}()
+	}
+	readers, err := c.inputReaders(ctx, paths)
 	if err != nil {
 		return err
 	}
@@ -208,7 +279,12 @@ func (c *Command) Run(args []string) error {
 			readers[i] = scanner.WarningReader(r, wch)
 		}
 	}
-	reader := scanner.NewCombiner(readers)
+	var reader zbuf.ReadCloser
+	if c.follow {
+		reader = scanner.NewLive(readers)
+	} else {
+		reader = scanner.NewCombiner(readers)
+	}
 	defer reader.Close()
 
 	writer, err := c.openOutput()
@@ -216,11 +292,18 @@ func (c *Command) Run(args []string) error {
 		return err
 	}
 	defer writer.Close()
-	mux, err := driver.CompileWarningsCh(context.Background(), query, reader, false, nano.MaxSpan, zap.NewNop(), wch)
+	var mux *driver.MuxOutput
+	if c.workers > 1 && !c.follow {
+		// The readers are closed by the combiner.
+		mux, err = driver.CompileReaders(ctx, query, readers, false, nano.MaxSpan, zap.NewNop(), wch)
+	} else {
+		mux, err = driver.CompileWarningsCh(ctx, query, reader, false, nano.MaxSpan, zap.NewNop(), wch)
+	}
 	if err != nil {
 		return err
 	}
 	d := driver.NewCLI(writer)
+	d.SetAutoFlush(c.follow)
 	if !c.quiet {
 		d.SetWarningsWriter(os.Stderr)
 	}
@@ -246,22 +329,51 @@ func (c *Command) errorf(format string, args ...interface{}) {
 
 type namedReader struct {
 	zbuf.Reader
-	name string
+	name   string
+	closer io.Closer
 }
 
 func (r namedReader) String() string {
 	return r.name
 }
 
-func (c *Command) inputReaders(paths []string) ([]zbuf.Reader, error) {
+func (r namedReader) Pushdown(f filter.Filter, span nano.Span) bool {
+	p, ok := r.Reader.(scanner.Pushdown)
+	return ok && p.Pushdown(f, span)
+}
+
+func (r namedReader) Close() error {
+	var err error
+	if closer, ok := r.Reader.(io.Closer); ok {
+		err = closer.Close()
+	}
+	if r.closer != nil {
+		if e := r.closer.Close(); err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// inputFile is the decompressed data of an input file.
+type inputFile struct {
+	io.ReadCloser
+	file *os.File
+}
+
+func (f inputFile) Close() error {
+	f.ReadCloser.Close()
+	return f.file.Close()
+}
+
+func (c *Command) inputReaders(ctx context.Context, paths []string) ([]zbuf.Reader, error) {
 	var readers []zbuf.Reader
 	for _, path := range paths {
-		var zr zbuf.Reader
-		var f *os.File
+		var r io.ReadCloser
+		var tail *scanner.Tail
 		if path == "-" {
-			f = os.Stdin
+			r = detector.DecompressReader(os.Stdin)
 		} else {
-			var err error
 			info, err := os.Stat(path)
 			if err != nil {
 				return nil, err
@@ -269,19 +381,40 @@ func (c *Command) inputReaders(paths []string) ([]zbuf.Reader, error) {
 			if info.IsDir() {
 				return nil, errors.New("is a directory")
 			}
-			f, err = os.Open(path)
-			if err != nil {
-				return nil, err
+			if c.follow {
+				if tail, err = scanner.OpenTail(ctx, path); err != nil {
+					return nil, err
+				}
+			} else if c.workers > 1 && c.isBzng(path) {
+				p, err := scanner.OpenParallel(c.zctx, path, c.workers)
+				if err != nil {
+					return nil, err
+				}
+				readers = append(readers, namedReader{p, path, nil})
+				continue
+			} else {
+				f, err := os.Open(path)
+				if err != nil {
+					return nil, err
+				}
+				r = inputFile{detector.DecompressReader(f), f}
 			}
 		}
-		r := detector.GzipReader(f)
+		var zr zbuf.Reader
 		var err error
-		if c.ifmt == "auto" {
-			zr, err = detector.NewReader(r, c.zctx)
+		if tail != nil {
+			// Since a new file replacing the one being followed
+			// begins anew, read each with a new reader.
+			zr, err = scanner.NewFollower(tail, func(r io.Reader) (zbuf.Reader, error) {
+				return c.newReader(r, path)
+			})
 		} else {
-			zr, err = detector.LookupReader(c.ifmt, r, c.zctx)
+			zr, err = c.newReader(r, path)
 		}
 		if err != nil {
+			if r != nil {
+				r.Close()
+			}
 			err = fmt.Errorf("%s: %w", path, err)
 			if c.stopErr {
 				return nil, err
@@ -289,17 +422,58 @@ func (c *Command) inputReaders(paths []string) ([]zbuf.Reader, error) {
 			c.errorf("%s\n", err)
 			continue
 		}
-		jr, ok := zr.(*ndjsonio.Reader)
-		if ok && c.jsonTypeConfig != nil {
-			if err = c.configureJSONTypeReader(jr, path); err != nil {
-				return nil, err
-			}
-		}
-		readers = append(readers, namedReader{zr, path})
+		readers = append(readers, namedReader{zr, path, r})
 	}
 	return readers, nil
 }
 
+// isBzng returns true if the file at path holds bzng data without any
+// compression, which can be split into streams to read in parallel.
+func (c *Command) isBzng(path string) bool {
+	if c.ifmt != "auto" && c.ifmt != "bzng" {
+		return false
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	var zr zbuf.Reader = bzngio.NewReader(f, resolver.NewContext())
+	if c.ifmt == "auto" {
+		if zr, err = detector.NewReader(f, resolver.NewContext()); err != nil {
+			return false
+		}
+	}
+	if _, ok := zr.(*bzngio.Reader); !ok {
+		return false
+	}
+	// Compressed data fails to read as bzng.
+	_, err = zr.Read()
+	return err == nil
+}
+
+// newReader returns a reader of the input format of r, which is read from
+// path.
+func (c *Command) newReader(r io.Reader, path string) (zbuf.Reader, error) {
+	var zr zbuf.Reader
+	var err error
+	if c.ifmt == "auto" {
+		zr, err = detector.NewReader(r, c.zctx)
+	} else {
+		zr, err = detector.LookupReader(c.ifmt, r, c.zctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	jr, ok := zr.(*ndjsonio.Reader)
+	if ok && c.jsonTypeConfig != nil {
+		if err = c.configureJSONTypeReader(jr, path); err != nil {
+			return nil, err
+		}
+	}
+	return zr, nil
+}
+
 func (c *Command) openOutput() (zbuf.WriteCloser, error) {
 	if c.dir != "" {
 		d, err := emitter.NewDir(c.dir, c.outputFile, c.ofmt, os.Stderr, &c.Flags)
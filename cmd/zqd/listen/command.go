@@ -71,6 +71,7 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.BoolVar(&c.pprof, "pprof", false, "add pprof routes to api")
 	f.StringVar(&c.configfile, "config", "", "path to a zqd config file")
 	f.BoolVar(&c.devMode, "dev", false, "runs zqd in development mode")
+	f.IntVar(&c.conf.Workers, "workers", 1, "number of goroutines that decode and filter the data of each search")
 	return c, nil
 }
 
@@ -23,12 +23,7 @@ func Compile(ctx context.Context, program ast.Proc, reader zbuf.Reader, reverse
 }
 
 func CompileWarningsCh(ctx context.Context, program ast.Proc, reader zbuf.Reader, reverse bool, span nano.Span, logger *zap.Logger, ch chan string) (*MuxOutput, error) {
-
-	filterAst, program := liftFilter(program)
-	input, err := inputProc(reader, filterAst, span)
-	if err != nil {
-		return nil, err
-	}
+	filterAst, program := liftFilter(Optimize(program))
 	pctx := &proc.Context{
 		Context:     ctx,
 		TypeContext: resolver.NewContext(),
@@ -36,6 +31,10 @@ func CompileWarningsCh(ctx context.Context, program ast.Proc, reader zbuf.Reader
 		Reverse:     reverse,
 		Warnings:    ch,
 	}
+	input, err := inputProc(pctx.TypeContext, reader, filterAst, span)
+	if err != nil {
+		return nil, err
+	}
 	leaves, err := proc.CompileProc(nil, program, pctx, input)
 	if err != nil {
 		return nil, err
@@ -69,12 +68,13 @@ func liftFilter(p ast.Proc) (*ast.FilterProc, ast.Proc) {
 
 // inputProc takes a Reader, optional Filter AST, and timespan, and
 // constructs an input proc that can be used as the head of a
+// flowgraph.  The filter is compiled in zctx, the type context of the
 // flowgraph.
-func inputProc(reader zbuf.Reader, fltast *ast.FilterProc, span nano.Span) (proc.Proc, error) {
+func inputProc(zctx *resolver.Context, reader zbuf.Reader, fltast *ast.FilterProc, span nano.Span) (proc.Proc, error) {
 	var f filter.Filter
 	if fltast != nil {
 		var err error
-		if f, err = filter.Compile(fltast.Filter); err != nil {
+		if f, err = filter.Compile(zctx, fltast.Filter); err != nil {
 			return nil, err
 		}
 	}
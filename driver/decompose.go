@@ -0,0 +1,111 @@
+package driver
+
+import (
+	"context"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/scanner"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng/resolver"
+	"go.uber.org/zap"
+)
+
+// Decompose splits program, which should already be optimized, into a
+// partial flowgraph that may be run over each of a number of pieces of the
+// input and a combining flowgraph that computes the output of program from
+// the merged outputs of the partial flowgraphs.  This is possible when
+// program begins with procs that transform each record independently
+// followed by an aggregation, which becomes a groupby emitting the partial
+// results of its reducers in the partial flowgraph and a groupby combining
+// them in the combining flowgraph.  The aggregation must not be time-binned
+// or updated periodically, and its reducers must not depend on the order of
+// the records, since the partial results are merged in no particular
+// order.  Decompose returns false if program cannot be split.
+func Decompose(program ast.Proc) (ast.Proc, ast.Proc, bool) {
+	seq, ok := program.(*ast.SequentialProc)
+	if !ok {
+		seq = &ast.SequentialProc{
+			Node:  ast.Node{"SequentialProc"},
+			Procs: []ast.Proc{program},
+		}
+	}
+	for k, p := range seq.Procs {
+		var groupby ast.GroupByProc
+		switch p := p.(type) {
+		case *ast.FilterProc, *ast.PutProc, *ast.CutProc, *ast.DropProc, *ast.RenameProc, *ast.PassProc:
+			continue
+		case *ast.ReducerProc:
+			if p.UpdateInterval.Seconds != 0 {
+				return nil, nil, false
+			}
+			groupby = ast.GroupByProc{
+				Node:     ast.Node{"GroupByProc"},
+				Reducers: p.Reducers,
+			}
+		case *ast.GroupByProc:
+			if p.Duration.Seconds != 0 || p.UpdateInterval.Seconds != 0 || p.ConsumePart || p.EmitPart {
+				return nil, nil, false
+			}
+			groupby = *p
+		default:
+			return nil, nil, false
+		}
+		for _, r := range groupby.Reducers {
+			switch r.Op {
+			case "First", "Last", "Collect":
+				return nil, nil, false
+			}
+		}
+		partial := groupby
+		partial.EmitPart = true
+		combine := groupby
+		combine.ConsumePart = true
+		partialProcs := append(append([]ast.Proc{}, seq.Procs[:k]...), &partial)
+		combineProcs := append([]ast.Proc{&combine}, seq.Procs[k+1:]...)
+		return &ast.SequentialProc{Node: seq.Node, Procs: partialProcs},
+			&ast.SequentialProc{Node: seq.Node, Procs: combineProcs},
+			true
+	}
+	return nil, nil, false
+}
+
+// CompileReaders compiles program as CompileWarningsCh does for the merged
+// records of readers.  If program can be split by Decompose, its partial
+// flowgraph is compiled for each reader and runs concurrently with the
+// others, and its combining flowgraph reads the merged partial results.
+// Otherwise, the readers are combined into a single input.
+func CompileReaders(ctx context.Context, program ast.Proc, readers []zbuf.Reader, reverse bool, span nano.Span, logger *zap.Logger, ch chan string) (*MuxOutput, error) {
+	program = Optimize(program)
+	partial, combine, ok := Decompose(program)
+	if !ok || len(readers) < 2 {
+		return CompileWarningsCh(ctx, program, scanner.NewCombiner(readers), reverse, span, logger, ch)
+	}
+	pctx := &proc.Context{
+		Context:     ctx,
+		TypeContext: resolver.NewContext(),
+		Logger:      logger,
+		Reverse:     reverse,
+		Warnings:    ch,
+	}
+	filterAst, partial := liftFilter(partial)
+	var parents []proc.Proc
+	for _, reader := range readers {
+		input, err := inputProc(pctx.TypeContext, reader, filterAst, span)
+		if err != nil {
+			return nil, err
+		}
+		leaves, err := proc.CompileProc(nil, partial, pctx, input)
+		if err != nil {
+			return nil, err
+		}
+		parents = append(parents, leaves...)
+	}
+	merge := proc.NewMerge(pctx, parents)
+	leaves, err := proc.CompileProc(nil, combine, pctx, merge)
+	if err != nil {
+		return nil, err
+	}
+	return NewMuxOutput(pctx, leaves), nil
+}
@@ -0,0 +1,99 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/scanner"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecompose(t *testing.T) {
+	tests := []struct {
+		query   string
+		partial string
+		combine string
+	}{
+		{"count() by _path", "* | count() by _path", "count() by _path"},
+		{"a=1 | put x=b+1 | avg(x) by y | sort", "a=1 | put x=b + 1 | avg(x) by y", "avg(x) by y | sort"},
+		{"cut a,b | rename c=a | countdistinct(b)", "* | cut a,b | rename c=a | countdistinct(b)", "countdistinct(b)"},
+		// Order-dependent, time-binned, and updated aggregations
+		// are not decomposed, nor are queries that don't begin with
+		// an aggregation.
+		{"first(a) by b", "", ""},
+		{"every 1h count()", "", ""},
+		{"sort a | count()", "", ""},
+		{"a=1 | head", "", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			query, err := zql.ParseProc(test.query)
+			require.NoError(t, err)
+			partial, combine, ok := Decompose(Optimize(query))
+			if test.partial == "" {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, test.partial, ast.FormatProc(partial))
+			assert.Equal(t, test.combine, ast.FormatProc(combine))
+		})
+	}
+}
+
+func TestCompileReaders(t *testing.T) {
+	var inputs []string
+	for k := 0; k < 4; k++ {
+		var b strings.Builder
+		b.WriteString("#0:record[ts:time,s:string,n:int64,x:float64]\n")
+		for i := 0; i < 100; i++ {
+			fmt.Fprintf(&b, "0:[%d;s%d;%d;%d.5;]\n", k*100+i, i%7, i%13, i)
+		}
+		inputs = append(inputs, b.String())
+	}
+	queries := []string{
+		"count() by s | sort s",
+		"n > 3 | count(), sum(n), min(x), max(ts), avg(x), countdistinct(n), union(n) by s | sort s",
+		"put m=n*2 | sum(m), median(x), quantile(n, 0.25), histogram(x, 4) by k=s | sort k",
+		"count(), avg(n)",
+	}
+	for _, q := range queries {
+		t.Run(q, func(t *testing.T) {
+			query, err := zql.ParseProc(q)
+			require.NoError(t, err)
+			zctx := resolver.NewContext()
+			var readers []zbuf.Reader
+			for _, input := range inputs {
+				readers = append(readers, zngio.NewReader(strings.NewReader(input), zctx))
+			}
+			out, err := Compile(context.Background(), query, scanner.NewCombiner(readers), false, nano.MaxSpan, nil)
+			require.NoError(t, err)
+			expected := run(t, out)
+			readers = readers[:0]
+			for _, input := range inputs {
+				readers = append(readers, zngio.NewReader(strings.NewReader(input), zctx))
+			}
+			out, err = CompileReaders(context.Background(), query, readers, false, nano.MaxSpan, nil, make(chan string, 5))
+			require.NoError(t, err)
+			actual := run(t, out)
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func run(t *testing.T, out *MuxOutput) string {
+	var b strings.Builder
+	w := zngio.NewWriter(&b)
+	require.NoError(t, Run(out, NewCLI(w), time.Second))
+	return b.String()
+}
@@ -64,8 +64,15 @@ func Run(out *MuxOutput, d Driver, statsInterval time.Duration) error {
 
 // CLI implements Driver.
 type CLI struct {
-	writers  []zbuf.Writer
-	warnings io.Writer
+	writers   []zbuf.Writer
+	warnings  io.Writer
+	autoFlush bool
+}
+
+// outputFlusher is implemented by writers that buffer their output, e.g.,
+// zio.Writer.
+type outputFlusher interface {
+	FlushOutput() error
 }
 
 func NewCLI(w ...zbuf.Writer) *CLI {
@@ -78,15 +85,25 @@ func (d *CLI) SetWarningsWriter(w io.Writer) {
 	d.warnings = w
 }
 
+// SetAutoFlush causes the CLI to flush the output of its writers after
+// each batch so that results appear as soon as they are computed.
+func (d *CLI) SetAutoFlush(on bool) {
+	d.autoFlush = on
+}
+
 func (d *CLI) Write(cid int, batch zbuf.Batch) error {
 	if len(d.writers) == 1 {
 		cid = 0
 	}
+	w := d.writers[cid]
 	for _, r := range batch.Records() {
-		if err := d.writers[cid].Write(r); err != nil {
+		if err := w.Write(r); err != nil {
 			return err
 		}
 	}
+	if f, ok := w.(outputFlusher); ok && d.autoFlush {
+		return f.FlushOutput()
+	}
 	return nil
 }
 
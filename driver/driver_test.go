@@ -57,3 +57,22 @@ func TestMuxDriver(t *testing.T) {
 		assert.Equal(t, 1, cs[1].(*counter).n)
 	})
 }
+
+func TestExplain(t *testing.T) {
+	query, err := zql.ParseProc("_path=conn | (count() by proto | sort -r count; head 3) | tail")
+	assert.NoError(t, err)
+	span := nano.Span{Ts: 1e9, Dur: 1e9}
+	plan, err := Explain(query, false, span, 1)
+	assert.NoError(t, err)
+	expected := `
+0: scanner filter _path="conn" span ` + span.String() + `
+1: split <- 0
+2: count() by proto -limit 1000000 <- 1
+3: sort -limit 1000000 -r count <- 2
+4: head 3 <- 1
+5: merge <- 3, 4
+6: tail 1 <- 5
+output 0 <- 6
+`
+	assert.Equal(t, expected[1:], plan.String())
+}
@@ -0,0 +1,155 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/scanner"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqd/api"
+	"go.uber.org/zap"
+)
+
+// Explain optimizes and compiles program as Compile does, without reading
+// any input, and returns a description of the resulting flowgraph.  Its
+// input is described as read by the given number of workers.
+func Explain(program ast.Proc, reverse bool, span nano.Span, workers int) (*api.SearchPlan, error) {
+	filterAst, program := liftFilter(Optimize(program))
+	pctx := &proc.Context{
+		Context:     context.Background(),
+		TypeContext: resolver.NewContext(),
+		Logger:      zap.NewNop(),
+		Reverse:     reverse,
+		Warnings:    make(chan string, 5),
+	}
+	input, err := inputProc(pctx.TypeContext, nil, filterAst, span)
+	if err != nil {
+		return nil, err
+	}
+	e := &explainer{
+		filter:  filterAst,
+		span:    span,
+		workers: workers,
+		nodes:   make(map[proc.Proc]ast.Proc),
+		ids:     make(map[proc.Proc]int),
+		plan:    &api.SearchPlan{},
+	}
+	leaves, err := proc.CompileProc(e, program, pctx, input)
+	if err != nil {
+		return nil, err
+	}
+	for _, leaf := range leaves {
+		e.plan.Outputs = append(e.plan.Outputs, e.visit(leaf))
+	}
+	return e.plan, nil
+}
+
+// explainer is a proc.Compiler that compiles each proc as usual but
+// records the AST node from which it was compiled so that the proc can be
+// described in the plan.
+type explainer struct {
+	filter  *ast.FilterProc
+	span    nano.Span
+	workers int
+	nodes   map[proc.Proc]ast.Proc
+	ids     map[proc.Proc]int
+	plan    *api.SearchPlan
+}
+
+func (e *explainer) Compile(node ast.Proc, c *proc.Context, parent proc.Proc) (proc.Proc, error) {
+	switch node.(type) {
+	case *ast.SequentialProc, *ast.ParallelProc:
+		// Leave these to proc.CompileProc, which compiles their
+		// procs with this compiler.
+		return nil, nil
+	}
+	procs, err := proc.CompileProc(nil, node, c, parent)
+	if err != nil {
+		return nil, err
+	}
+	e.nodes[procs[0]] = node
+	return procs[0], nil
+}
+
+// visit adds p and the procs upstream of it to the plan, if they are not
+// already there, and returns the ID of p.
+func (e *explainer) visit(p proc.Proc) int {
+	if sc, ok := p.(*proc.SplitChannel); ok {
+		// Each branch of a split reads from the split itself.
+		return e.visit(sc.Parents()[0])
+	}
+	if id, ok := e.ids[p]; ok {
+		return id
+	}
+	var parents []int
+	for _, parent := range p.Parents() {
+		parents = append(parents, e.visit(parent))
+	}
+	id := len(e.plan.Procs)
+	e.ids[p] = id
+	e.plan.Procs = append(e.plan.Procs, api.PlanProc{
+		ID:      id,
+		Proc:    e.describe(p),
+		Parents: parents,
+	})
+	return id
+}
+
+func (e *explainer) describe(p proc.Proc) string {
+	switch p := p.(type) {
+	case *scanner.Scanner:
+		s := "scanner"
+		if e.filter != nil {
+			if _, ok := e.filter.Filter.(*ast.MatchAll); !ok {
+				s += " filter " + ast.FormatFilter(e.filter.Filter)
+			}
+		}
+		if e.span != nano.MaxSpan {
+			s += " span " + e.span.String()
+		}
+		if e.workers > 1 {
+			s += fmt.Sprintf(" (%d workers)", e.workers)
+		}
+		return s
+	case *proc.Split:
+		return "split"
+	case *proc.Merge:
+		return "merge"
+	case *proc.Join:
+		return ast.FormatProc(p.Node())
+	}
+	node, ok := e.nodes[p]
+	if !ok {
+		return "unknown"
+	}
+	// Show the limits chosen by the compiler for nodes that leave them
+	// to its defaults.
+	limit, _ := proc.Limit(p)
+	switch n := node.(type) {
+	case *ast.FilterProc:
+		return "filter " + ast.FormatFilter(n.Filter)
+	case *ast.SortProc:
+		sort := *n
+		sort.Limit = limit
+		node = &sort
+	case *ast.GroupByProc:
+		groupby := *n
+		groupby.Limit = limit
+		s := ast.FormatProc(&groupby)
+		if n.EmitPart {
+			s += " (partial)"
+		}
+		if n.ConsumePart {
+			s += " (combine)"
+		}
+		return s
+	case *ast.TopProc:
+		top := *n
+		top.Limit = limit
+		node = &top
+	}
+	return ast.FormatProc(node)
+}
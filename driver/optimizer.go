@@ -0,0 +1,495 @@
+package driver
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// Optimize returns a flowgraph AST that computes the same records as
+// program but compiles into a more efficient flowgraph.  Optimize
+//
+//   - folds each expression whose operands are literals into a literal,
+//   - moves each term of a filter ahead of the puts, cuts, and sorts that
+//     precede it when the term does not depend on their output, so that the
+//     filter at the start of a query, which is pushed into the scanner,
+//     selects as many records as possible,
+//   - merges adjacent filters, and
+//   - marks a sort followed by a head so that the sort keeps only the records
+//     passed by the head in a bounded heap.
+//
+// Since moving a filter changes the records seen by the procs it is moved
+// ahead of, the warnings of those procs may differ, e.g., a sort may warn
+// that a field is not present in its input.  The nodes of program are not
+// modified.
+func Optimize(program ast.Proc) ast.Proc {
+	switch p := program.(type) {
+	case *ast.SequentialProc:
+		var procs []ast.Proc
+		for _, proc := range p.Procs {
+			proc = Optimize(proc)
+			if seq, ok := proc.(*ast.SequentialProc); ok {
+				procs = append(procs, seq.Procs...)
+			} else {
+				procs = append(procs, proc)
+			}
+		}
+		procs = boundSorts(mergeFilters(pushFilters(procs)))
+		return &ast.SequentialProc{Node: p.Node, Procs: procs}
+	case *ast.ParallelProc:
+		procs := make([]ast.Proc, 0, len(p.Procs))
+		for _, proc := range p.Procs {
+			procs = append(procs, Optimize(proc))
+		}
+		return &ast.ParallelProc{Node: p.Node, Procs: procs}
+	case *ast.FilterProc:
+		return &ast.FilterProc{Node: p.Node, Filter: foldFilter(p.Filter)}
+	case *ast.PutProc:
+		put := *p
+		put.Expr = foldExpr(p.Expr)
+		return &put
+	case *ast.ReducerProc:
+		reducer := *p
+		reducer.Reducers = foldReducers(p.Reducers)
+		return &reducer
+	case *ast.GroupByProc:
+		groupby := *p
+		groupby.Keys = make([]ast.ExpressionAssignment, 0, len(p.Keys))
+		for _, key := range p.Keys {
+			key.Expr = foldExpr(key.Expr)
+			groupby.Keys = append(groupby.Keys, key)
+		}
+		groupby.Reducers = foldReducers(p.Reducers)
+		return &groupby
+	}
+	return program
+}
+
+// pushFilters splits each filter in procs into the terms of its top-level
+// conjunction and moves each term ahead of the procs it commutes with.
+// A term moved ahead of a proc is placed after the filters preceding the
+// proc.
+func pushFilters(procs []ast.Proc) []ast.Proc {
+	var out []ast.Proc
+	for _, proc := range procs {
+		f, ok := proc.(*ast.FilterProc)
+		if !ok {
+			out = append(out, proc)
+			continue
+		}
+		for _, term := range conjuncts(f.Filter) {
+			at := len(out)
+			for k := len(out) - 1; k >= 0; k-- {
+				if _, ok := out[k].(*ast.FilterProc); ok {
+					continue
+				}
+				if !commutes(out[k], term) {
+					break
+				}
+				at = k
+			}
+			filter := &ast.FilterProc{Node: f.Node, Filter: term}
+			out = append(out[:at], append([]ast.Proc{filter}, out[at:]...)...)
+		}
+	}
+	return out
+}
+
+func conjuncts(e ast.BooleanExpr) []ast.BooleanExpr {
+	if and, ok := e.(*ast.LogicalAnd); ok {
+		return append(conjuncts(and.Left), conjuncts(and.Right)...)
+	}
+	return []ast.BooleanExpr{e}
+}
+
+// mergeFilters replaces each run of adjacent filters in procs with a
+// single filter matching the conjunction of their filters.
+func mergeFilters(procs []ast.Proc) []ast.Proc {
+	var out []ast.Proc
+	for _, proc := range procs {
+		f, ok := proc.(*ast.FilterProc)
+		if ok && len(out) > 0 {
+			if prev, ok := out[len(out)-1].(*ast.FilterProc); ok {
+				out[len(out)-1] = &ast.FilterProc{
+					Node:   prev.Node,
+					Filter: and(prev.Filter, f.Filter),
+				}
+				continue
+			}
+		}
+		out = append(out, proc)
+	}
+	return out
+}
+
+func and(left, right ast.BooleanExpr) ast.BooleanExpr {
+	if _, ok := left.(*ast.MatchAll); ok {
+		return right
+	}
+	if _, ok := right.(*ast.MatchAll); ok {
+		return left
+	}
+	return &ast.LogicalAnd{
+		Node:  ast.Node{"LogicalAnd"},
+		Left:  left,
+		Right: right,
+	}
+}
+
+// commutes returns true if the filter term e selects the same records
+// whether it follows or precedes proc.
+func commutes(proc ast.Proc, e ast.BooleanExpr) bool {
+	switch p := proc.(type) {
+	case *ast.PutProc:
+		// A put changes only the field it sets.
+		paths, ok := filterPaths(e, nil)
+		if !ok {
+			return false
+		}
+		target := strings.Split(p.Target, ".")[0]
+		for _, path := range paths {
+			if path[0] == target {
+				return false
+			}
+		}
+		return true
+	case *ast.CutProc:
+		// A cut copies the values of its fields, and drops the
+		// records that lack any of them whether or not they are
+		// selected, so a term that reads only those values selects
+		// the same records before the cut.
+		paths, ok := filterPaths(e, nil)
+		if !ok {
+			return false
+		}
+		var cut [][]string
+		for _, field := range p.Fields {
+			path, ok := cutPath(field)
+			if !ok {
+				return false
+			}
+			cut = append(cut, path)
+		}
+		for _, path := range paths {
+			if !covered(path, cut) {
+				return false
+			}
+		}
+		return true
+	case *ast.SortProc:
+		// A sort doesn't change records and is stable, so it outputs
+		// the selected records in the same order either way, but the
+		// field of a sort without fields is chosen from its first
+		// record.
+		return len(p.Fields) > 0
+	}
+	return false
+}
+
+// filterPaths appends to paths the paths of the fields read by the filter
+// e and returns them, or returns false if e may read any field, as a
+// search does.
+func filterPaths(e ast.BooleanExpr, paths [][]string) ([][]string, bool) {
+	switch e := e.(type) {
+	case *ast.MatchAll:
+		return paths, true
+	case *ast.CompareField:
+		path, ok := fieldPath(e.Field)
+		if ok && e.ValueField != nil {
+			paths = append(paths, path)
+			path, ok = fieldPath(e.ValueField)
+		}
+		return append(paths, path), ok
+	case *ast.LogicalAnd:
+		paths, ok := filterPaths(e.Left, paths)
+		if !ok {
+			return nil, false
+		}
+		return filterPaths(e.Right, paths)
+	case *ast.LogicalOr:
+		paths, ok := filterPaths(e.Left, paths)
+		if !ok {
+			return nil, false
+		}
+		return filterPaths(e.Right, paths)
+	case *ast.LogicalNot:
+		return filterPaths(e.Expr, paths)
+	case *ast.Evaluate:
+		return exprPaths(e.Expr, paths)
+	}
+	return nil, false
+}
+
+// exprPaths appends to paths the paths of the fields read by the
+// expression e and returns them.
+func exprPaths(e ast.Expression, paths [][]string) ([][]string, bool) {
+	switch e := e.(type) {
+	case *ast.Literal:
+		return paths, true
+	case *ast.FieldRead, *ast.FieldCall:
+		path, ok := fieldPath(e)
+		return append(paths, path), ok
+	case *ast.UnaryExpression:
+		return exprPaths(e.Operand, paths)
+	case *ast.BinaryExpression:
+		if path, ok := derefPath(e); ok {
+			return append(paths, path), true
+		}
+		return exprsPaths(paths, e.LHS, e.RHS)
+	case *ast.ConditionalExpression:
+		return exprsPaths(paths, e.Condition, e.Then, e.Else)
+	case *ast.FunctionCall:
+		return exprsPaths(paths, e.Args...)
+	}
+	return nil, false
+}
+
+func exprsPaths(paths [][]string, exprs ...ast.Expression) ([][]string, bool) {
+	for _, e := range exprs {
+		var ok bool
+		if paths, ok = exprPaths(e, paths); !ok {
+			return nil, false
+		}
+	}
+	return paths, true
+}
+
+// derefPath returns the path of the field read by e if e is a chain of
+// record field dereferences of a field, e.g., "a.b.c".
+func derefPath(e ast.Expression) ([]string, bool) {
+	switch e := e.(type) {
+	case *ast.FieldRead, *ast.FieldCall:
+		return fieldPath(e)
+	case *ast.BinaryExpression:
+		name, ok := e.RHS.(*ast.Literal)
+		if e.Operator != "." || !ok {
+			return nil, false
+		}
+		path, ok := derefPath(e.LHS)
+		return append(path, name.Value), ok
+	}
+	return nil, false
+}
+
+// fieldPath returns the path of the field read by the field expression e.
+// A function of a field, e.g., an array element, reads the whole field.
+func fieldPath(e ast.FieldExpr) ([]string, bool) {
+	switch e := e.(type) {
+	case *ast.FieldRead:
+		return []string{e.Field}, true
+	case *ast.FieldCall:
+		path, ok := fieldPath(e.Field)
+		if ok && e.Fn == "RecordFieldRead" {
+			path = append(path, e.Param)
+		}
+		return path, ok
+	}
+	return nil, false
+}
+
+// cutPath returns the path of the field named by e in a cut, or false if e
+// is a pattern or not a field.
+func cutPath(e ast.FieldExpr) ([]string, bool) {
+	switch e := e.(type) {
+	case *ast.FieldRead:
+		return []string{e.Field}, !strings.Contains(e.Field, "*")
+	case *ast.FieldCall:
+		if e.Fn != "RecordFieldRead" || strings.Contains(e.Param, "*") {
+			return nil, false
+		}
+		path, ok := cutPath(e.Field)
+		return append(path, e.Param), ok
+	}
+	return nil, false
+}
+
+// covered returns true if path is one of the paths in cut or a field
+// within one of them.
+func covered(path []string, cut [][]string) bool {
+	for _, c := range cut {
+		if len(c) <= len(path) && equalPaths(c, path[:len(c)]) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalPaths(a, b []string) bool {
+	for k := range a {
+		if a[k] != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// boundSorts sets the head of each sort in procs that is followed by a
+// head proc.  The head proc is kept since a sort may not honor its head.
+func boundSorts(procs []ast.Proc) []ast.Proc {
+	out := append([]ast.Proc(nil), procs...)
+	for k := 0; k+1 < len(out); k++ {
+		sort, ok := out[k].(*ast.SortProc)
+		if !ok {
+			continue
+		}
+		if head, ok := out[k+1].(*ast.HeadProc); ok {
+			n := head.Count
+			if n == 0 {
+				n = 1
+			}
+			if sort.Head == 0 || n < sort.Head {
+				bounded := *sort
+				bounded.Head = n
+				out[k] = &bounded
+			}
+		}
+	}
+	return out
+}
+
+// foldFilter returns the filter e with its expressions folded.  An
+// expression that is always true matches all records.
+func foldFilter(e ast.BooleanExpr) ast.BooleanExpr {
+	switch e := e.(type) {
+	case *ast.LogicalAnd:
+		return and(foldFilter(e.Left), foldFilter(e.Right))
+	case *ast.LogicalOr:
+		return &ast.LogicalOr{Node: e.Node, Left: foldFilter(e.Left), Right: foldFilter(e.Right)}
+	case *ast.LogicalNot:
+		return &ast.LogicalNot{Node: e.Node, Expr: foldFilter(e.Expr)}
+	case *ast.Evaluate:
+		folded := foldExpr(e.Expr)
+		if lit, ok := folded.(*ast.Literal); ok && lit.Type == "bool" && lit.Value == "true" {
+			return &ast.MatchAll{Node: ast.Node{"MatchAll"}}
+		}
+		return &ast.Evaluate{Node: e.Node, Expr: folded}
+	}
+	return e
+}
+
+func foldReducers(reducers []ast.Reducer) []ast.Reducer {
+	out := make([]ast.Reducer, 0, len(reducers))
+	for _, r := range reducers {
+		if r.Expr != nil {
+			r.Expr = foldExpr(r.Expr)
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// foldExpr returns e with each operator whose operands are literals
+// replaced by the literal value it computes.  Function calls aren't
+// evaluated, and an operator that fails, e.g., an integer division by
+// zero, is left to fail when the query runs.
+func foldExpr(e ast.Expression) ast.Expression {
+	switch e := e.(type) {
+	case *ast.UnaryExpression:
+		folded := *e
+		folded.Operand = foldExpr(e.Operand)
+		return evalLiteral(&folded, folded.Operand)
+	case *ast.BinaryExpression:
+		folded := *e
+		folded.LHS = foldExpr(e.LHS)
+		folded.RHS = foldExpr(e.RHS)
+		return evalLiteral(&folded, folded.LHS, folded.RHS)
+	case *ast.ConditionalExpression:
+		folded := *e
+		folded.Condition = foldExpr(e.Condition)
+		folded.Then = foldExpr(e.Then)
+		folded.Else = foldExpr(e.Else)
+		return evalLiteral(&folded, folded.Condition, folded.Then, folded.Else)
+	case *ast.FunctionCall:
+		folded := *e
+		folded.Args = make([]ast.Expression, 0, len(e.Args))
+		for _, arg := range e.Args {
+			folded.Args = append(folded.Args, foldExpr(arg))
+		}
+		return &folded
+	}
+	return e
+}
+
+// evalLiteral returns the literal value of e if its operands are all
+// literals and it can be evaluated, and e otherwise.
+func evalLiteral(e ast.Expression, operands ...ast.Expression) ast.Expression {
+	for _, operand := range operands {
+		if _, ok := operand.(*ast.Literal); !ok {
+			return e
+		}
+	}
+	v, err := evalConst(e)
+	if err != nil {
+		return e
+	}
+	if lit, ok := literal(v); ok {
+		return lit
+	}
+	return e
+}
+
+// evalConst evaluates the expression e, which reads no fields, and returns
+// an error if it fails or panics, e.g., on integer division by zero.
+// Since function calls aren't folded, e has no values of container types
+// and any type context will do.
+func evalConst(e ast.Expression) (v zng.Value, err error) {
+	eval, err := expr.CompileExpr(resolver.NewContext(), e)
+	if err != nil {
+		return zng.Value{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return eval(nil)
+}
+
+// literal returns a literal for v written as it would be in ZQL, or false
+// if v has no such literal or the literal doesn't parse as v.
+func literal(v zng.Value) (*ast.Literal, bool) {
+	var s string
+	switch v.Type {
+	case zng.TypeBool:
+		b, err := zng.DecodeBool(v.Bytes)
+		if err != nil {
+			return nil, false
+		}
+		s = strconv.FormatBool(b)
+	case zng.TypeInt64:
+		i, err := zng.DecodeInt(v.Bytes)
+		if err != nil {
+			return nil, false
+		}
+		s = strconv.FormatInt(i, 10)
+	case zng.TypeFloat64:
+		f, err := zng.DecodeFloat64(v.Bytes)
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, false
+		}
+		s = strconv.FormatFloat(f, 'f', -1, 64)
+		if !strings.Contains(s, ".") {
+			s += ".0"
+		}
+	case zng.TypeString:
+		var err error
+		if s, err = zng.DecodeString(v.Bytes); err != nil {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+	lit := &ast.Literal{Node: ast.Node{"Literal"}, Type: v.Type.String(), Value: s}
+	parsed, err := zng.Parse(*lit)
+	if err != nil || parsed.Type != v.Type || !bytes.Equal(parsed.Bytes, v.Bytes) {
+		return nil, false
+	}
+	return lit, true
+}
@@ -0,0 +1,78 @@
+package driver
+
+import (
+	"testing"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimize(t *testing.T) {
+	tests := []struct {
+		query     string
+		optimized string
+	}{
+		// Filters are merged.
+		{"a=1 | filter b=2 | filter c=3", "a=1 b=2 c=3"},
+		// A filter is moved ahead of a put that doesn't set its fields.
+		{"put x=a+1 | filter a=1", "a=1 | put x=a + 1"},
+		{"put x=a+1 | filter x=2", "* | put x=a + 1 | filter x=2"},
+		{"put x=a+1 | filter a=1 x=2", "a=1 | put x=a + 1 | filter x=2"},
+		{"put x=1 | filter a.x=1", "a.x=1 | put x=1"},
+		{"put x=1 | filter a+x=1", "* | put x=1 | filter a + x = 1"},
+		// A search may read any field.
+		{"put x=1 | filter foo", "* | put x=1 | filter foo"},
+		{"put x=1 | filter *=1", "* | put x=1 | filter *=1"},
+		// A filter is moved ahead of a cut of the fields it reads.
+		{"cut a,b | filter a=1 c=2", "a=1 | cut a,b | filter c=2"},
+		{"cut id | filter id.orig_h=10.0.0.1", "id.orig_h=10.0.0.1 | cut id"},
+		{"cut id.orig_h | filter id.orig_h=10.0.0.1", "id.orig_h=10.0.0.1 | cut id.orig_h"},
+		{"cut id.orig_h | filter id=10.0.0.1", "* | cut id.orig_h | filter id=10.0.0.1"},
+		{"cut a* | filter a=1", "* | cut a* | filter a=1"},
+		// A filter is moved ahead of a sort with fields.
+		{"sort x | filter x>1 | head 2", "x>1 | sort x | head 2"},
+		{"sort | filter x>1", "* | sort | filter x>1"},
+		// A filter moves across several procs and the filters stuck
+		// behind them.
+		{"put x=1 | filter x=1 | cut a | filter a=2", "a=2 | put x=1 | filter x=1 | cut a"},
+		{"put x=1 | cut a | filter a=2", "a=2 | put x=1 | cut a"},
+		// Constant expressions are folded.
+		{"put x=1+2*3", "* | put x=7"},
+		{"put x=a+2*3", "* | put x=a + 6"},
+		{"put x=(1+2)*a", "* | put x=3 * a"},
+		{`put s="a"+"b"`, `* | put s="ab"`},
+		{"put x=1.5*2", "* | put x=3.0"},
+		{"put b=1<2 ? 3 : 4", "* | put b=3"},
+		{"put x=1/(2-2)", "* | put x=1 / 0"},
+		{"put x=Math.sqrt(2*2)", "* | put x=Math.sqrt(4)"},
+		{"count() by y=1+1", "* | count() by y=2"},
+		{"filter 1+1=2 | count()", "* | count()"},
+		{"filter 1+1=3 | count()", "false | count()"},
+		// Parallel branches are optimized.
+		{"* | (put x=1 | filter a=1; sort y | filter y=2)", "* | (filter a=1 | put x=1; filter y=2 | sort y)"},
+	}
+	for _, test := range tests {
+		program, err := zql.ParseProc(test.query)
+		require.NoError(t, err, test.query)
+		before := ast.FormatProc(program)
+		assert.Equal(t, test.optimized, ast.FormatProc(Optimize(program)), test.query)
+		assert.Equal(t, before, ast.FormatProc(program), "%s: program modified", test.query)
+	}
+}
+
+func TestOptimizeSortHead(t *testing.T) {
+	program, err := zql.ParseProc("sort -r x | head 5 | (sort y | head; sort z)")
+	require.NoError(t, err)
+	seq := Optimize(program).(*ast.SequentialProc)
+	require.Len(t, seq.Procs, 4)
+	assert.Equal(t, 5, seq.Procs[1].(*ast.SortProc).Head)
+	// The head follows the sort.
+	assert.IsType(t, &ast.HeadProc{}, seq.Procs[2])
+	branches := seq.Procs[3].(*ast.ParallelProc).Procs
+	assert.Equal(t, 1, branches[0].(*ast.SequentialProc).Procs[0].(*ast.SortProc).Head)
+	assert.Equal(t, 0, branches[1].(*ast.SequentialProc).Procs[0].(*ast.SortProc).Head)
+	// The original program is unchanged.
+	assert.Equal(t, 0, program.(*ast.SequentialProc).Procs[1].(*ast.SortProc).Head)
+}
@@ -0,0 +1,58 @@
+package emitter
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+type compressWriter struct {
+	io.WriteCloser
+	closer io.Closer
+}
+
+// Close finishes the compressed stream then closes the underlying writer.
+func (c *compressWriter) Close() error {
+	err := c.WriteCloser.Close()
+	if cerr := c.closer.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// compress returns a writer that compresses its output to w with the
+// indicated compression, which is either "gzip" or "zstd".  If compression
+// is empty, compress returns w.
+func compress(w io.WriteCloser, compression string) (io.WriteCloser, error) {
+	switch compression {
+	case "":
+		return w, nil
+	case "gzip":
+		return &compressWriter{gzip.NewWriter(w), w}, nil
+	case "zstd":
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, err
+		}
+		return &compressWriter{zw, w}, nil
+	}
+	return nil, unknownCompression(compression)
+}
+
+func unknownCompression(compression string) error {
+	return fmt.Errorf("unknown output compression: %s", compression)
+}
+
+// compressionExtension returns the file extension of output compressed
+// with compression.
+func compressionExtension(compression string) string {
+	switch compression {
+	case "gzip":
+		return ".gz"
+	case "zstd":
+		return ".zst"
+	}
+	return ""
+}
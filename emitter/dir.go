@@ -44,6 +44,13 @@ func NewDir(dir, prefix, format string, stderr io.Writer, flags *zio.Flags) (*Di
 	if e == "" {
 		return nil, unknownFormat(format)
 	}
+	if flags.Compression != "" {
+		ce := compressionExtension(flags.Compression)
+		if ce == "" {
+			return nil, unknownCompression(flags.Compression)
+		}
+		e += ce
+	}
 	return &Dir{
 		dir:     dir,
 		prefix:  prefix,
@@ -108,6 +115,16 @@ func (d *Dir) newFile(rec *zng.Record) (*zio.Writer, error) {
 	return w, err
 }
 
+// FlushOutput flushes the output buffered for each file.
+func (d *Dir) FlushOutput() error {
+	for _, w := range d.writers {
+		if err := w.FlushOutput(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (d *Dir) Close() error {
 	var cerr error
 	for _, w := range d.writers {
@@ -18,6 +18,11 @@ func (*noClose) Close() error {
 }
 
 func NewFile(path, format string, flags *zio.Flags) (*zio.Writer, error) {
+	if flags.Compression != "" && compressionExtension(flags.Compression) == "" {
+		// Check this before opening the file so a bad flag doesn't
+		// leave an empty file behind.
+		return nil, unknownCompression(flags.Compression)
+	}
 	var f io.WriteCloser
 	if path == "" {
 		// Don't close stdout in case we live inside something
@@ -32,9 +37,15 @@ func NewFile(path, format string, flags *zio.Flags) (*zio.Writer, error) {
 		}
 		f = file
 	}
+	cf, err := compress(f, flags.Compression)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	f = cf
 	// On close, zio.Writer.Close(), the zng WriteFlusher will be flushed
 	// then the bufwriter will closed (which will flush it's internal buffer
-	// then close the file)
+	// then close the compressor, if any, and the file)
 	w := detector.LookupWriter(format, bufwriter.New(f), flags)
 	if w == nil {
 		return nil, unknownFormat(format)
@@ -0,0 +1,109 @@
+package expr
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"net"
+)
+
+// The Net.communityID function computes version 1 of the Community ID flow
+// hash, which is described at https://github.com/corelight/community-id-spec.
+// The hash is taken over the flow's endpoints in a canonical order so that
+// both directions of a flow have the same ID.
+
+const (
+	protoICMP   = 1
+	protoTCP    = 6
+	protoUDP    = 17
+	protoICMPv6 = 58
+	protoSCTP   = 132
+)
+
+var protoNumbers = map[string]int{
+	"icmp":   protoICMP,
+	"tcp":    protoTCP,
+	"udp":    protoUDP,
+	"icmp6":  protoICMPv6,
+	"icmpv6": protoICMPv6,
+	"sctp":   protoSCTP,
+}
+
+// The ICMP message types that have a counterpart in the other direction
+// of a flow, which plays the part of the destination port.  Flows of other
+// message types are one-way.
+var icmpCounterparts = map[uint16]uint16{
+	0:  8,  // echo reply
+	8:  0,  // echo request
+	9:  10, // router advertisement
+	10: 9,  // router solicitation
+	13: 14, // timestamp
+	14: 13, // timestamp reply
+	15: 16, // information request
+	16: 15, // information reply
+	17: 18, // address mask request
+	18: 17, // address mask reply
+}
+
+var icmpv6Counterparts = map[uint16]uint16{
+	128: 129, // echo request
+	129: 128, // echo reply
+	130: 131, // multicast listener query
+	131: 130, // multicast listener report
+	133: 134, // router solicitation
+	134: 133, // router advertisement
+	135: 136, // neighbor solicitation
+	136: 135, // neighbor advertisement
+	139: 140, // node information query
+	140: 139, // node information response
+	144: 145, // home agent address discovery request
+	145: 144, // home agent address discovery reply
+}
+
+// communityID returns the Community ID of a flow.  For ICMP and ICMPv6
+// flows, sport and dport hold the message type and code.
+func communityID(seed uint16, proto int, src net.IP, sport uint16, dst net.IP, dport uint16) (string, error) {
+	if proto < 0 || proto > 255 {
+		return "", errors.New("protocol out of range")
+	}
+	if s4, d4 := src.To4(), dst.To4(); s4 != nil && d4 != nil {
+		src, dst = s4, d4
+	} else if s4 != nil || d4 != nil {
+		return "", errors.New("mixed address families")
+	}
+	oneWay := false
+	switch proto {
+	case protoICMP, protoICMPv6:
+		counterparts := icmpCounterparts
+		if proto == protoICMPv6 {
+			counterparts = icmpv6Counterparts
+		}
+		if code, ok := counterparts[sport]; ok {
+			dport = code
+		} else {
+			oneWay = true
+		}
+	}
+	cmp := bytes.Compare(src, dst)
+	if !oneWay && (cmp > 0 || (cmp == 0 && sport > dport)) {
+		src, dst = dst, src
+		sport, dport = dport, sport
+	}
+	h := sha1.New()
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], seed)
+	h.Write(b[:])
+	h.Write(src)
+	h.Write(dst)
+	h.Write([]byte{byte(proto), 0})
+	switch proto {
+	case protoICMP, protoTCP, protoUDP, protoICMPv6, protoSCTP:
+		binary.BigEndian.PutUint16(b[:], sport)
+		h.Write(b[:])
+		binary.BigEndian.PutUint16(b[:], dport)
+		h.Write(b[:])
+	}
+	return "1:" + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zngnative"
 )
 
@@ -25,7 +26,10 @@ type NativeEvaluator func(*zng.Record) (zngnative.Value, error)
 
 // CompileExpr tries to compile the given Expression into a function
 // that evalutes the expression against a provided Record.  Returns an
-// error if compilation fails for any reason.
+// error if compilation fails for any reason.  The container types of
+// values computed by the expression, e.g., the array returned by
+// String.split, are created in zctx, which should be the type context
+// of the flowgraph.
 //
 // This is currently not particularly optimized -- it creates a bunch
 // of closures and every evaluation involves some allocations.
@@ -35,8 +39,8 @@ type NativeEvaluator func(*zng.Record) (zngnative.Value, error)
 // more efficiently.  ZNG unions are a challenge for this approach, but
 // we could fail back to the "slow path" implemented here if an
 // expression ever touches a union.
-func CompileExpr(node ast.Expression) (ExpressionEvaluator, error) {
-	ne, err := compileNative(node)
+func CompileExpr(zctx *resolver.Context, node ast.Expression) (ExpressionEvaluator, error) {
+	ne, err := compileNative(zctx, node)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +55,30 @@ func CompileExpr(node ast.Expression) (ExpressionEvaluator, error) {
 	}, nil
 }
 
-func compileNative(node ast.Expression) (NativeEvaluator, error) {
+// CompileExprResolver compiles the given Expression into a
+// FieldExprResolver, which returns an unset zng.Value (i.e., one with a
+// nil Type) if the expression cannot be evaluated for a record.  Field
+// expressions are compiled with CompileFieldExpr so that their values
+// are passed through exactly as they appear in the record.
+func CompileExprResolver(zctx *resolver.Context, node ast.Expression) (FieldExprResolver, error) {
+	switch node.(type) {
+	case *ast.FieldRead, *ast.FieldCall:
+		return CompileFieldExpr(node)
+	}
+	eval, err := CompileExpr(zctx, node)
+	if err != nil {
+		return nil, err
+	}
+	return func(rec *zng.Record) zng.Value {
+		v, err := eval(rec)
+		if err != nil {
+			return zng.Value{}
+		}
+		return v
+	}, nil
+}
+
+func compileNative(zctx *resolver.Context, node ast.Expression) (NativeEvaluator, error) {
 	switch n := node.(type) {
 	case *ast.Literal:
 		v, err := zng.Parse(*n)
@@ -64,8 +91,9 @@ func compileNative(node ast.Expression) (NativeEvaluator, error) {
 		}
 		return func(*zng.Record) (zngnative.Value, error) { return nv, nil }, nil
 
-	case *ast.FieldRead:
-		fn, err := CompileFieldExpr(n)
+	case *ast.FieldRead, *ast.FieldCall:
+		field := node.(ast.FieldExpr)
+		fn, err := CompileFieldExpr(field)
 		if err != nil {
 			return nil, err
 		}
@@ -76,20 +104,20 @@ func compileNative(node ast.Expression) (NativeEvaluator, error) {
 			}
 			nv, err := zngnative.ToNativeValue(v)
 			if err != nil {
-				return zngnative.Value{}, fmt.Errorf("%s: %w", n.Field, err)
+				return zngnative.Value{}, fmt.Errorf("%s: %w", FieldExprToString(field), err)
 			}
 			return nv, nil
 		}, nil
 
 	case *ast.UnaryExpression:
-		return compileUnary(*n)
+		return compileUnary(zctx, *n)
 
 	case *ast.BinaryExpression:
-		lhsFunc, err := compileNative(n.LHS)
+		lhsFunc, err := compileNative(zctx, n.LHS)
 		if err != nil {
 			return nil, err
 		}
-		rhsFunc, err := compileNative(n.RHS)
+		rhsFunc, err := compileNative(zctx, n.RHS)
 		if err != nil {
 			return nil, err
 		}
@@ -113,21 +141,21 @@ func compileNative(node ast.Expression) (NativeEvaluator, error) {
 		}
 
 	case *ast.ConditionalExpression:
-		return compileConditional(*n)
+		return compileConditional(zctx, *n)
 
 	case *ast.FunctionCall:
-		return compileFunctionCall(*n)
+		return compileFunctionCall(zctx, *n)
 
 	default:
 		return nil, fmt.Errorf("invalid expression type %T", node)
 	}
 }
 
-func compileUnary(node ast.UnaryExpression) (NativeEvaluator, error) {
+func compileUnary(zctx *resolver.Context, node ast.UnaryExpression) (NativeEvaluator, error) {
 	if node.Operator != "!" {
 		return nil, fmt.Errorf("unknown unary operator %s\n", node.Operator)
 	}
-	fn, err := compileNative(node.Operand)
+	fn, err := compileNative(zctx, node.Operand)
 	if err != nil {
 		return nil, err
 	}
@@ -196,31 +224,42 @@ func compileIn(lhsFunc, rhsFunc NativeEvaluator) (NativeEvaluator, error) {
 			return zngnative.Value{}, err
 		}
 
-		iter := zcode.Iter(rhs.Value.(zcode.Bytes))
-		for {
-			if iter.Done() {
-				return zngnative.Value{zng.TypeBool, false}, nil
-			}
-
-			zv, _, err := iter.Next()
-			if err != nil {
-				return zngnative.Value{}, err
-			}
-			v, err := zngnative.ToNativeValue(zng.Value{typ, zv})
-			if err != nil {
-				return zngnative.Value{}, err
-			}
-			found, err := compare(lhs, v)
-			if err != nil {
-				return zngnative.Value{}, err
-			}
-			if found {
-				return zngnative.Value{zng.TypeBool, true}, nil
-			}
+		found, err := containerHas(typ, rhs.Value.(zcode.Bytes), lhs)
+		if err != nil {
+			return zngnative.Value{}, err
 		}
+		return zngnative.Value{zng.TypeBool, found}, nil
 	}, nil
 }
 
+// containerHas returns true if the container body, whose elements are of
+// type typ, holds an element equal to v.
+func containerHas(typ zng.Type, body zcode.Bytes, v zngnative.Value) (bool, error) {
+	iter := body.Iter()
+	for !iter.Done() {
+		zv, _, err := iter.Next()
+		if err != nil {
+			return false, err
+		}
+		if zv == nil {
+			// An unset element equals nothing.
+			continue
+		}
+		elem, err := zngnative.ToNativeValue(zng.Value{typ, zv})
+		if err != nil {
+			return false, err
+		}
+		found, err := compare(v, elem)
+		if err != nil {
+			return false, err
+		}
+		if found {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func floatToInt64(f float64) (int64, bool) {
 	i := int64(f)
 	if float64(i) == f {
@@ -821,8 +860,11 @@ func compileFieldReference(lhsFunc, rhsFunc NativeEvaluator, operator string) (N
 	}, nil
 }
 
-func compileFunctionCall(node ast.FunctionCall) (NativeEvaluator, error) {
-	fn, ok := allFns[node.Function]
+func compileFunctionCall(zctx *resolver.Context, node ast.FunctionCall) (NativeEvaluator, error) {
+	if node.Function == "has" {
+		return compileHas(zctx, node)
+	}
+	fn, ok := lookupFunction(node.Function)
 	if !ok {
 		return nil, fmt.Errorf("%s: %w", node.Function, ErrNoSuchFunction)
 	}
@@ -837,7 +879,7 @@ func compileFunctionCall(node ast.FunctionCall) (NativeEvaluator, error) {
 
 	exprs := make([]NativeEvaluator, nargs)
 	for i, expr := range node.Args {
-		eval, err := compileNative(expr)
+		eval, err := compileNative(zctx, expr)
 		if err != nil {
 			return nil, err
 		}
@@ -854,20 +896,103 @@ func compileFunctionCall(node ast.FunctionCall) (NativeEvaluator, error) {
 			args = append(args, val)
 		}
 
-		return fn.impl(args)
+		return fn.impl(zctx, args)
 	}, nil
 }
 
-func compileConditional(node ast.ConditionalExpression) (NativeEvaluator, error) {
-	conditionFunc, err := compileNative(node.Condition)
+// compileHas compiles a call to has(), which returns true if each of its
+// arguments, which are typically field expressions, refers to a field
+// present in the record.  Since the arguments need not be present, has()
+// cannot be an ordinary function.
+func compileHas(zctx *resolver.Context, node ast.FunctionCall) (NativeEvaluator, error) {
+	if len(node.Args) == 0 {
+		return nil, fmt.Errorf("has: %w", ErrTooFewArgs)
+	}
+	fields := make([]NativeEvaluator, len(node.Args))
+	for i, expr := range node.Args {
+		eval, err := compilePresence(zctx, expr)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = eval
+	}
+	return func(r *zng.Record) (zngnative.Value, error) {
+		for _, eval := range fields {
+			if _, err := eval(r); err != nil {
+				if errors.Is(err, ErrNoSuchField) {
+					return zngnative.Value{zng.TypeBool, false}, nil
+				}
+				return zngnative.Value{}, err
+			}
+		}
+		return zngnative.Value{zng.TypeBool, true}, nil
+	}, nil
+}
+
+// compilePresence compiles an argument of has() into an evaluator that
+// returns ErrNoSuchField if the argument refers to a missing field.  The
+// values of field expressions are not decoded, so unset fields are
+// present.
+func compilePresence(zctx *resolver.Context, node ast.Expression) (NativeEvaluator, error) {
+	switch n := node.(type) {
+	case *ast.FieldRead, *ast.FieldCall:
+		fn, err := CompileFieldExpr(n)
+		if err != nil {
+			return nil, err
+		}
+		return func(r *zng.Record) (zngnative.Value, error) {
+			if fn(r).Type == nil {
+				return zngnative.Value{}, ErrNoSuchField
+			}
+			return zngnative.Value{}, nil
+		}, nil
+	case *ast.BinaryExpression:
+		if n.Operator != "." {
+			break
+		}
+		lhsFunc, err := compileNative(zctx, n.LHS)
+		if err != nil {
+			return nil, err
+		}
+		rhsFunc, err := compileNative(zctx, n.RHS)
+		if err != nil {
+			return nil, err
+		}
+		return func(r *zng.Record) (zngnative.Value, error) {
+			lhs, err := lhsFunc(r)
+			if err != nil {
+				return zngnative.Value{}, err
+			}
+			rType, ok := lhs.Type.(*zng.TypeRecord)
+			if !ok {
+				return zngnative.Value{}, ErrIncompatibleTypes
+			}
+			rhs, err := rhsFunc(r)
+			if err != nil {
+				return zngnative.Value{}, err
+			}
+			if !isStringType(rhs.Type) {
+				return zngnative.Value{}, ErrIncompatibleTypes
+			}
+			if _, ok := rType.ColumnOfField(rhs.Value.(string)); !ok {
+				return zngnative.Value{}, ErrNoSuchField
+			}
+			return zngnative.Value{}, nil
+		}, nil
+	}
+	return compileNative(zctx, node)
+}
+
+func compileConditional(zctx *resolver.Context, node ast.ConditionalExpression) (NativeEvaluator, error) {
+	conditionFunc, err := compileNative(zctx, node.Condition)
 	if err != nil {
 		return nil, err
 	}
-	thenFunc, err := compileNative(node.Then)
+	thenFunc, err := compileNative(zctx, node.Then)
 	if err != nil {
 		return nil, err
 	}
-	elseFunc, err := compileNative(node.Else)
+	elseFunc, err := compileNative(zctx, node.Else)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +54,7 @@ func compileExpr(s string) (expr.ExpressionEvaluator, error) {
 		return nil, errors.New("expected Expression")
 	}
 
-	return expr.CompileExpr(node)
+	return expr.CompileExpr(resolver.NewContext(), node)
 }
 
 // Compile and evaluate a zql expression against a provided Record.
@@ -113,6 +113,10 @@ func zstring(s string) zng.Value {
 	return zng.Value{zng.TypeString, zng.EncodeString(s)}
 }
 
+func zbstring(s string) zng.Value {
+	return zng.Value{zng.TypeBstring, zng.EncodeBstring(s)}
+}
+
 func TestPrimitives(t *testing.T) {
 	record, err := parseOneRecord(`
 #0:record[x:int32,f:float64,s:string]
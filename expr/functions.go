@@ -1,15 +1,31 @@
 package expr
 
 import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
+	"hash/fnv"
 	"math"
 	"net"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"unicode/utf8"
 
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zngnative"
 )
 
@@ -18,13 +34,56 @@ type Function func([]zngnative.Value) (zngnative.Value, error)
 var ErrTooFewArgs = errors.New("too few arguments")
 var ErrTooManyArgs = errors.New("too many arguments")
 var ErrBadArgument = errors.New("bad argument")
+var ErrNoMatch = errors.New("no match")
 
-var allFns = map[string]struct {
+// The regular expressions compiled by compileRegexp, which are cached
+// since functions are evaluated for each record.
+var regexps struct {
+	sync.Mutex
+	cache map[string]*regexp.Regexp
+}
+
+const maxRegexps = 1000
+
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	regexps.Lock()
+	defer regexps.Unlock()
+	if re, ok := regexps.cache[pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, ErrBadArgument
+	}
+	if regexps.cache == nil || len(regexps.cache) >= maxRegexps {
+		regexps.cache = make(map[string]*regexp.Regexp)
+	}
+	regexps.cache[pattern] = re
+	return re, nil
+}
+
+type function struct {
 	minArgs int
 	maxArgs int
 	impl    Function
-}{
-	"len": {1, 1, lenFn},
+}
+
+var allFns = map[string]function{
+	"contains": {2, 2, containsFn},
+	"len":      {1, 1, lenFn},
+
+	"Array.slice": {2, 3, arraySlice},
+	"Array.sort":  {1, 1, arraySort},
+
+	"Encode.base64":     {1, 1, encodeBase64},
+	"Encode.fromBase64": {1, 1, encodeFromBase64},
+	"Encode.fromHex":    {1, 1, encodeFromHex},
+	"Encode.hex":        {1, 1, encodeHex},
+
+	"Hash.fnv":    {1, 1, hashFnv},
+	"Hash.md5":    {1, 1, hashFn("Hash.md5", md5.New)},
+	"Hash.sha1":   {1, 1, hashFn("Hash.sha1", sha1.New)},
+	"Hash.sha256": {1, 1, hashFn("Hash.sha256", sha256.New)},
 
 	"Math.abs":   {1, 1, mathAbs},
 	"Math.ceil":  {1, 1, mathCeil},
@@ -37,18 +96,83 @@ var allFns = map[string]struct {
 	"Math.pow":   {2, 2, mathPow},
 	"Math.sqrt":  {1, 1, mathSqrt},
 
-	"String.byteLen":     {1, 1, stringByteLen},
-	"String.formatFloat": {1, 1, stringFormatFloat},
-	"String.formatInt":   {1, 1, stringFormatInt},
-	"String.formatIp":    {1, 1, stringFormatIp},
-	"String.parseFloat":  {1, 1, stringParseFloat},
-	"String.parseInt":    {1, 1, stringParseInt},
-	"String.parseIp":     {1, 1, stringParseIp},
-	"String.replace":     {3, 3, stringReplace},
-	"String.runeLen":     {1, 1, stringRuneLen},
-	"String.toLower":     {1, 1, stringToLower},
-	"String.toUpper":     {1, 1, stringToUpper},
-	"String.trim":        {1, 1, stringTrim},
+	"Net.cidrMatch":   {2, 2, netCidrMatch},
+	"Net.communityID": {5, 6, netCommunityID},
+	"Net.family":      {1, 1, netFamily},
+	"Net.fromInt":     {1, 1, netFromInt},
+	"Net.isLoopback":  {1, 1, netIsLoopback},
+	"Net.isMulticast": {1, 1, netIsMulticast},
+	"Net.isPrivate":   {1, 1, netIsPrivate},
+	"Net.networkOf":   {2, 2, netNetworkOf},
+	"Net.toInt":       {1, 1, netToInt},
+
+	"Set.intersect": {2, -1, setIntersect},
+	"Set.union":     {2, -1, setUnion},
+
+	"String.byteLen":       {1, 1, stringByteLen},
+	"String.endsWith":      {2, 2, stringEndsWith},
+	"String.formatFloat":   {1, 1, stringFormatFloat},
+	"String.formatInt":     {1, 1, stringFormatInt},
+	"String.formatIp":      {1, 1, stringFormatIp},
+	"String.index":         {2, 2, stringIndex},
+	"String.join":          {2, 2, stringJoin},
+	"String.parseFloat":    {1, 1, stringParseFloat},
+	"String.parseInt":      {1, 1, stringParseInt},
+	"String.parseIp":       {1, 1, stringParseIp},
+	"String.regexpExtract": {2, 3, stringRegexpExtract},
+	"String.regexpMatch":   {2, 2, stringRegexpMatch},
+	"String.replace":       {3, 3, stringReplace},
+	"String.runeLen":       {1, 1, stringRuneLen},
+	"String.startsWith":    {2, 2, stringStartsWith},
+	"String.substr":        {2, 3, stringSubstr},
+	"String.toLower":       {1, 1, stringToLower},
+	"String.toUpper":       {1, 1, stringToUpper},
+	"String.trim":          {1, 1, stringTrim},
+
+	"Time.day":      {1, 1, timeDay},
+	"Time.duration": {1, 1, timeDuration},
+	"Time.format":   {2, 2, timeFormat},
+	"Time.hour":     {1, 1, timeHour},
+	"Time.minute":   {1, 1, timeMinute},
+	"Time.month":    {1, 1, timeMonth},
+	"Time.now":      {0, 0, timeNow},
+	"Time.parse":    {2, 2, timeParse},
+	"Time.seconds":  {1, 1, timeSeconds},
+	"Time.trunc":    {2, 2, timeTrunc},
+	"Time.weekday":  {1, 1, timeWeekday},
+	"Time.year":     {1, 1, timeYear},
+}
+
+// A TypedFunction is a function whose values have container types, which
+// it creates in the type context of the flowgraph.
+type TypedFunction func(*resolver.Context, []zngnative.Value) (zngnative.Value, error)
+
+type typedFunction struct {
+	minArgs int
+	maxArgs int
+	impl    TypedFunction
+}
+
+var typedFns = map[string]typedFunction{
+	"String.split": {2, 2, stringSplit},
+}
+
+func init() {
+	// Array.map is added here since it refers to typedFns.
+	typedFns["Array.map"] = typedFunction{2, -1, arrayMap}
+}
+
+// lookupFunction returns the function with the given name from either
+// allFns or typedFns.
+func lookupFunction(name string) (typedFunction, bool) {
+	if fn, ok := allFns[name]; ok {
+		impl := fn.impl
+		return typedFunction{fn.minArgs, fn.maxArgs, func(_ *resolver.Context, args []zngnative.Value) (zngnative.Value, error) {
+			return impl(args)
+		}}, true
+	}
+	fn, ok := typedFns[name]
+	return fn, ok
 }
 
 func err(fn string, err error) (zngnative.Value, error) {
@@ -72,6 +196,295 @@ func lenFn(args []zngnative.Value) (zngnative.Value, error) {
 	}
 }
 
+func containsFn(args []zngnative.Value) (zngnative.Value, error) {
+	typ := zng.InnerType(zng.AliasedType(args[0].Type))
+	if typ == nil {
+		return err("contains", ErrBadArgument)
+	}
+	found, err := containerHas(typ, args[0].Value.(zcode.Bytes), args[1])
+	if err != nil {
+		return zngnative.Value{}, err
+	}
+	return zngnative.Value{zng.TypeBool, found}, nil
+}
+
+// elements returns the undecoded tag and body of each element of a
+// container.
+func elements(body zcode.Bytes) ([]zcode.Bytes, error) {
+	var elems []zcode.Bytes
+	for it := body.Iter(); !it.Done(); {
+		elem, _, err := it.NextTagAndBody()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+	}
+	return elems, nil
+}
+
+// arrayMap applies the function named by its second argument to each
+// element of an array, passing any remaining arguments after the element,
+// and returns the array of the results.  Unset elements remain unset.
+func arrayMap(zctx *resolver.Context, args []zngnative.Value) (zngnative.Value, error) {
+	typ, ok := zng.AliasedType(args[0].Type).(*zng.TypeArray)
+	if !ok || !isString(args[1]) {
+		return err("Array.map", ErrBadArgument)
+	}
+	name := args[1].Value.(string)
+	fn, ok := lookupFunction(name)
+	if !ok {
+		return err("Array.map", fmt.Errorf("%s: %w", name, ErrNoSuchFunction))
+	}
+	nargs := len(args) - 1
+	if nargs < fn.minArgs || (fn.maxArgs >= 0 && nargs > fn.maxArgs) {
+		return err("Array.map", ErrBadArgument)
+	}
+	fnArgs := make([]zngnative.Value, nargs)
+	copy(fnArgs[1:], args[2:])
+	// The type of the results is that of the function's result for the
+	// zero value of the element type so that it doesn't depend on the
+	// elements of the array.  If the function fails for the zero value,
+	// it's the type of the results for the elements, and the error is
+	// returned if there are none.
+	inner, zerr := mapType(zctx, fn, fnArgs, typ.Type)
+	var results []zcode.Bytes
+	for it := args[0].Value.(zcode.Bytes).Iter(); !it.Done(); {
+		b, _, ierr := it.Next()
+		if ierr != nil {
+			return zngnative.Value{}, ierr
+		}
+		if b == nil {
+			results = append(results, nil)
+			continue
+		}
+		elem, ierr := zngnative.ToNativeValue(zng.Value{typ.Type, b})
+		if ierr != nil {
+			return zngnative.Value{}, ierr
+		}
+		fnArgs[0] = elem
+		result, ierr := fn.impl(zctx, fnArgs)
+		if ierr != nil {
+			return zngnative.Value{}, ierr
+		}
+		zv, ierr := result.ToZngValue()
+		if ierr != nil {
+			return zngnative.Value{}, ierr
+		}
+		if inner == nil {
+			inner = zv.Type
+		} else if zv.Type != inner {
+			return err("Array.map", ErrIncompatibleTypes)
+		}
+		results = append(results, zv.Bytes)
+	}
+	if inner == nil {
+		return zngnative.Value{}, zerr
+	}
+	container := zng.IsContainerType(zng.AliasedType(inner))
+	body := zcode.Bytes{}
+	for _, b := range results {
+		if container {
+			body = zcode.AppendContainer(body, b)
+		} else {
+			body = zcode.AppendPrimitive(body, b)
+		}
+	}
+	return zngnative.Value{zctx.LookupTypeArray(inner), body}, nil
+}
+
+// mapType returns the type of the result of fn for the zero value of typ
+// followed by the rest of args.
+func mapType(zctx *resolver.Context, fn typedFunction, args []zngnative.Value, typ zng.Type) (zng.Type, error) {
+	zero, e := zeroValue(typ)
+	if e != nil {
+		return nil, e
+	}
+	args[0] = zero
+	result, e := fn.impl(zctx, args)
+	if e != nil {
+		return nil, e
+	}
+	zv, e := result.ToZngValue()
+	if e != nil {
+		return nil, e
+	}
+	return zv.Type, nil
+}
+
+// zeroValue returns the zero value of typ, e.g., 0 for a number, an empty
+// string, or an empty container.
+func zeroValue(typ zng.Type) (zngnative.Value, error) {
+	var zb zcode.Bytes
+	switch zng.AliasedType(typ).ID() {
+	case zng.IdBool:
+		zb = zng.EncodeBool(false)
+	case zng.IdByte:
+		zb = zng.EncodeByte(0)
+	case zng.IdInt16, zng.IdInt32, zng.IdInt64:
+		zb = zng.EncodeInt(0)
+	case zng.IdUint16, zng.IdUint32, zng.IdUint64:
+		zb = zng.EncodeUint(0)
+	case zng.IdFloat64:
+		zb = zng.EncodeFloat64(0)
+	case zng.IdIP:
+		zb = zng.EncodeIP(net.IPv4zero)
+	case zng.IdNet:
+		zb = zng.EncodeNet(&net.IPNet{IP: net.IPv4zero, Mask: net.CIDRMask(0, 32)})
+	case zng.IdPort:
+		zb = zng.EncodePort(0)
+	case zng.IdTime:
+		zb = zng.EncodeTime(0)
+	case zng.IdDuration:
+		zb = zng.EncodeDuration(0)
+	default:
+		// An empty body is an empty string or container.
+		zb = zcode.Bytes{}
+	}
+	return zngnative.ToNativeValue(zng.Value{typ, zb})
+}
+
+// arraySlice returns the elements of an array from a start index through
+// an optional end index, not inclusive.  Negative indexes count from the
+// end of the array, and the slice is truncated at either end of the array.
+func arraySlice(args []zngnative.Value) (zngnative.Value, error) {
+	if _, ok := zng.AliasedType(args[0].Type).(*zng.TypeArray); !ok {
+		return err("Array.slice", ErrBadArgument)
+	}
+	elems, e := elements(args[0].Value.(zcode.Bytes))
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	n := int64(len(elems))
+	from, ok := zngnative.CoerceNativeToInt(args[1])
+	if !ok {
+		return err("Array.slice", ErrBadArgument)
+	}
+	to := n
+	if len(args) == 3 {
+		to, ok = zngnative.CoerceNativeToInt(args[2])
+		if !ok {
+			return err("Array.slice", ErrBadArgument)
+		}
+	}
+	from = sliceIndex(from, n)
+	to = sliceIndex(to, n)
+	body := zcode.Bytes{}
+	for k := from; k < to; k++ {
+		body = append(body, elems[k]...)
+	}
+	return zngnative.Value{args[0].Type, body}, nil
+}
+
+func sliceIndex(k, n int64) int64 {
+	if k < 0 {
+		k += n
+	}
+	if k < 0 {
+		return 0
+	}
+	if k > n {
+		return n
+	}
+	return k
+}
+
+// arraySort returns the elements of an array in ascending order with any
+// unset elements last.
+func arraySort(args []zngnative.Value) (zngnative.Value, error) {
+	typ, ok := zng.AliasedType(args[0].Type).(*zng.TypeArray)
+	if !ok {
+		return err("Array.sort", ErrBadArgument)
+	}
+	var vals, unset []zcode.Bytes
+	for it := args[0].Value.(zcode.Bytes).Iter(); !it.Done(); {
+		b, _, e := it.Next()
+		if e != nil {
+			return zngnative.Value{}, e
+		}
+		if b == nil {
+			unset = append(unset, b)
+		} else {
+			vals = append(vals, b)
+		}
+	}
+	compare := lookupSorter(typ.Type)
+	sort.SliceStable(vals, func(i, j int) bool {
+		return compare(vals[i], vals[j]) < 0
+	})
+	container := zng.IsContainerType(zng.AliasedType(typ.Type))
+	body := zcode.Bytes{}
+	for _, b := range append(vals, unset...) {
+		if container {
+			body = zcode.AppendContainer(body, b)
+		} else {
+			body = zcode.AppendPrimitive(body, b)
+		}
+	}
+	return zngnative.Value{args[0].Type, body}, nil
+}
+
+func encodeBase64(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) {
+		return err("Encode.base64", ErrBadArgument)
+	}
+	s := base64.StdEncoding.EncodeToString([]byte(args[0].Value.(string)))
+	return zngnative.Value{zng.TypeString, s}, nil
+}
+
+// The decoded bytes need not be valid UTF-8, so encodeFromBase64 and
+// encodeFromHex return bstrings.
+func encodeFromBase64(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) {
+		return err("Encode.fromBase64", ErrBadArgument)
+	}
+	b, e := base64.StdEncoding.DecodeString(args[0].Value.(string))
+	if e != nil {
+		return err("Encode.fromBase64", ErrBadArgument)
+	}
+	return zngnative.Value{zng.TypeBstring, string(b)}, nil
+}
+
+func encodeFromHex(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) {
+		return err("Encode.fromHex", ErrBadArgument)
+	}
+	b, e := hex.DecodeString(args[0].Value.(string))
+	if e != nil {
+		return err("Encode.fromHex", ErrBadArgument)
+	}
+	return zngnative.Value{zng.TypeBstring, string(b)}, nil
+}
+
+func encodeHex(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) {
+		return err("Encode.hex", ErrBadArgument)
+	}
+	s := hex.EncodeToString([]byte(args[0].Value.(string)))
+	return zngnative.Value{zng.TypeString, s}, nil
+}
+
+// hashFn returns a function computing the hex-encoded digest of a string.
+func hashFn(name string, newHash func() hash.Hash) Function {
+	return func(args []zngnative.Value) (zngnative.Value, error) {
+		if !isString(args[0]) {
+			return err(name, ErrBadArgument)
+		}
+		h := newHash()
+		h.Write([]byte(args[0].Value.(string)))
+		return zngnative.Value{zng.TypeString, hex.EncodeToString(h.Sum(nil))}, nil
+	}
+}
+
+// hashFnv returns the 64-bit FNV-1a hash of a string.
+func hashFnv(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) {
+		return err("Hash.fnv", ErrBadArgument)
+	}
+	h := fnv.New64a()
+	h.Write([]byte(args[0].Value.(string)))
+	return zngnative.Value{zng.TypeUint64, h.Sum64()}, nil
+}
+
 func mathAbs(args []zngnative.Value) (zngnative.Value, error) {
 	switch args[0].Type.ID() {
 	case zng.IdInt16, zng.IdInt32, zng.IdInt64:
@@ -276,6 +689,76 @@ func mathSqrt(args []zngnative.Value) (zngnative.Value, error) {
 	return zngnative.Value{zng.TypeFloat64, r}, nil
 }
 
+// setArgs returns the bodies of the arguments of a Set function, which
+// must all be sets of the same type of element.
+func setArgs(fn string, args []zngnative.Value) ([]zcode.Bytes, error) {
+	var inner zng.Type
+	bodies := make([]zcode.Bytes, 0, len(args))
+	for _, arg := range args {
+		typ, ok := zng.AliasedType(arg.Type).(*zng.TypeSet)
+		if !ok {
+			return nil, fmt.Errorf("%s: %w", fn, ErrBadArgument)
+		}
+		if inner == nil {
+			inner = zng.AliasedType(typ.InnerType)
+		} else if zng.AliasedType(typ.InnerType) != inner {
+			return nil, fmt.Errorf("%s: %w", fn, ErrIncompatibleTypes)
+		}
+		bodies = append(bodies, arg.Value.(zcode.Bytes))
+	}
+	return bodies, nil
+}
+
+func setIntersect(args []zngnative.Value) (zngnative.Value, error) {
+	bodies, e := setArgs("Set.intersect", args)
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	// The elements of a set are sorted by their encodings, so the
+	// intersection of two sets is found by merging them.
+	result, e := elements(bodies[0])
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	for _, body := range bodies[1:] {
+		elems, e := elements(body)
+		if e != nil {
+			return zngnative.Value{}, e
+		}
+		var merged []zcode.Bytes
+		for len(result) > 0 && len(elems) > 0 {
+			switch bytes.Compare(result[0], elems[0]) {
+			case -1:
+				result = result[1:]
+			case 1:
+				elems = elems[1:]
+			default:
+				merged = append(merged, result[0])
+				result = result[1:]
+				elems = elems[1:]
+			}
+		}
+		result = merged
+	}
+	body := zcode.Bytes{}
+	for _, elem := range result {
+		body = append(body, elem...)
+	}
+	return zngnative.Value{args[0].Type, body}, nil
+}
+
+func setUnion(args []zngnative.Value) (zngnative.Value, error) {
+	bodies, e := setArgs("Set.union", args)
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	body := zcode.Bytes{}
+	for _, b := range bodies {
+		body = append(body, b...)
+	}
+	return zngnative.Value{args[0].Type, zng.NormalizeSet(body)}, nil
+}
+
 func stringByteLen(args []zngnative.Value) (zngnative.Value, error) {
 	switch args[0].Type.ID() {
 	case zng.IdString, zng.IdBstring:
@@ -362,7 +845,11 @@ func stringParseIp(args []zngnative.Value) (zngnative.Value, error) {
 }
 
 func isString(v zngnative.Value) bool {
-	i := v.Type.ID()
+	return isStringType(v.Type)
+}
+
+func isStringType(typ zng.Type) bool {
+	i := typ.ID()
 	return i == zng.IdString || i == zng.IdBstring
 }
 
@@ -384,6 +871,150 @@ func stringRuneLen(args []zngnative.Value) (zngnative.Value, error) {
 	}
 
 }
+func stringSplit(zctx *resolver.Context, args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.split", ErrBadArgument)
+	}
+	var body zcode.Bytes
+	for _, elem := range strings.Split(args[0].Value.(string), args[1].Value.(string)) {
+		body = zcode.AppendPrimitive(body, zng.EncodeString(elem))
+	}
+	return zngnative.Value{zctx.LookupTypeArray(zng.TypeString), body}, nil
+}
+
+func stringJoin(args []zngnative.Value) (zngnative.Value, error) {
+	var inner zng.Type
+	switch typ := zng.AliasedType(args[0].Type).(type) {
+	case *zng.TypeArray:
+		inner = typ.Type
+	case *zng.TypeSet:
+		inner = typ.InnerType
+	}
+	if inner == nil || !isStringType(inner) || !isString(args[1]) {
+		return err("String.join", ErrBadArgument)
+	}
+	var elems []string
+	for it := args[0].Value.(zcode.Bytes).Iter(); !it.Done(); {
+		b, _, ierr := it.Next()
+		if ierr != nil {
+			return zngnative.Value{}, ierr
+		}
+		// Unset elements are skipped.
+		if b != nil {
+			elems = append(elems, string(b))
+		}
+	}
+	s := strings.Join(elems, args[1].Value.(string))
+	return zngnative.Value{zng.TypeString, s}, nil
+}
+
+// stringSubstr returns the runes of a string from a start index, which
+// counts from the end of the string if negative, through an optional
+// length.  The substring is truncated at either end of the string.
+func stringSubstr(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) {
+		return err("String.substr", ErrBadArgument)
+	}
+	runes := []rune(args[0].Value.(string))
+	start, ok := zngnative.CoerceNativeToInt(args[1])
+	if !ok {
+		return err("String.substr", ErrBadArgument)
+	}
+	n := int64(len(runes))
+	if start < 0 {
+		start += n
+		if start < 0 {
+			start = 0
+		}
+	}
+	if start > n {
+		start = n
+	}
+	end := n
+	if len(args) == 3 {
+		length, ok := zngnative.CoerceNativeToInt(args[2])
+		if !ok || length < 0 {
+			return err("String.substr", ErrBadArgument)
+		}
+		if length < end-start {
+			end = start + length
+		}
+	}
+	return zngnative.Value{zng.TypeString, string(runes[start:end])}, nil
+}
+
+// stringIndex returns the index in runes of the first instance of a
+// substring in a string or -1 if it is not present.
+func stringIndex(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.index", ErrBadArgument)
+	}
+	s := args[0].Value.(string)
+	i := strings.Index(s, args[1].Value.(string))
+	if i > 0 {
+		i = utf8.RuneCountInString(s[:i])
+	}
+	return zngnative.Value{zng.TypeInt64, int64(i)}, nil
+}
+
+func stringStartsWith(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.startsWith", ErrBadArgument)
+	}
+	b := strings.HasPrefix(args[0].Value.(string), args[1].Value.(string))
+	return zngnative.Value{zng.TypeBool, b}, nil
+}
+
+func stringEndsWith(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.endsWith", ErrBadArgument)
+	}
+	b := strings.HasSuffix(args[0].Value.(string), args[1].Value.(string))
+	return zngnative.Value{zng.TypeBool, b}, nil
+}
+
+func stringRegexpMatch(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.regexpMatch", ErrBadArgument)
+	}
+	re, rerr := compileRegexp(args[1].Value.(string))
+	if rerr != nil {
+		return err("String.regexpMatch", rerr)
+	}
+	return zngnative.Value{zng.TypeBool, re.MatchString(args[0].Value.(string))}, nil
+}
+
+// stringRegexpExtract returns the text matched by a capture group of a
+// regular expression, which is the entire match if the group is not given.
+func stringRegexpExtract(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.regexpExtract", ErrBadArgument)
+	}
+	re, rerr := compileRegexp(args[1].Value.(string))
+	if rerr != nil {
+		return err("String.regexpExtract", rerr)
+	}
+	var group int64
+	if len(args) == 3 {
+		var ok bool
+		group, ok = zngnative.CoerceNativeToInt(args[2])
+		if !ok || group < 0 || group > int64(re.NumSubexp()) {
+			return err("String.regexpExtract", ErrBadArgument)
+		}
+	}
+	s := args[0].Value.(string)
+	match := re.FindStringSubmatchIndex(s)
+	if match == nil {
+		return err("String.regexpExtract", ErrNoMatch)
+	}
+	start, end := match[2*group], match[2*group+1]
+	if start < 0 {
+		// The group did not participate in the match.
+		return zngnative.Value{zng.TypeString, ""}, nil
+	}
+	return zngnative.Value{zng.TypeString, s[start:end]}, nil
+}
+
 func stringToLower(args []zngnative.Value) (zngnative.Value, error) {
 	if !isString(args[0]) {
 		return err("String.toLower", ErrBadArgument)
@@ -407,3 +1038,314 @@ func stringTrim(args []zngnative.Value) (zngnative.Value, error) {
 	s := strings.TrimSpace(args[0].Value.(string))
 	return zngnative.Value{zng.TypeString, s}, nil
 }
+
+// toTime returns the time in v, which must be a time value.
+func toTime(v zngnative.Value) (time.Time, bool) {
+	if v.Type.ID() != zng.IdTime {
+		return time.Time{}, false
+	}
+	return nano.Ts(v.Value.(int64)).Time(), true
+}
+
+// toDuration returns the duration in nanoseconds in v, which is a duration
+// or a number of seconds.
+func toDuration(v zngnative.Value) (int64, bool) {
+	switch v.Type.ID() {
+	case zng.IdDuration:
+		return v.Value.(int64), true
+	case zng.IdFloat64:
+		return int64(v.Value.(float64) * 1e9), true
+	case zng.IdInt16, zng.IdInt32, zng.IdInt64, zng.IdByte, zng.IdUint16, zng.IdUint32, zng.IdUint64:
+		if i, ok := zngnative.CoerceNativeToInt(v); ok {
+			return i * 1_000_000_000, true
+		}
+	}
+	return 0, false
+}
+
+// timePart returns a function that extracts a part of a time in UTC.
+func timePart(name string, part func(time.Time) int) Function {
+	return func(args []zngnative.Value) (zngnative.Value, error) {
+		t, ok := toTime(args[0])
+		if !ok {
+			return err(name, ErrBadArgument)
+		}
+		return zngnative.Value{zng.TypeInt64, int64(part(t))}, nil
+	}
+}
+
+var (
+	timeDay     = timePart("Time.day", time.Time.Day)
+	timeHour    = timePart("Time.hour", time.Time.Hour)
+	timeMinute  = timePart("Time.minute", time.Time.Minute)
+	timeMonth   = timePart("Time.month", func(t time.Time) int { return int(t.Month()) })
+	timeWeekday = timePart("Time.weekday", func(t time.Time) int { return int(t.Weekday()) })
+	timeYear    = timePart("Time.year", time.Time.Year)
+)
+
+func timeDuration(args []zngnative.Value) (zngnative.Value, error) {
+	if isString(args[0]) {
+		d, perr := time.ParseDuration(args[0].Value.(string))
+		if perr != nil {
+			return err("Time.duration", ErrBadArgument)
+		}
+		return zngnative.Value{zng.TypeDuration, int64(d)}, nil
+	}
+	d, ok := toDuration(args[0])
+	if !ok {
+		return err("Time.duration", ErrBadArgument)
+	}
+	return zngnative.Value{zng.TypeDuration, d}, nil
+}
+
+func timeFormat(args []zngnative.Value) (zngnative.Value, error) {
+	t, ok := toTime(args[0])
+	if !ok || !isString(args[1]) {
+		return err("Time.format", ErrBadArgument)
+	}
+	s, ferr := strftime(t, args[1].Value.(string))
+	if ferr != nil {
+		return err("Time.format", ErrBadArgument)
+	}
+	return zngnative.Value{zng.TypeString, s}, nil
+}
+
+func timeNow(args []zngnative.Value) (zngnative.Value, error) {
+	return zngnative.Value{zng.TypeTime, int64(nano.Now())}, nil
+}
+
+func timeParse(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("Time.parse", ErrBadArgument)
+	}
+	t, perr := strptime(args[0].Value.(string), args[1].Value.(string))
+	if perr != nil {
+		return err("Time.parse", ErrBadArgument)
+	}
+	return zngnative.Value{zng.TypeTime, int64(nano.TimeToTs(t))}, nil
+}
+
+func timeSeconds(args []zngnative.Value) (zngnative.Value, error) {
+	switch args[0].Type.ID() {
+	case zng.IdTime, zng.IdDuration:
+		return zngnative.Value{zng.TypeFloat64, float64(args[0].Value.(int64)) / 1e9}, nil
+	default:
+		return err("Time.seconds", ErrBadArgument)
+	}
+}
+
+func timeTrunc(args []zngnative.Value) (zngnative.Value, error) {
+	if args[0].Type.ID() != zng.IdTime {
+		return err("Time.trunc", ErrBadArgument)
+	}
+	d, ok := toDuration(args[1])
+	if !ok || d <= 0 {
+		return err("Time.trunc", ErrBadArgument)
+	}
+	ts := nano.Ts(args[0].Value.(int64)).Trunc(d)
+	return zngnative.Value{zng.TypeTime, int64(ts)}, nil
+}
+
+// toIP returns the IP address in v, which must be an ip value.
+func toIP(v zngnative.Value) (net.IP, bool) {
+	if v.Type.ID() != zng.IdIP {
+		return nil, false
+	}
+	return v.Value.(net.IP), true
+}
+
+// toNet returns the network in v, which is a net value or a string in
+// CIDR notation.
+func toNet(v zngnative.Value) (*net.IPNet, bool) {
+	switch v.Type.ID() {
+	case zng.IdNet:
+		return v.Value.(*net.IPNet), true
+	case zng.IdString, zng.IdBstring:
+		_, n, err := net.ParseCIDR(v.Value.(string))
+		return n, err == nil
+	}
+	return nil, false
+}
+
+func netCidrMatch(args []zngnative.Value) (zngnative.Value, error) {
+	n, ok := toNet(args[0])
+	if !ok {
+		return err("Net.cidrMatch", ErrBadArgument)
+	}
+	ip, ok := toIP(args[1])
+	if !ok {
+		return err("Net.cidrMatch", ErrBadArgument)
+	}
+	return zngnative.Value{zng.TypeBool, n.Contains(ip)}, nil
+}
+
+// netCommunityID returns the Community ID of the flow given by a protocol,
+// which is either a number or a name such as "tcp", a source address and
+// port, a destination address and port, and an optional seed.
+func netCommunityID(args []zngnative.Value) (zngnative.Value, error) {
+	var proto int
+	if isString(args[0]) {
+		p, ok := protoNumbers[strings.ToLower(args[0].Value.(string))]
+		if !ok {
+			return err("Net.communityID", ErrBadArgument)
+		}
+		proto = p
+	} else {
+		p, ok := toUint16(args[0])
+		if !ok {
+			return err("Net.communityID", ErrBadArgument)
+		}
+		proto = int(p)
+	}
+	src, ok1 := toIP(args[1])
+	sport, ok2 := toUint16(args[2])
+	dst, ok3 := toIP(args[3])
+	dport, ok4 := toUint16(args[4])
+	if !ok1 || !ok2 || !ok3 || !ok4 {
+		return err("Net.communityID", ErrBadArgument)
+	}
+	var seed uint16
+	if len(args) == 6 {
+		var ok bool
+		if seed, ok = toUint16(args[5]); !ok {
+			return err("Net.communityID", ErrBadArgument)
+		}
+	}
+	id, e := communityID(seed, proto, src, sport, dst, dport)
+	if e != nil {
+		return err("Net.communityID", ErrBadArgument)
+	}
+	return zngnative.Value{zng.TypeString, id}, nil
+}
+
+// toUint16 returns the value of a port or an integer that fits in 16 bits.
+func toUint16(v zngnative.Value) (uint16, bool) {
+	var u uint64
+	switch v.Type.ID() {
+	case zng.IdPort, zng.IdByte, zng.IdUint16, zng.IdUint32, zng.IdUint64:
+		u = v.Value.(uint64)
+	case zng.IdInt16, zng.IdInt32, zng.IdInt64:
+		i := v.Value.(int64)
+		if i < 0 {
+			return 0, false
+		}
+		u = uint64(i)
+	default:
+		return 0, false
+	}
+	if u > math.MaxUint16 {
+		return 0, false
+	}
+	return uint16(u), true
+}
+
+func netFamily(args []zngnative.Value) (zngnative.Value, error) {
+	var ip net.IP
+	switch args[0].Type.ID() {
+	case zng.IdIP:
+		ip = args[0].Value.(net.IP)
+	case zng.IdNet:
+		ip = args[0].Value.(*net.IPNet).IP
+	default:
+		return err("Net.family", ErrBadArgument)
+	}
+	if ip.To4() != nil {
+		return zngnative.Value{zng.TypeInt64, int64(4)}, nil
+	}
+	return zngnative.Value{zng.TypeInt64, int64(6)}, nil
+}
+
+func netFromInt(args []zngnative.Value) (zngnative.Value, error) {
+	u, ok := zngnative.CoerceNativeToUint(args[0])
+	if !ok || u > math.MaxUint32 {
+		return err("Net.fromInt", ErrBadArgument)
+	}
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, uint32(u))
+	return zngnative.Value{zng.TypeIP, ip}, nil
+}
+
+// ipPredicate returns a function that applies pred to an IP address.
+func ipPredicate(name string, pred func(net.IP) bool) Function {
+	return func(args []zngnative.Value) (zngnative.Value, error) {
+		ip, ok := toIP(args[0])
+		if !ok {
+			return err(name, ErrBadArgument)
+		}
+		return zngnative.Value{zng.TypeBool, pred(ip)}, nil
+	}
+}
+
+// The private address ranges of RFC 1918 and the IPv6 unique local
+// addresses of RFC 4193.
+var privateNets = []*net.IPNet{
+	{IP: net.IPv4(10, 0, 0, 0), Mask: net.CIDRMask(8, 32)},
+	{IP: net.IPv4(172, 16, 0, 0), Mask: net.CIDRMask(12, 32)},
+	{IP: net.IPv4(192, 168, 0, 0), Mask: net.CIDRMask(16, 32)},
+	{IP: net.IP{0xfc, 15: 0}, Mask: net.CIDRMask(7, 128)},
+}
+
+func isPrivate(ip net.IP) bool {
+	for _, n := range privateNets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	netIsLoopback  = ipPredicate("Net.isLoopback", net.IP.IsLoopback)
+	netIsMulticast = ipPredicate("Net.isMulticast", net.IP.IsMulticast)
+	netIsPrivate   = ipPredicate("Net.isPrivate", isPrivate)
+)
+
+// netNetworkOf returns the network of an IP address given the length of
+// its prefix or its mask.
+func netNetworkOf(args []zngnative.Value) (zngnative.Value, error) {
+	ip, ok := toIP(args[0])
+	if !ok {
+		return err("Net.networkOf", ErrBadArgument)
+	}
+	bits := 8 * net.IPv6len
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+		bits = 8 * net.IPv4len
+	}
+	var mask net.IPMask
+	if args[1].Type.ID() == zng.IdIP {
+		m := args[1].Value.(net.IP)
+		if len(ip) == net.IPv4len {
+			m = m.To4()
+		}
+		mask = net.IPMask(m)
+		if ones, _ := mask.Size(); ones == 0 && !m.IsUnspecified() {
+			// The mask is not a run of ones followed by zeros.
+			return err("Net.networkOf", ErrBadArgument)
+		}
+	} else {
+		ones, ok := zngnative.CoerceNativeToInt(args[1])
+		if !ok || ones < 0 || ones > int64(bits) {
+			return err("Net.networkOf", ErrBadArgument)
+		}
+		mask = net.CIDRMask(int(ones), bits)
+	}
+	if len(mask) != len(ip) {
+		return err("Net.networkOf", ErrBadArgument)
+	}
+	n := &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+	return zngnative.Value{zng.TypeNet, n}, nil
+}
+
+func netToInt(args []zngnative.Value) (zngnative.Value, error) {
+	ip, ok := toIP(args[0])
+	if !ok {
+		return err("Net.toInt", ErrBadArgument)
+	}
+	v4 := ip.To4()
+	if v4 == nil {
+		// An IPv6 address doesn't fit in a uint64.
+		return err("Net.toInt", ErrBadArgument)
+	}
+	return zngnative.Value{zng.TypeUint64, uint64(binary.BigEndian.Uint32(v4))}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zng"
 	"github.com/stretchr/testify/require"
 )
@@ -15,6 +16,14 @@ func zaddr(addr string) zng.Value {
 	return zng.Value{zng.TypeIP, zng.EncodeIP(parsed)}
 }
 
+func znet(cidr string) zng.Value {
+	_, parsed, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return zng.Value{zng.TypeNet, zng.EncodeNet(parsed)}
+}
+
 func TestBadFunction(t *testing.T) {
 	testError(t, "notafunction()", nil, expr.ErrNoSuchFunction, "calling nonexistent function")
 }
@@ -248,3 +257,258 @@ func TestLen(t *testing.T) {
 	testSuccessful(t, `String.runeLen(bs)`, record, zint64(1))
 	testSuccessful(t, `String.runeLen(bs2)`, record, zint64(4))
 }
+
+func ztime(s string) zng.Value {
+	ts, err := nano.ParseTs(s)
+	if err != nil {
+		panic(err)
+	}
+	return zng.Value{zng.TypeTime, zng.EncodeTime(ts)}
+}
+
+func zduration(d int64) zng.Value {
+	return zng.Value{zng.TypeDuration, zng.EncodeDuration(d)}
+}
+
+func TestTime(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[ts:time,d:duration,s:string]
+0:[1425565514.419939;90;2015-03-05T14:25:14Z;]`)
+	require.NoError(t, err)
+
+	testSuccessful(t, "Time.trunc(ts, 3600)", record, ztime("1425564000"))
+	testSuccessful(t, "Time.trunc(ts, d)", record, ztime("1425565440"))
+	testSuccessful(t, "Time.trunc(ts, 0.5)", record, ztime("1425565514"))
+	testError(t, "Time.trunc(ts)", record, expr.ErrTooFewArgs, "trunc() with one arg")
+	testError(t, "Time.trunc(ts, 0)", record, expr.ErrBadArgument, "trunc() to zero duration")
+	testError(t, "Time.trunc(1, 1)", record, expr.ErrBadArgument, "trunc() of non-time")
+
+	testSuccessful(t, "Time.year(ts)", record, zint64(2015))
+	testSuccessful(t, "Time.month(ts)", record, zint64(3))
+	testSuccessful(t, "Time.day(ts)", record, zint64(5))
+	testSuccessful(t, "Time.weekday(ts)", record, zint64(4))
+	testSuccessful(t, "Time.hour(ts)", record, zint64(14))
+	testSuccessful(t, "Time.minute(ts)", record, zint64(25))
+	testError(t, "Time.hour()", record, expr.ErrTooFewArgs, "hour() with no args")
+	testError(t, `Time.hour("foo")`, record, expr.ErrBadArgument, "hour() of non-time")
+
+	testSuccessful(t, `Time.format(ts, "%Y-%m-%dT%H:%M:%S.%fZ")`, record, zstring("2015-03-05T14:25:14.419939Z"))
+	testSuccessful(t, `Time.format(ts, "%a %e %b %y %I%p day %j, %s%%")`, record, zstring("Thu  5 Mar 15 02PM day 064, 1425565514%"))
+	testError(t, `Time.format(ts, "%Q")`, record, expr.ErrBadArgument, "format() with bad layout")
+	testError(t, `Time.format(ts)`, record, expr.ErrTooFewArgs, "format() with no layout")
+
+	testSuccessful(t, `Time.parse(s, "%FT%TZ")`, record, ztime("1425565514"))
+	testSuccessful(t, `Time.parse("2015-03-05 14:25:14.5 -0100", "%Y-%m-%d %H:%M:%S.%f %z")`, record, ztime("1425569114.5"))
+	testSuccessful(t, `Time.parse("Mar 5, 2015 2:25 pm", "%b %d, %Y %I:%M %p")`, record, ztime("1425565500"))
+	testSuccessful(t, `Time.parse("1425565514", "%s")`, record, ztime("1425565514"))
+	testSuccessful(t, `Time.parse("2015 064", "%Y %j")`, record, ztime("1425513600"))
+	testError(t, `Time.parse(s, "%Y")`, record, expr.ErrBadArgument, "parse() with extra text")
+	testError(t, `Time.parse("2015-02-30", "%F")`, record, expr.ErrBadArgument, "parse() of bad date")
+	testError(t, `Time.parse(s, 1)`, record, expr.ErrBadArgument, "parse() with non-string layout")
+
+	testSuccessful(t, `Time.duration("1h30m")`, record, zduration(5400_000_000_000))
+	testSuccessful(t, "Time.duration(1.5)", record, zduration(1_500_000_000))
+	testSuccessful(t, "Time.duration(d)", record, zduration(90_000_000_000))
+	testError(t, `Time.duration("forever")`, record, expr.ErrBadArgument, "duration() of bad string")
+	testError(t, "Time.duration(ts)", record, expr.ErrBadArgument, "duration() of time")
+
+	testSuccessful(t, "Time.seconds(d)", record, zfloat64(90))
+	testSuccessful(t, "Time.seconds(ts)", record, zfloat64(1425565514.419939))
+	testError(t, "Time.seconds(1)", record, expr.ErrBadArgument, "seconds() of number")
+
+	testError(t, "Time.now(1)", record, expr.ErrTooManyArgs, "now() with an arg")
+}
+
+func TestNet(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[a:ip,a6:ip,n:net]
+0:[10.1.2.3;fd00::1;10.0.0.0/8;]`)
+	require.NoError(t, err)
+
+	testSuccessful(t, "Net.networkOf(a, 24)", record, znet("10.1.2.0/24"))
+	testSuccessful(t, "Net.networkOf(a, 255.255.0.0)", record, znet("10.1.0.0/16"))
+	testSuccessful(t, "Net.networkOf(a, 0)", record, znet("0.0.0.0/0"))
+	testSuccessful(t, "Net.networkOf(a6, 64)", record, znet("fd00::/64"))
+	testError(t, "Net.networkOf(a, 33)", record, expr.ErrBadArgument, "networkOf() with bad prefix length")
+	testError(t, "Net.networkOf(a, 255.0.255.0)", record, expr.ErrBadArgument, "networkOf() with non-canonical mask")
+	testError(t, "Net.networkOf(a6, 255.255.0.0)", record, expr.ErrBadArgument, "networkOf() with IPv4 mask of IPv6 address")
+	testError(t, "Net.networkOf(a)", record, expr.ErrTooFewArgs, "networkOf() with no prefix")
+	testError(t, `Net.networkOf("10.1.2.3", 8)`, record, expr.ErrBadArgument, "networkOf() of non-ip")
+
+	testSuccessful(t, "Net.cidrMatch(n, a)", record, zbool(true))
+	testSuccessful(t, `Net.cidrMatch("192.168.0.0/16", a)`, record, zbool(false))
+	testSuccessful(t, "Net.cidrMatch(fc00::/7, a6)", record, zbool(true))
+	testError(t, `Net.cidrMatch("foo", a)`, record, expr.ErrBadArgument, "cidrMatch() with bad network")
+	testError(t, "Net.cidrMatch(n, n)", record, expr.ErrBadArgument, "cidrMatch() of non-ip")
+
+	testSuccessful(t, "Net.isPrivate(a)", record, zbool(true))
+	testSuccessful(t, "Net.isPrivate(a6)", record, zbool(true))
+	testSuccessful(t, "Net.isPrivate(172.32.0.1)", record, zbool(false))
+	testSuccessful(t, "Net.isLoopback(127.0.0.1)", record, zbool(true))
+	testSuccessful(t, "Net.isLoopback(::1)", record, zbool(true))
+	testSuccessful(t, "Net.isLoopback(a)", record, zbool(false))
+	testSuccessful(t, "Net.isMulticast(224.0.0.251)", record, zbool(true))
+	testSuccessful(t, "Net.isMulticast(a)", record, zbool(false))
+	testError(t, "Net.isPrivate(n)", record, expr.ErrBadArgument, "isPrivate() of non-ip")
+
+	testSuccessful(t, "Net.family(a)", record, zint64(4))
+	testSuccessful(t, "Net.family(a6)", record, zint64(6))
+	testSuccessful(t, "Net.family(n)", record, zint64(4))
+	testError(t, "Net.family(1)", record, expr.ErrBadArgument, "family() of number")
+
+	testSuccessful(t, "Net.toInt(a)", record, zuint64(167838211))
+	testSuccessful(t, "Net.fromInt(167838211)", record, zaddr("10.1.2.3"))
+	testError(t, "Net.toInt(a6)", record, expr.ErrBadArgument, "toInt() of IPv6 address")
+	testError(t, "Net.fromInt(-1)", record, expr.ErrBadArgument, "fromInt() of negative number")
+}
+
+func TestStringSplitJoin(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[s:string,a:array[string],st:set[bstring],ai:array[int32]]
+0:[a.b..c;[x;-;y;][p;q;][1;2;]]`)
+	require.NoError(t, err)
+
+	testSuccessful(t, `len(String.split(s, "."))`, record, zint64(4))
+	testSuccessful(t, `String.join(String.split(s, "."), "/")`, record, zstring("a/b//c"))
+	testSuccessful(t, `String.join(a, ", ")`, record, zstring("x, y"))
+	testSuccessful(t, `String.join(st, "")`, record, zstring("pq"))
+	testError(t, `String.split(s)`, record, expr.ErrTooFewArgs, "split() with no separator")
+	testError(t, `String.split(s, 1)`, record, expr.ErrBadArgument, "split() with non-string separator")
+	testError(t, `String.join(s, ",")`, record, expr.ErrBadArgument, "join() of non-container")
+	testError(t, `String.join(ai, ",")`, record, expr.ErrBadArgument, "join() of non-strings")
+}
+
+func TestSubstrings(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[s:string]
+0:[🍺 www.example.com;]`)
+	require.NoError(t, err)
+
+	testSuccessful(t, `String.substr(s, 2)`, record, zstring("www.example.com"))
+	testSuccessful(t, `String.substr(s, 2, 3)`, record, zstring("www"))
+	testSuccessful(t, `String.substr(s, -3)`, record, zstring("com"))
+	testSuccessful(t, `String.substr(s, -100, 1)`, record, zstring("🍺"))
+	testSuccessful(t, `String.substr(s, 100)`, record, zstring(""))
+	testError(t, `String.substr(s, 1, -1)`, record, expr.ErrBadArgument, "substr() with negative length")
+	testError(t, `String.substr(s, "a")`, record, expr.ErrBadArgument, "substr() with non-integer start")
+
+	testSuccessful(t, `String.index(s, "www")`, record, zint64(2))
+	testSuccessful(t, `String.index(s, "🍺")`, record, zint64(0))
+	testSuccessful(t, `String.index(s, "org")`, record, zint64(-1))
+	testError(t, `String.index(s, 1)`, record, expr.ErrBadArgument, "index() of non-string")
+
+	testSuccessful(t, `String.startsWith(s, "🍺 w")`, record, zbool(true))
+	testSuccessful(t, `String.startsWith(s, "www")`, record, zbool(false))
+	testSuccessful(t, `String.endsWith(s, ".com")`, record, zbool(true))
+	testSuccessful(t, `String.endsWith(s, ".org")`, record, zbool(false))
+	testError(t, `String.endsWith(s)`, record, expr.ErrTooFewArgs, "endsWith() with no suffix")
+}
+
+func TestRegexpFuncs(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[s:string]
+0:[mail.example.co.uk;]`)
+	require.NoError(t, err)
+
+	testSuccessful(t, `String.regexpMatch(s, "^mail\\.")`, record, zbool(true))
+	testSuccessful(t, `String.regexpMatch(s, "^www\\.")`, record, zbool(false))
+	testError(t, `String.regexpMatch(s, "(")`, record, expr.ErrBadArgument, "regexpMatch() with bad regexp")
+
+	testSuccessful(t, `String.regexpExtract(s, "[^.]+\\.[^.]+$")`, record, zstring("co.uk"))
+	testSuccessful(t, `String.regexpExtract(s, "^([^.]+)\\.(.*)$", 2)`, record, zstring("example.co.uk"))
+	testSuccessful(t, `String.regexpExtract(s, "^(x)?mail", 1)`, record, zstring(""))
+	testError(t, `String.regexpExtract(s, "org$")`, record, expr.ErrNoMatch, "regexpExtract() without match")
+	testError(t, `String.regexpExtract(s, "(a)", 2)`, record, expr.ErrBadArgument, "regexpExtract() of missing group")
+	testError(t, `String.regexpExtract(s)`, record, expr.ErrTooFewArgs, "regexpExtract() with no regexp")
+}
+
+func TestContainerFuncs(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[a:array[int64],s:set[string],u:set[string],w:array[string],r:record[x:int64]]
+0:[[3;1;-;2;][b;a;][a;c;][x;Yy;][5;]]`)
+	require.NoError(t, err)
+
+	testSuccessful(t, `contains(a, 2)`, record, zbool(true))
+	testSuccessful(t, `contains(a, 4)`, record, zbool(false))
+	testSuccessful(t, `contains(s, "b")`, record, zbool(true))
+	testError(t, `contains(r, 5)`, record, expr.ErrBadArgument, "contains() of non-container")
+
+	testSuccessful(t, `Array.sort(a)[0]`, record, zint64(1))
+	testSuccessful(t, `Array.sort(a)[2]`, record, zint64(3))
+	testSuccessful(t, `len(Array.sort(a))`, record, zint64(4))
+	testError(t, `Array.sort(s)`, record, expr.ErrBadArgument, "sort() of set")
+
+	testSuccessful(t, `len(Array.slice(a, 1))`, record, zint64(3))
+	testSuccessful(t, `Array.slice(a, 1, 2)[0]`, record, zint64(1))
+	testSuccessful(t, `Array.slice(a, -1)[0]`, record, zint64(2))
+	testSuccessful(t, `len(Array.slice(a, 3, 1))`, record, zint64(0))
+	testSuccessful(t, `len(Array.slice(a, -100, 100))`, record, zint64(4))
+	testError(t, `Array.slice(a, "a")`, record, expr.ErrBadArgument, "slice() with non-integer start")
+
+	testSuccessful(t, `String.join(Set.union(s, u), ",")`, record, zstring("a,b,c"))
+	testSuccessful(t, `String.join(Set.intersect(s, u), ",")`, record, zstring("a"))
+	testSuccessful(t, `len(Set.intersect(s, u, Set.union(u, s)))`, record, zint64(1))
+	testError(t, `Set.union(s, w)`, record, expr.ErrBadArgument, "union() with array")
+
+	testSuccessful(t, `String.join(Array.map(w, "String.toUpper"), ",")`, record, zstring("X,YY"))
+	testSuccessful(t, `Array.map(a, "Math.pow", 2)[0]`, record, zfloat64(9))
+	testSuccessful(t, `len(Array.map(w, "String.split", "")[1])`, record, zint64(2))
+	testError(t, `Array.map(a, "Math.mod")`, record, expr.ErrBadArgument, "map() with too few arguments")
+	testError(t, `Array.map(a, "nope")`, record, expr.ErrNoSuchFunction, "map() of unknown function")
+	testError(t, `Array.map(w, "Math.sqrt")`, record, expr.ErrBadArgument, "map() with function error")
+}
+
+func TestHas(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[x:int64,r:record[y:string,i:int64]]
+0:[-;[a;-;]]`)
+	require.NoError(t, err)
+
+	testSuccessful(t, `has(x)`, record, zbool(true))
+	testSuccessful(t, `has(r.y)`, record, zbool(true))
+	testSuccessful(t, `has(x, r.y)`, record, zbool(true))
+	testSuccessful(t, `has(r.i)`, record, zbool(true))
+	testSuccessful(t, `has(r.z)`, record, zbool(false))
+	testSuccessful(t, `has(q.z)`, record, zbool(false))
+	testSuccessful(t, `has(x, z)`, record, zbool(false))
+	testError(t, `has()`, record, expr.ErrTooFewArgs, "has() with no arguments")
+}
+
+func TestHashEncode(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[s:string,n:int64]
+0:[hello;1;]`)
+	require.NoError(t, err)
+
+	testSuccessful(t, `Hash.md5(s)`, record, zstring("5d41402abc4b2a76b9719d911017c592"))
+	testSuccessful(t, `Hash.sha1(s)`, record, zstring("aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"))
+	testSuccessful(t, `Hash.sha256(s)`, record, zstring("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"))
+	testSuccessful(t, `Hash.fnv(s)`, record, zuint64(0xa430d84680aabd0b))
+	testError(t, `Hash.md5(n)`, record, expr.ErrBadArgument, "md5() of non-string")
+
+	testSuccessful(t, `Encode.base64(s)`, record, zstring("aGVsbG8="))
+	testSuccessful(t, `Encode.hex(s)`, record, zstring("68656c6c6f"))
+	testSuccessful(t, `Encode.fromBase64("aGVsbG8=")`, record, zbstring("hello"))
+	testSuccessful(t, `Encode.fromHex("68656c6c6f")`, record, zbstring("hello"))
+	testError(t, `Encode.fromBase64("a")`, record, expr.ErrBadArgument, "fromBase64() of bad input")
+	testError(t, `Encode.fromHex("xy")`, record, expr.ErrBadArgument, "fromHex() of bad input")
+	testError(t, `Encode.hex(n)`, record, expr.ErrBadArgument, "hex() of non-string")
+}
+
+func TestCommunityID(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[proto:string,src:ip,sport:port,dst:ip,dport:port,v6:ip]
+0:[tcp;128.232.110.120;34855;66.35.250.204;80;fe80::1;]`)
+	require.NoError(t, err)
+
+	// The expected IDs are from the baseline of the Community ID spec.
+	testSuccessful(t, `Net.communityID(proto, src, sport, dst, dport)`, record, zstring("1:LQU9qZlK+B5F3KDmev6m5PMibrg="))
+	testSuccessful(t, `Net.communityID(6, dst, dport, src, sport)`, record, zstring("1:LQU9qZlK+B5F3KDmev6m5PMibrg="))
+	testSuccessful(t, `Net.communityID("udp", 192.168.1.52, 54585, 8.8.8.8, 53)`, record, zstring("1:d/FP5EW3wiY1vCndhwleRRKHowQ="))
+	testSuccessful(t, `Net.communityID("icmp", 192.168.0.89, 8, 192.168.0.1, 0)`, record, zstring("1:X0snYXpgwiv9TZtqg64sgzUn6Dk="))
+	testSuccessful(t, `Net.communityID("icmp", 192.168.0.1, 0, 192.168.0.89, 0)`, record, zstring("1:X0snYXpgwiv9TZtqg64sgzUn6Dk="))
+	testError(t, `Net.communityID("gre", src, sport, dst, dport)`, record, expr.ErrBadArgument, "communityID() of unknown protocol")
+	testError(t, `Net.communityID(proto, src, sport, v6, dport)`, record, expr.ErrBadArgument, "communityID() of mixed families")
+	testError(t, `Net.communityID(proto, src, 65536, dst, dport)`, record, expr.ErrBadArgument, "communityID() with bad port")
+	testError(t, `Net.communityID(proto, src, sport, dst)`, record, expr.ErrTooFewArgs, "communityID() with no destination port")
+}
@@ -0,0 +1,358 @@
+package expr
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The Time.format and Time.parse functions take strftime-style layouts,
+// which are made up of literal text and the conversions
+//
+//   %Y  year                      %H  hour (00-23)
+//   %y  year in the century       %I  hour (01-12)
+//   %m  month (01-12)             %p  AM or PM
+//   %b  abbreviated month name    %M  minute (00-59)
+//   %B  full month name           %S  second (00-60)
+//   %d  day of the month (01-31)  %f  microseconds (000000-999999)
+//   %e  day of the month ( 1-31)  %z  UTC offset (+hhmm)
+//   %j  day of the year (001-366) %Z  time zone name (UTC)
+//   %a  abbreviated weekday name  %s  seconds since the Unix epoch
+//   %A  full weekday name         %%  a literal %
+//   %F  same as %Y-%m-%d          %T  same as %H:%M:%S
+//
+// Times are formatted in UTC.  When parsing, %f accepts from one to nine
+// digits, and fields missing from the layout default to those of the
+// Unix epoch.
+
+var errLayout = errors.New("bad time layout")
+
+// strftime returns t formatted according to layout.
+func strftime(t time.Time, layout string) (string, error) {
+	t = t.UTC()
+	var b strings.Builder
+	for k := 0; k < len(layout); k++ {
+		c := layout[k]
+		if c != '%' {
+			b.WriteByte(c)
+			continue
+		}
+		k++
+		if k == len(layout) {
+			return "", errLayout
+		}
+		switch layout[k] {
+		case 'Y':
+			b.WriteString(pad(t.Year(), 4, '0'))
+		case 'y':
+			b.WriteString(pad(t.Year()%100, 2, '0'))
+		case 'm':
+			b.WriteString(pad(int(t.Month()), 2, '0'))
+		case 'b':
+			b.WriteString(t.Month().String()[:3])
+		case 'B':
+			b.WriteString(t.Month().String())
+		case 'd':
+			b.WriteString(pad(t.Day(), 2, '0'))
+		case 'e':
+			b.WriteString(pad(t.Day(), 2, ' '))
+		case 'j':
+			b.WriteString(pad(t.YearDay(), 3, '0'))
+		case 'a':
+			b.WriteString(t.Weekday().String()[:3])
+		case 'A':
+			b.WriteString(t.Weekday().String())
+		case 'F':
+			b.WriteString(t.Format("2006-01-02"))
+		case 'H':
+			b.WriteString(pad(t.Hour(), 2, '0'))
+		case 'I':
+			h := t.Hour() % 12
+			if h == 0 {
+				h = 12
+			}
+			b.WriteString(pad(h, 2, '0'))
+		case 'p':
+			if t.Hour() < 12 {
+				b.WriteString("AM")
+			} else {
+				b.WriteString("PM")
+			}
+		case 'M':
+			b.WriteString(pad(t.Minute(), 2, '0'))
+		case 'S':
+			b.WriteString(pad(t.Second(), 2, '0'))
+		case 'f':
+			b.WriteString(pad(t.Nanosecond()/1000, 6, '0'))
+		case 'T':
+			b.WriteString(t.Format("15:04:05"))
+		case 'z':
+			b.WriteString("+0000")
+		case 'Z':
+			b.WriteString("UTC")
+		case 's':
+			b.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case '%':
+			b.WriteByte('%')
+		default:
+			return "", errLayout
+		}
+	}
+	return b.String(), nil
+}
+
+func pad(n, width int, c byte) string {
+	s := strconv.Itoa(n)
+	if len(s) >= width {
+		return s
+	}
+	return strings.Repeat(string(c), width-len(s)) + s
+}
+
+// timeParser holds the fields of a time parsed by strptime.
+type timeParser struct {
+	s       string
+	year    int
+	month   int
+	day     int
+	yday    int
+	hour    int
+	pm      int // 0 if %p is absent, 1 for AM, and 2 for PM
+	minute  int
+	second  int
+	nsec    int
+	offset  int
+	unix    int64
+	hasUnix bool
+}
+
+// strptime returns the time in s as described by layout.
+func strptime(s, layout string) (time.Time, error) {
+	p := timeParser{s: s, year: 1970, month: 1, day: 1}
+	if err := p.parse(layout); err != nil {
+		return time.Time{}, err
+	}
+	if p.s != "" {
+		return time.Time{}, errors.New("extra text in time")
+	}
+	if p.hasUnix {
+		return time.Unix(p.unix, int64(p.nsec)).UTC(), nil
+	}
+	if p.pm != 0 {
+		if p.hour < 1 || p.hour > 12 {
+			return time.Time{}, errors.New("hour out of range")
+		}
+		p.hour %= 12
+		if p.pm == 2 {
+			p.hour += 12
+		}
+	}
+	if p.month < 1 || p.month > 12 || p.day < 1 || p.day > 31 || p.hour > 23 || p.minute > 59 || p.second > 60 {
+		return time.Time{}, errors.New("time field out of range")
+	}
+	zone := time.FixedZone("", p.offset)
+	var t time.Time
+	if p.yday > 0 {
+		t = time.Date(p.year, time.January, p.yday, p.hour, p.minute, p.second, p.nsec, zone)
+	} else {
+		t = time.Date(p.year, time.Month(p.month), p.day, p.hour, p.minute, p.second, p.nsec, zone)
+		if t.Day() != p.day {
+			return time.Time{}, errors.New("day out of range")
+		}
+	}
+	return t.UTC(), nil
+}
+
+func (p *timeParser) parse(layout string) error {
+	for k := 0; k < len(layout); k++ {
+		c := layout[k]
+		if c != '%' {
+			if p.s == "" || p.s[0] != c {
+				return errors.New("time does not match layout")
+			}
+			p.s = p.s[1:]
+			continue
+		}
+		k++
+		if k == len(layout) {
+			return errLayout
+		}
+		var err error
+		switch layout[k] {
+		case 'Y':
+			p.year, err = p.number(4, 4)
+		case 'y':
+			p.year, err = p.number(2, 2)
+			if p.year < 69 {
+				p.year += 2000
+			} else {
+				p.year += 1900
+			}
+		case 'm':
+			p.month, err = p.number(1, 2)
+		case 'b', 'B':
+			p.month, err = p.name(months)
+		case 'd':
+			p.day, err = p.number(1, 2)
+		case 'e':
+			p.s = strings.TrimPrefix(p.s, " ")
+			p.day, err = p.number(1, 2)
+		case 'j':
+			p.yday, err = p.number(1, 3)
+			if err == nil && (p.yday < 1 || p.yday > 366) {
+				err = errors.New("day of year out of range")
+			}
+		case 'a', 'A':
+			_, err = p.name(weekdays)
+		case 'F':
+			err = p.parse("%Y-%m-%d")
+		case 'H', 'I':
+			p.hour, err = p.number(1, 2)
+		case 'p':
+			switch {
+			case strings.HasPrefix(strings.ToUpper(p.s), "AM"):
+				p.pm = 1
+			case strings.HasPrefix(strings.ToUpper(p.s), "PM"):
+				p.pm = 2
+			default:
+				return errors.New("expected AM or PM")
+			}
+			p.s = p.s[2:]
+		case 'M':
+			p.minute, err = p.number(1, 2)
+		case 'S':
+			p.second, err = p.number(1, 2)
+		case 'f':
+			err = p.fraction()
+		case 'T':
+			err = p.parse("%H:%M:%S")
+		case 'z':
+			err = p.zone()
+		case 'Z':
+			switch {
+			case strings.HasPrefix(p.s, "UTC"), strings.HasPrefix(p.s, "GMT"):
+				p.s = p.s[3:]
+			case strings.HasPrefix(p.s, "Z"):
+				p.s = p.s[1:]
+			default:
+				err = errors.New("unknown time zone")
+			}
+		case 's':
+			err = p.epoch()
+		case '%':
+			if !strings.HasPrefix(p.s, "%") {
+				return errors.New("time does not match layout")
+			}
+			p.s = p.s[1:]
+		default:
+			return errLayout
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// number parses an unsigned decimal number of from min to max digits.
+func (p *timeParser) number(min, max int) (int, error) {
+	n := 0
+	for n < max && n < len(p.s) && p.s[n] >= '0' && p.s[n] <= '9' {
+		n++
+	}
+	if n < min {
+		return 0, errors.New("expected number in time")
+	}
+	v, _ := strconv.Atoi(p.s[:n])
+	p.s = p.s[n:]
+	return v, nil
+}
+
+var months = []string{
+	"january", "february", "march", "april", "may", "june", "july",
+	"august", "september", "october", "november", "december",
+}
+
+var weekdays = []string{
+	"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday",
+}
+
+// name parses a full or three-letter abbreviated name from names, ignoring
+// case, and returns its index plus one.
+func (p *timeParser) name(names []string) (int, error) {
+	lower := strings.ToLower(p.s)
+	for k, name := range names {
+		if strings.HasPrefix(lower, name) {
+			p.s = p.s[len(name):]
+			return k + 1, nil
+		}
+		if strings.HasPrefix(lower, name[:3]) {
+			p.s = p.s[3:]
+			return k + 1, nil
+		}
+	}
+	return 0, errors.New("expected name in time")
+}
+
+func (p *timeParser) fraction() error {
+	n := 0
+	for n < 9 && n < len(p.s) && p.s[n] >= '0' && p.s[n] <= '9' {
+		n++
+	}
+	if n == 0 {
+		return errors.New("expected fraction in time")
+	}
+	v, _ := strconv.Atoi(p.s[:n])
+	for k := n; k < 9; k++ {
+		v *= 10
+	}
+	p.nsec = v
+	p.s = p.s[n:]
+	return nil
+}
+
+func (p *timeParser) zone() error {
+	if strings.HasPrefix(p.s, "Z") {
+		p.s = p.s[1:]
+		p.offset = 0
+		return nil
+	}
+	if p.s == "" || (p.s[0] != '+' && p.s[0] != '-') {
+		return errors.New("expected UTC offset in time")
+	}
+	sign := 1
+	if p.s[0] == '-' {
+		sign = -1
+	}
+	p.s = p.s[1:]
+	hh, err := p.number(2, 2)
+	if err != nil {
+		return err
+	}
+	p.s = strings.TrimPrefix(p.s, ":")
+	mm, err := p.number(2, 2)
+	if err != nil {
+		return err
+	}
+	p.offset = sign * (hh*3600 + mm*60)
+	return nil
+}
+
+func (p *timeParser) epoch() error {
+	n := 0
+	if strings.HasPrefix(p.s, "-") {
+		n++
+	}
+	for n < len(p.s) && p.s[n] >= '0' && p.s[n] <= '9' {
+		n++
+	}
+	v, err := strconv.ParseInt(p.s[:n], 10, 64)
+	if err != nil {
+		return errors.New("expected seconds in time")
+	}
+	p.unix = v
+	p.hasUnix = true
+	p.s = p.s[n:]
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/brimsec/zq/pkg/byteconv"
 	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zngnative"
 )
 
@@ -258,32 +259,86 @@ func searchRecordString(term string) Filter {
 	}
 }
 
-func Compile(node ast.BooleanExpr) (Filter, error) {
+// compileEvaluate returns a Filter that matches the records for which the
+// expression evaluates to true.  A record for which the expression cannot
+// be evaluated, e.g., because a field is missing, does not match.
+func compileEvaluate(zctx *resolver.Context, node *ast.Evaluate) (Filter, error) {
+	eval, err := expr.CompileExpr(zctx, node.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return func(r *zng.Record) bool {
+		v, err := eval(r)
+		if err != nil || v.Type != zng.TypeBool || v.Bytes == nil {
+			return false
+		}
+		b, err := zng.DecodeBool(v.Bytes)
+		return err == nil && b
+	}, nil
+}
+
+// compileCompareBareword returns a Filter that compares the field of node
+// with its value field in the records that have it and with the bare word
+// naming the value field in the others.
+func compileCompareBareword(zctx *resolver.Context, node *ast.CompareField) (Filter, error) {
+	compareValue, err := CompileFieldCompare(&ast.CompareField{
+		Comparator: node.Comparator,
+		Field:      node.Field,
+		Value:      node.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+	compareField, err := compileEvaluate(zctx, &ast.Evaluate{
+		Expr: &ast.BinaryExpression{
+			Operator: node.Comparator,
+			LHS:      node.Field.(ast.Expression),
+			RHS:      node.ValueField.(ast.Expression),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	valueField, err := expr.CompileFieldExpr(node.ValueField)
+	if err != nil {
+		return nil, err
+	}
+	return func(r *zng.Record) bool {
+		if valueField(r).Type != nil {
+			return compareField(r)
+		}
+		return compareValue(r)
+	}, nil
+}
+
+// Compile compiles node into a Filter.  Types needed to evaluate any
+// expressions in node are created in zctx.
+func Compile(zctx *resolver.Context, node ast.BooleanExpr) (Filter, error) {
 	switch v := node.(type) {
 	case *ast.LogicalNot:
-		expr, err := Compile(v.Expr)
+		expr, err := Compile(zctx, v.Expr)
 		if err != nil {
 			return nil, err
 		}
 		return LogicalNot(expr), nil
 
 	case *ast.LogicalAnd:
-		left, err := Compile(v.Left)
+		left, err := Compile(zctx, v.Left)
 		if err != nil {
 			return nil, err
 		}
-		right, err := Compile(v.Right)
+		right, err := Compile(zctx, v.Right)
 		if err != nil {
 			return nil, err
 		}
 		return LogicalAnd(left, right), nil
 
 	case *ast.LogicalOr:
-		left, err := Compile(v.Left)
+		left, err := Compile(zctx, v.Left)
 		if err != nil {
 			return nil, err
 		}
-		right, err := Compile(v.Right)
+		right, err := Compile(zctx, v.Right)
 		if err != nil {
 			return nil, err
 		}
@@ -306,6 +361,9 @@ func Compile(node ast.BooleanExpr) (Filter, error) {
 			return combine(resolver, comparison), nil
 		}
 
+		if v.ValueField != nil {
+			return compileCompareBareword(zctx, v)
+		}
 		return CompileFieldCompare(v)
 
 	case *ast.CompareAny:
@@ -323,6 +381,9 @@ func Compile(node ast.BooleanExpr) (Filter, error) {
 		}
 		return EvalAny(comparison, v.Recursive), nil
 
+	case *ast.Evaluate:
+		return compileEvaluate(zctx, v)
+
 	default:
 		return nil, fmt.Errorf("Filter AST unknown type: %v", v)
 	}
@@ -30,7 +30,7 @@ func compileFilter(filt string) (filter.Filter, error) {
 	}
 
 	// Compile the filter...
-	return filter.Compile(filtProc.Filter)
+	return filter.Compile(resolver.NewContext(), filtProc.Filter)
 }
 
 // Execute one test of a filter by compiling the given filter and
@@ -470,6 +470,33 @@ func TestFilters(t *testing.T) {
 		{"rec.sub", true},
 		{"c.s", true},
 	})
+
+	// Test filters that evaluate expressions
+	record, err = parseOneRecord(`
+#0:record[s:string,x:int64,y:int64,n:record[z:int64]]
+0:[foo;2;5;[-1;]]`)
+	require.NoError(t, err)
+	runCases(t, record, []testcase{
+		{"x < y", true},
+		{"x > y", false},
+		{"x < (y)", true},
+		{"x*2 < y", true},
+		{"x*3 < y", false},
+		{"x + y = 7", true},
+		{"x + y != 7", false},
+		{"y - x >= 3", true},
+		{"n.z < x", true},
+		{"x > n.z", true},
+		{"s = foo and x*2 < y", true},
+		{"not x > y", true},
+		{"x < (nosuchfield)", false},
+		// A bare word that doesn't name a field is a string.
+		{"x < nosuchfield", false},
+		{"s < nosuchfield", true},
+		{"s > nosuchfield", false},
+		{`s < "x"`, true},
+		{`String.toUpper(s) = "FOO"`, true},
+	})
 }
 
 func TestBadFilter(t *testing.T) {
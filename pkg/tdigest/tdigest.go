@@ -0,0 +1,213 @@
+// Package tdigest implements the merging t-digest of Dunning and Ertl,
+// a compact sketch of a distribution of values from which quantiles can
+// be estimated.  Quantiles near the tails of the distribution are
+// estimated more accurately than those near the median.  Two digests
+// can be merged, so a digest can be computed in pieces and combined.
+package tdigest
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"sort"
+)
+
+// DefaultCompression bounds the number of centroids kept by a digest.
+// Larger values give more accurate quantiles at the cost of space.
+const DefaultCompression = 100
+
+var ErrCorrupt = errors.New("corrupt t-digest")
+
+type centroid struct {
+	mean  float64
+	count float64
+}
+
+type TDigest struct {
+	compression float64
+	centroids   []centroid
+	// Values are added to buffer and periodically merged into
+	// centroids.
+	buffer []centroid
+	count  float64
+	min    float64
+	max    float64
+}
+
+func New() *TDigest {
+	return NewWithCompression(DefaultCompression)
+}
+
+func NewWithCompression(compression float64) *TDigest {
+	return &TDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Count returns the number of values added to the digest.
+func (t *TDigest) Count() float64 {
+	return t.count
+}
+
+// Add adds a value to the digest.
+func (t *TDigest) Add(x float64) {
+	t.add(x, 1)
+}
+
+func (t *TDigest) add(mean, count float64) {
+	if math.IsNaN(mean) || count <= 0 {
+		return
+	}
+	t.buffer = append(t.buffer, centroid{mean, count})
+	t.count += count
+	if mean < t.min {
+		t.min = mean
+	}
+	if mean > t.max {
+		t.max = mean
+	}
+	if len(t.buffer) >= int(5*t.compression) {
+		t.compress()
+	}
+}
+
+// Merge adds the values summarized by other to the digest.
+func (t *TDigest) Merge(other *TDigest) {
+	other.compress()
+	for _, c := range other.centroids {
+		t.add(c.mean, c.count)
+	}
+	if other.count > 0 {
+		// The extrema of other may lie beyond its centroid means.
+		t.min = math.Min(t.min, other.min)
+		t.max = math.Max(t.max, other.max)
+	}
+}
+
+// compress merges the buffered values into the centroids, keeping the
+// size of each centroid within the bound given by the k1 scale function
+// of the t-digest paper.
+func (t *TDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+	all := append(t.centroids, t.buffer...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+	t.buffer = t.buffer[:0]
+	merged := make([]centroid, 0, len(all))
+	cur := all[0]
+	var sofar float64
+	limit := t.count * t.kinverse(t.k(0)+1)
+	for _, c := range all[1:] {
+		if sofar+cur.count+c.count <= limit {
+			cur.count += c.count
+			cur.mean += (c.mean - cur.mean) * c.count / cur.count
+			continue
+		}
+		sofar += cur.count
+		merged = append(merged, cur)
+		limit = t.count * t.kinverse(t.k(sofar/t.count)+1)
+		cur = c
+	}
+	t.centroids = append(merged, cur)
+}
+
+func (t *TDigest) k(q float64) float64 {
+	return t.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+func (t *TDigest) kinverse(k float64) float64 {
+	if k >= t.compression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/t.compression) + 1) / 2
+}
+
+// Quantile returns an estimate of the value below which the fraction q
+// of the values lie, interpolating between adjacent centroids.  It
+// returns NaN if the digest is empty.
+func (t *TDigest) Quantile(q float64) float64 {
+	t.compress()
+	n := len(t.centroids)
+	if n == 0 || math.IsNaN(q) {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return t.min
+	}
+	if q >= 1 {
+		return t.max
+	}
+	if n == 1 {
+		return t.centroids[0].mean
+	}
+	// Each centroid is taken to have half its count on either side of
+	// its mean, with the extrema at the outer edges of the first and
+	// last centroids.
+	index := q * t.count
+	first := t.centroids[0]
+	if index < first.count/2 {
+		return t.min + index/(first.count/2)*(first.mean-t.min)
+	}
+	sofar := first.count / 2
+	for k := 0; k < n-1; k++ {
+		left, right := t.centroids[k], t.centroids[k+1]
+		dw := (left.count + right.count) / 2
+		if sofar+dw > index {
+			z := (index - sofar) / dw
+			return left.mean + z*(right.mean-left.mean)
+		}
+		sofar += dw
+	}
+	last := t.centroids[n-1]
+	z := (index - sofar) / (last.count / 2)
+	return last.mean + z*(t.max-last.mean)
+}
+
+// MarshalBinary encodes the digest as its compression, extrema, and
+// centroids.
+func (t *TDigest) MarshalBinary() ([]byte, error) {
+	t.compress()
+	b := make([]byte, 0, 8*(3+2*len(t.centroids)))
+	b = appendFloat(b, t.compression)
+	b = appendFloat(b, t.min)
+	b = appendFloat(b, t.max)
+	for _, c := range t.centroids {
+		b = appendFloat(b, c.mean)
+		b = appendFloat(b, c.count)
+	}
+	return b, nil
+}
+
+func (t *TDigest) UnmarshalBinary(b []byte) error {
+	if len(b) < 24 || len(b)%16 != 8 {
+		return ErrCorrupt
+	}
+	t.compression = readFloat(b[0:])
+	t.min = readFloat(b[8:])
+	t.max = readFloat(b[16:])
+	t.buffer = nil
+	t.centroids = nil
+	t.count = 0
+	for b = b[24:]; len(b) > 0; b = b[16:] {
+		c := centroid{readFloat(b), readFloat(b[8:])}
+		if c.count <= 0 {
+			return ErrCorrupt
+		}
+		t.centroids = append(t.centroids, c)
+		t.count += c.count
+	}
+	return nil
+}
+
+func appendFloat(b []byte, f float64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], math.Float64bits(f))
+	return append(b, buf[:]...)
+}
+
+func readFloat(b []byte) float64 {
+	return math.Float64frombits(binary.BigEndian.Uint64(b))
+}
@@ -0,0 +1,63 @@
+package tdigest
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmall(t *testing.T) {
+	td := New()
+	assert.True(t, math.IsNaN(td.Quantile(0.5)))
+	for _, x := range []float64{3, 1, 2} {
+		td.Add(x)
+	}
+	assert.Equal(t, 2.0, td.Quantile(0.5))
+	assert.Equal(t, 1.0, td.Quantile(0))
+	assert.Equal(t, 3.0, td.Quantile(1))
+	td.Add(4)
+	assert.Equal(t, 2.5, td.Quantile(0.5))
+}
+
+// rank returns the fraction of the sorted values less than x.
+func rank(sorted []float64, x float64) float64 {
+	return float64(sort.SearchFloat64s(sorted, x)) / float64(len(sorted))
+}
+
+func TestAccuracyAndMerge(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	whole := New()
+	parts := []*TDigest{New(), New(), New()}
+	var vals []float64
+	for k := 0; k < 100000; k++ {
+		x := r.NormFloat64()*10 + 50
+		vals = append(vals, x)
+		whole.Add(x)
+		parts[k%len(parts)].Add(x)
+	}
+	sort.Float64s(vals)
+	merged := New()
+	for _, p := range parts {
+		b, err := p.MarshalBinary()
+		require.NoError(t, err)
+		var u TDigest
+		require.NoError(t, u.UnmarshalBinary(b))
+		merged.Merge(&u)
+	}
+	assert.Equal(t, whole.Count(), merged.Count())
+	for _, q := range []float64{0.01, 0.1, 0.5, 0.9, 0.99} {
+		assert.InDelta(t, q, rank(vals, whole.Quantile(q)), 0.001, "quantile %f", q)
+		assert.InDelta(t, q, rank(vals, merged.Quantile(q)), 0.001, "merged quantile %f", q)
+	}
+	assert.Equal(t, vals[0], merged.Quantile(0))
+	assert.Equal(t, vals[len(vals)-1], merged.Quantile(1))
+}
+
+func TestUnmarshalCorrupt(t *testing.T) {
+	var td TDigest
+	assert.Equal(t, ErrCorrupt, td.UnmarshalBinary([]byte{1, 2, 3}))
+}
@@ -8,10 +8,24 @@ import (
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 type Cut struct {
 	Base
+	fields []ast.FieldExpr
+	// When none of the fields are patterns, cutter cuts every record.
+	// Otherwise, the patterns are expanded to the matching fields of
+	// each input record type and the cutter for each type is held in
+	// cutters.
+	cutter   *cutter
+	patterns []fieldPattern
+	cutters  map[int]*cutter
+}
+
+// A cutter builds records holding a fixed list of fields.
+type cutter struct {
+	zctx      *resolver.Context
 	resolvers []expr.FieldExprResolver
 	builder   *ColumnBuilder
 	cutmap    map[int]*zng.TypeRecord
@@ -27,16 +41,37 @@ type Cut struct {
 // do this now since it might confuse users who expect to see output
 // fields in the order they specified.
 func CompileCutProc(c *Context, parent Proc, node *ast.CutProc) (*Cut, error) {
-	resolvers, err := expr.CompileFieldExprs(node.Fields)
+	patterns, err := compileFieldPatterns(node.Fields)
 	if err != nil {
 		return nil, fmt.Errorf("compiling cut: %w", err)
 	}
-	builder, err := NewColumnBuilder(c.TypeContext, node.Fields)
+	cut := &Cut{
+		Base:   Base{Context: c, Parent: parent},
+		fields: node.Fields,
+	}
+	if hasGlob(patterns) {
+		cut.patterns = patterns
+		cut.cutters = make(map[int]*cutter)
+		return cut, nil
+	}
+	cut.cutter, err = newCutter(c.TypeContext, node.Fields)
 	if err != nil {
 		return nil, fmt.Errorf("compiling cut: %w", err)
 	}
-	return &Cut{
-		Base:      Base{Context: c, Parent: parent},
+	return cut, nil
+}
+
+func newCutter(zctx *resolver.Context, fields []ast.FieldExpr) (*cutter, error) {
+	resolvers, err := expr.CompileFieldExprs(fields)
+	if err != nil {
+		return nil, err
+	}
+	builder, err := NewColumnBuilder(zctx, fields)
+	if err != nil {
+		return nil, err
+	}
+	return &cutter{
+		zctx:      zctx,
 		resolvers: resolvers,
 		builder:   builder,
 		cutmap:    make(map[int]*zng.TypeRecord),
@@ -46,7 +81,7 @@ func CompileCutProc(c *Context, parent Proc, node *ast.CutProc) (*Cut, error) {
 // cut returns a new record value derived by keeping only the fields
 // specified by name in the fields slice.  If the record can't be cut
 // (i.e., it doesn't have one of the specified fields), returns nil.
-func (c *Cut) cut(in *zng.Record) *zng.Record {
+func (c *cutter) cut(in *zng.Record) *zng.Record {
 	// Check if we already have an output descriptor for this
 	// input type
 	typ, ok := c.cutmap[in.Type.ID()]
@@ -80,7 +115,7 @@ func (c *Cut) cut(in *zng.Record) *zng.Record {
 	}
 	if typ == nil {
 		cols := c.builder.TypedColumns(types)
-		typ = c.TypeContext.LookupTypeRecord(cols)
+		typ = c.zctx.LookupTypeRecord(cols)
 		c.cutmap[in.Type.ID()] = typ
 	}
 
@@ -98,11 +133,52 @@ func (c *Cut) cut(in *zng.Record) *zng.Record {
 	return r
 }
 
+func (c *Cut) cut(in *zng.Record) *zng.Record {
+	if c.cutter != nil {
+		return c.cutter.cut(in)
+	}
+	id := in.Type.ID()
+	cutter, ok := c.cutters[id]
+	if !ok {
+		// A record type with no field matching one of the
+		// patterns is blocked like a record type missing a field.
+		fields := expandFieldPatterns(in.Type, c.patterns)
+		if fields != nil {
+			var err error
+			cutter, err = newCutter(c.TypeContext, fields)
+			if err != nil {
+				c.Warnings <- fmt.Sprintf("Cut: %s", err)
+			}
+		}
+		c.cutters[id] = cutter
+	}
+	if cutter == nil {
+		return nil
+	}
+	return cutter.cut(in)
+}
+
+// blocked returns true if no input records could be cut.
+func (c *Cut) blocked() bool {
+	if c.cutter != nil {
+		return len(c.cutter.cutmap) == c.cutter.nblocked
+	}
+	for _, cutter := range c.cutters {
+		if cutter != nil && len(cutter.cutmap) > cutter.nblocked {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *Cut) warn() {
-	if len(c.cutmap) > c.nblocked {
+	if !c.blocked() {
 		return
 	}
-	names := c.builder.FullNames()
+	var names []string
+	for _, field := range c.fields {
+		names = append(names, expr.FieldExprToString(field))
+	}
 	var msg string
 	if len(names) == 1 {
 		msg = fmt.Sprintf("Cut field %s not present in input", names[0])
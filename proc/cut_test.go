@@ -133,3 +133,21 @@ func TestCutNested(t *testing.T) {
 	proc.TestOneProc(t, nestedIn1, nestedIn1, "cut rec.foo,rec.bar")
 	proc.TestOneProc(t, nestedIn2, nestedOut2, "cut rec1.sub1.foo,rec1.sub2.bar,rec2.foo,foo")
 }
+
+const nestedOut3 = `
+#0:record[rec1:record[sub1:record[foo:string],sub2:record[foo:string]],rec2:record[foo:string],foo:string]
+0:[[[foo1.1;][foo2.1;]][foo3.1;]outer1;]
+0:[[[foo1.2;][foo2.2;]][foo3.2;]outer2;]
+`
+
+// Test cutting fields matched by glob patterns.
+func TestCutGlob(t *testing.T) {
+	proc.TestOneProc(t, fooAndBar, fooAndBar, "cut *")
+	proc.TestOneProc(t, fooAndBar, fooOnly, "cut f*")
+	proc.TestOneProc(t, nestedIn1, nestedOut1, "cut rec.f*")
+	proc.TestOneProc(t, nestedIn2, nestedOut3, "cut rec*.foo,*.*.foo,foo")
+	proc.TestOneProc(t, fooOnly+barOnly, fooOnly, "cut f*")
+
+	warning := "Cut field x* not present in input"
+	proc.TestOneProcWithWarnings(t, fooAndBar, "", []string{warning}, "cut x*")
+}
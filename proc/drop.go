@@ -0,0 +1,122 @@
+package proc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+)
+
+// A Drop proc removes the fields matching any of its field patterns from
+// each input record.  A nested record left with no fields is removed as
+// well, and a record left with no fields at all is dropped.
+type Drop struct {
+	Base
+	fields   []ast.FieldExpr
+	patterns []fieldPattern
+	cutters  map[int]*cutter
+	dropped  bool
+}
+
+func CompileDropProc(c *Context, parent Proc, node *ast.DropProc) (*Drop, error) {
+	patterns, err := compileFieldPatterns(node.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("compiling drop: %w", err)
+	}
+	return &Drop{
+		Base:     Base{Context: c, Parent: parent},
+		fields:   node.Fields,
+		patterns: patterns,
+		cutters:  make(map[int]*cutter),
+	}, nil
+}
+
+// keep returns the fields of a record of type typ that are not dropped
+// along with a boolean that is true if any fields are dropped.  A nested
+// record with no dropped fields is kept as a single field.
+func (d *Drop) keep(prefix []string, typ *zng.TypeRecord) ([]ast.FieldExpr, bool) {
+	var fields []ast.FieldExpr
+	var dropped bool
+	for _, col := range typ.Columns {
+		names := make([]string, len(prefix)+1)
+		copy(names, prefix)
+		names[len(prefix)] = col.Name
+		if matchAny(d.patterns, strings.Join(names, ".")) {
+			dropped = true
+			continue
+		}
+		if recType, ok := col.Type.(*zng.TypeRecord); ok {
+			if kept, ok := d.keep(names, recType); ok {
+				fields = append(fields, kept...)
+				dropped = true
+				continue
+			}
+		}
+		fields = append(fields, fieldExpr(names))
+	}
+	return fields, dropped
+}
+
+func (d *Drop) drop(in *zng.Record) *zng.Record {
+	id := in.Type.ID()
+	cutter, ok := d.cutters[id]
+	if !ok {
+		fields, dropped := d.keep(nil, in.Type)
+		if dropped {
+			d.dropped = true
+		}
+		if len(fields) > 0 {
+			var err error
+			cutter, err = newCutter(d.TypeContext, fields)
+			if err != nil {
+				d.Warnings <- fmt.Sprintf("Drop: %s", err)
+			}
+		}
+		d.cutters[id] = cutter
+	}
+	if cutter == nil {
+		return nil
+	}
+	return cutter.cut(in)
+}
+
+func (d *Drop) warn() {
+	if d.dropped {
+		return
+	}
+	var names []string
+	for _, field := range d.fields {
+		names = append(names, expr.FieldExprToString(field))
+	}
+	var msg string
+	if len(names) == 1 {
+		msg = fmt.Sprintf("Drop field %s not present in input", names[0])
+	} else {
+		msg = fmt.Sprintf("Drop fields %s not present in input", strings.Join(names, ","))
+	}
+	d.Warnings <- msg
+}
+
+func (d *Drop) Pull() (zbuf.Batch, error) {
+	for {
+		batch, err := d.Get()
+		if EOS(batch, err) {
+			d.warn()
+			return nil, err
+		}
+		recs := make([]*zng.Record, 0, batch.Length())
+		for k := 0; k < batch.Length(); k++ {
+			if out := d.drop(batch.Index(k)); out != nil {
+				recs = append(recs, out)
+			}
+		}
+		span := batch.Span()
+		batch.Unref()
+		if len(recs) > 0 {
+			return zbuf.NewArray(recs, span), nil
+		}
+	}
+}
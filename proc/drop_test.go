@@ -0,0 +1,28 @@
+package proc_test
+
+import (
+	"testing"
+
+	"github.com/brimsec/zq/proc"
+)
+
+const nestedDropOut = `
+#0:record[foo:string,rec1:record[sub2:record[foo:string,bar:string]]]
+0:[outer1;[[foo2.1;bar2.1;]]]
+0:[outer2;[[foo2.2;bar2.2;]]]
+`
+
+func TestDrop(t *testing.T) {
+	proc.TestOneProc(t, fooAndBar, fooOnly, "drop bar")
+	proc.TestOneProc(t, fooAndBar, fooOnly, "drop b*")
+	proc.TestOneProc(t, fooOnly+barOnly, fooOnly, "drop bar")
+	proc.TestOneProcWithWarnings(t, fooAndBar, "", nil, "drop foo,bar")
+	proc.TestOneProc(t, nestedIn1, nestedOut1, "drop rec.bar")
+	proc.TestOneProc(t, nestedIn2, nestedDropOut, "drop rec1.sub1,rec2")
+	proc.TestOneProc(t, nestedIn2, nestedDropOut, "drop *.sub1.*,rec2.*")
+
+	warning := "Drop field x not present in input"
+	proc.TestOneProcWithWarnings(t, fooAndBar, fooAndBar, []string{warning}, "drop x")
+	warning = "Drop fields x,y* not present in input"
+	proc.TestOneProcWithWarnings(t, fooAndBar, fooAndBar, []string{warning}, "drop x,y*")
+}
@@ -1,7 +1,9 @@
 package proc
 
 import (
+	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -30,42 +32,62 @@ type GroupByParams struct {
 	keys            []GroupByKey
 	reducers        []compile.CompiledReducer
 	builder         *ColumnBuilder
+	consumePart     bool
+	emitPart        bool
 }
 
-type errTooBig int
-
-func (e errTooBig) Error() string {
-	return fmt.Sprintf("groupby aggregation exceeded configured cardinality limit (%d)", e)
-}
-
-func IsErrTooBig(err error) bool {
-	_, ok := err.(errTooBig)
-	return ok
-}
-
+// defaultGroupByLimit is the default number of rows a groupby table
+// holds in memory.  Beyond this, the partial results of the rows are
+// spilled to temporary files and combined once all input has been read.
 const defaultGroupByLimit = 1000000
 
-func CompileGroupBy(node *ast.GroupByProc, zctx *resolver.Context) (*GroupByParams, error) {
+// groupByBatchSize is the number of records in each output batch when
+// combining spilled results.
+const groupByBatchSize = 100
+
+func CompileGroupBy(node *ast.GroupByProc, zctx *resolver.Context, warnings chan string) (*GroupByParams, error) {
 	keys := make([]GroupByKey, 0)
+	var names []ast.FieldExpr
 	for _, key := range node.Keys {
-		resolver, err := expr.CompileFieldExpr(key)
+		// A key with a target is named by the target.  Otherwise,
+		// the key must be a field expression, which retains the
+		// name and nesting of the field in the output.
+		var name ast.FieldExpr = &ast.FieldRead{Field: key.Target}
+		if key.Target == "" {
+			switch key.Expr.(type) {
+			case *ast.FieldRead, *ast.FieldCall:
+				name = key.Expr
+			default:
+				return nil, errors.New("compiling groupby: key expression requires a name")
+			}
+		}
+		// Partial results hold the value of each key in the
+		// field named by the key.
+		var resolver expr.FieldExprResolver
+		var err error
+		if node.ConsumePart {
+			resolver, err = expr.CompileFieldExpr(name)
+		} else {
+			resolver, err = expr.CompileExprResolver(zctx, key.Expr)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("compiling groupby: %w", err)
 		}
 		keys = append(keys, GroupByKey{
-			name:     GroupKey(key),
+			name:     GroupKey(name),
 			resolver: resolver,
 		})
+		names = append(names, name)
 	}
 	reducers := make([]compile.CompiledReducer, 0)
 	for _, reducer := range node.Reducers {
-		compiled, err := compile.Compile(reducer)
+		compiled, err := compile.Compile(reducer, zctx, warnings)
 		if err != nil {
 			return nil, err
 		}
 		reducers = append(reducers, compiled)
 	}
-	builder, err := NewColumnBuilder(zctx, node.Keys)
+	builder, err := NewColumnBuilder(zctx, names)
 	if err != nil {
 		return nil, fmt.Errorf("compiling groupby: %w", err)
 	}
@@ -76,6 +98,8 @@ func CompileGroupBy(node *ast.GroupByProc, zctx *resolver.Context) (*GroupByPara
 		keys:            keys,
 		reducers:        reducers,
 		builder:         builder,
+		consumePart:     node.ConsumePart,
+		emitPart:        node.EmitPart,
 	}, nil
 }
 
@@ -85,6 +109,7 @@ type GroupBy struct {
 	timeBinned bool
 	interval   time.Duration
 	agg        *GroupByAggregator
+	eof        bool
 }
 
 type keyRow struct {
@@ -100,12 +125,17 @@ type keyRow struct {
 // search direction.
 type GroupByAggregator struct {
 	// keyCols maps incoming type ID of the record's type to a set of columns
-	// for that record type where each column represents a key.  If the
-	// inbound record doesn't have all of the group-by keys, then it is
-	// blocked by setting the map entry to nil.  If there are no group-by
-	// keys, then the map is set to an empty slice.
-	keyCols  map[int]keyRow
-	cacheKey []byte // Reduces memory allocations in Consume.
+	// for that record type where each column represents a key.  Since a
+	// key may be an expression whose type varies from record to record,
+	// the entry holds the columns of the last record of the type and is
+	// replaced when the key types change.  If there are no group-by keys,
+	// then the columns are an empty slice.
+	keyCols map[int]keyRow
+	// keyRows maps the scratch type ID of a keyRow (see kctx) back to
+	// the keyRow so that spilled rows can be reconstructed.
+	keyRows  map[int]keyRow
+	keyVals  []zng.Value // Reduces memory allocations in Consume.
+	cacheKey []byte      // Reduces memory allocations in Consume.
 	// zctx is the type context of the running search.
 	zctx *resolver.Context
 	// kctx is a scratch type context used to generate unique
@@ -126,6 +156,23 @@ type GroupByAggregator struct {
 	reverse         bool
 	logger          *zap.Logger
 	limit           int
+	// When the rows of a bin don't fit in memory, their partial results
+	// are spilled to sorted runs by the bin's entry in spillers, in the
+	// scratch type context sctx.  Once the bin is complete, its runs are
+	// merged by merger, whose spiller is draining.  row is the row
+	// currently being combined from the merged runs.
+	spillers map[nano.Ts]*spiller
+	sctx     *resolver.Context
+	draining *spiller
+	merger   *runMerger
+	row      *GroupByRow
+	rowKey   zcode.Bytes
+	// When consumePart is set, the aggregator combines the partial
+	// results of the reducers held in its input records, and when
+	// emitPart is set, it outputs the partial results of the reducers.
+	consumePart bool
+	emitPart    bool
+	parts       []zng.Value // Reduces memory allocations in Consume.
 }
 
 type GroupByRow struct {
@@ -153,11 +200,15 @@ func NewGroupByAggregator(c *Context, params GroupByParams) *GroupByAggregator {
 		reducerDefs:     params.reducers,
 		builder:         params.builder,
 		keyCols:         make(map[int]keyRow),
+		keyRows:         make(map[int]keyRow),
 		tables:          make(map[nano.Ts]map[string]*GroupByRow),
+		spillers:        make(map[nano.Ts]*spiller),
 		TimeBinDuration: dur,
 		reverse:         c.Reverse,
 		logger:          c.Logger,
 		limit:           limit,
+		consumePart:     params.consumePart,
+		emitPart:        params.emitPart,
 	}
 }
 
@@ -176,6 +227,11 @@ func NewGroupBy(c *Context, parent Proc, params GroupByParams) *GroupBy {
 }
 
 func (g *GroupBy) Pull() (zbuf.Batch, error) {
+	if g.eof {
+		// Results that were spilled to disk are returned a batch
+		// at a time until they are exhausted.
+		return g.results(true)
+	}
 	start := time.Now()
 	for {
 		batch, err := g.Get()
@@ -183,26 +239,42 @@ func (g *GroupBy) Pull() (zbuf.Batch, error) {
 			return nil, err
 		}
 		if batch == nil {
-			return g.agg.Results(true, g.MinTs, g.MaxTs), nil
+			g.eof = true
+			return g.results(true)
 		}
 		for k := 0; k < batch.Length(); k++ {
 			err := g.agg.Consume(batch.Index(k))
 			if err != nil {
 				batch.Unref()
+				g.agg.Cleanup()
 				return nil, err
 			}
 		}
 		batch.Unref()
 		if g.timeBinned {
-			if f := g.agg.Results(false, g.MinTs, g.MaxTs); f != nil {
-				return f, nil
+			f, err := g.results(false)
+			if f != nil || err != nil {
+				return f, err
 			}
 		} else if g.interval > 0 && time.Since(start) >= g.interval {
-			return g.agg.Results(false, g.MinTs, g.MaxTs), nil
+			return g.results(false)
 		}
 	}
 }
 
+func (g *GroupBy) results(eof bool) (zbuf.Batch, error) {
+	batch, err := g.agg.Results(eof, g.MinTs, g.MaxTs)
+	if err != nil || (eof && batch == nil) {
+		g.agg.Cleanup()
+	}
+	return batch, err
+}
+
+func (g *GroupBy) Done() {
+	g.agg.Cleanup()
+	g.Base.Done()
+}
+
 func (g *GroupByAggregator) createRow(keyCols keyRow, ts nano.Ts, vals zcode.Bytes) *GroupByRow {
 	// Make a deep copy so the caller can reuse the underlying arrays.
 	v := make(zcode.Bytes, len(vals))
@@ -215,21 +287,15 @@ func (g *GroupByAggregator) createRow(keyCols keyRow, ts nano.Ts, vals zcode.Byt
 	}
 }
 
-func newKeyRow(kctx *resolver.Context, r *zng.Record, keys []GroupByKey) keyRow {
+func newKeyRow(kctx *resolver.Context, vals []zng.Value, keys []GroupByKey) keyRow {
 	cols := make([]zng.Column, len(keys))
 	for k, key := range keys {
-		// Recurse the record to find the bottom column for group-by
-		// on record access, e.g., a.b.c should find the column for "c".
-		keyVal := key.resolver(r)
-		if keyVal.Type == nil {
-			return keyRow{}
-		}
-		cols[k] = zng.NewColumn(key.name, keyVal.Type)
+		cols[k] = zng.NewColumn(key.name, vals[k].Type)
 	}
 	// Lookup a unique ID by converting the columns too a record string
 	// and looking up the record by name in the scratch type context.
-	// This is called infrequently, just once for each unique input
-	// record type.  If there no keys, just use id zero since the
+	// This is called infrequently, typically just once for each unique
+	// input record type.  If there no keys, just use id zero since the
 	// type ID doesn't matter here.
 	var id int
 	if len(cols) > 0 {
@@ -239,21 +305,37 @@ func newKeyRow(kctx *resolver.Context, r *zng.Record, keys []GroupByKey) keyRow
 	return keyRow{id, cols}
 }
 
+func keyTypesMatch(keyCols keyRow, vals []zng.Value) bool {
+	for k, col := range keyCols.columns {
+		if col.Type != vals[k].Type {
+			return false
+		}
+	}
+	return true
+}
+
 // Consume takes a record and adds it to the aggregation. Records
 // successively passed to Consume are expected to have timestamps in
 // monotonically increasing or decreasing order determined by g.reverse.
 func (g *GroupByAggregator) Consume(r *zng.Record) error {
-	// First check if we've seen this descriptor before and if not
-	// build an entry for it.
+	// Evaluate the keys, ignoring any record for which a key can't
+	// be computed, e.g., because the record doesn't have the key field.
+	g.keyVals = g.keyVals[:0]
+	for _, key := range g.keys {
+		keyVal := key.resolver(r)
+		if keyVal.Type == nil {
+			return nil
+		}
+		g.keyVals = append(g.keyVals, keyVal)
+	}
+	// Then check if we've seen this descriptor with these key types
+	// before and if not build an entry for it.
 	id := r.Type.ID()
 	keyCols, ok := g.keyCols[id]
-	if !ok {
-		keyCols = newKeyRow(g.kctx, r, g.keys)
+	if !ok || !keyTypesMatch(keyCols, g.keyVals) {
+		keyCols = newKeyRow(g.kctx, g.keyVals, g.keys)
 		g.keyCols[id] = keyCols
-	}
-	if keyCols.columns == nil {
-		// block this descriptor since it doesn't have all the group-by keys
-		return nil
+		g.keyRows[keyCols.id] = keyCols
 	}
 
 	// See if we've encountered this row before.
@@ -265,8 +347,7 @@ func (g *GroupByAggregator) Consume(r *zng.Record) error {
 	// input descriptor may end up with multiple output descriptors
 	// (because the reducer types are different for the same keys), but
 	// because our goal is to distingush rows for different types of keys,
-	// we can rely on just the key types (and input desciptor uniquely
-	// implying those types)
+	// we can rely on just the key types.
 
 	var keyBytes zcode.Bytes
 	if g.cacheKey != nil {
@@ -276,8 +357,7 @@ func (g *GroupByAggregator) Consume(r *zng.Record) error {
 	}
 	binary.BigEndian.PutUint32(keyBytes, uint32(keyCols.id))
 	g.builder.Reset()
-	for _, key := range g.keys {
-		keyVal := key.resolver(r)
+	for _, keyVal := range g.keyVals {
 		g.builder.Append(keyVal.Bytes, keyVal.IsContainer())
 	}
 	zv, err := g.builder.Encode()
@@ -299,15 +379,230 @@ func (g *GroupByAggregator) Consume(r *zng.Record) error {
 	row, ok := table[string(keyBytes)]
 	if !ok {
 		if len(table) >= g.limit {
-			return errTooBig(g.limit)
+			if err := g.spillTable(ts); err != nil {
+				return err
+			}
+			table = make(map[string]*GroupByRow)
+			g.tables[ts] = table
 		}
 		row = g.createRow(keyCols, ts, keyBytes[4:])
 		table[string(keyBytes)] = row
 	}
+	if g.consumePart {
+		return g.consumePartial(row, r)
+	}
 	row.reducers.Consume(r)
 	return nil
 }
 
+// consumePartial combines the partial results in r, each of which is held
+// in the field named by the target of its reducer, into row.
+func (g *GroupByAggregator) consumePartial(row *GroupByRow, r *zng.Record) error {
+	g.parts = g.parts[:0]
+	for _, def := range g.reducerDefs {
+		part, err := r.ValueByField(def.Target())
+		if err != nil {
+			return fmt.Errorf("groupby: partial result %q: %w", def.Target(), err)
+		}
+		g.parts = append(g.parts, part)
+	}
+	return row.reducers.ConsumePart(g.parts)
+}
+
+// spillTable writes the partial results of the rows of the bin ts in
+// memory to a new run of the bin's spiller, sorted in output order, and
+// removes the bin's table.  Each spilled record holds the bin timestamp,
+// the table key, and the partial result of each reducer.
+func (g *GroupByAggregator) spillTable(ts nano.Ts) error {
+	table := g.tables[ts]
+	delete(g.tables, ts)
+	if len(table) == 0 {
+		return nil
+	}
+	spiller, ok := g.spillers[ts]
+	if !ok {
+		var err error
+		if spiller, err = newSpiller(); err != nil {
+			return err
+		}
+		g.spillers[ts] = spiller
+		if g.sctx == nil {
+			g.sctx = resolver.NewContext()
+		}
+	}
+	rows := make([]*GroupByRow, 0, len(table))
+	keys := make([]string, 0, len(table))
+	for key, row := range table {
+		rows = append(rows, row)
+		keys = append(keys, key)
+	}
+	sort.Sort(&rowSorter{rows, keys, g.reverse})
+	recs := make([]*zng.Record, 0, len(rows))
+	for k, row := range rows {
+		rec, err := g.spillRecord(row, keys[k])
+		if err != nil {
+			return err
+		}
+		recs = append(recs, rec)
+	}
+	return spiller.spill(recs)
+}
+
+// drain spills the rows of the bin ts remaining in memory and starts
+// merging the bin's runs so that spilledResults can return its rows.
+func (g *GroupByAggregator) drain(ts nano.Ts) error {
+	if err := g.spillTable(ts); err != nil {
+		return err
+	}
+	g.draining = g.spillers[ts]
+	delete(g.spillers, ts)
+	merger, err := g.draining.merge(g.sctx, g.compareSpilled)
+	if err != nil {
+		return err
+	}
+	g.merger = merger
+	return nil
+}
+
+func (g *GroupByAggregator) spillRecord(row *GroupByRow, key string) (*zng.Record, error) {
+	parts, err := row.reducers.ResultPart(g.sctx)
+	if err != nil {
+		return nil, err
+	}
+	cols := make([]zng.Column, 0, len(parts)+2)
+	cols = append(cols, zng.NewColumn("ts", zng.TypeTime))
+	cols = append(cols, zng.NewColumn("key", zng.TypeBstring))
+	var zv zcode.Bytes
+	zv = zcode.AppendPrimitive(zv, zng.EncodeTime(row.ts))
+	zv = zcode.AppendPrimitive(zv, zcode.Bytes(key))
+	for k, part := range parts {
+		cols = append(cols, zng.NewColumn(fmt.Sprintf("r%d", k), part.Type))
+		zv = part.Encode(zv)
+	}
+	typ := g.sctx.LookupTypeRecord(cols)
+	return zng.NewRecordTs(typ, row.ts, zv), nil
+}
+
+// rowSorter sorts rows by bin timestamp, in the direction of the search,
+// and then by table key, which is the order of Results.
+type rowSorter struct {
+	rows    []*GroupByRow
+	keys    []string
+	reverse bool
+}
+
+func (r *rowSorter) Len() int { return len(r.rows) }
+
+func (r *rowSorter) Less(i, j int) bool {
+	if a, b := r.rows[i].ts, r.rows[j].ts; a != b {
+		return (a < b) != r.reverse
+	}
+	return r.keys[i] < r.keys[j]
+}
+
+func (r *rowSorter) Swap(i, j int) {
+	r.rows[i], r.rows[j] = r.rows[j], r.rows[i]
+	r.keys[i], r.keys[j] = r.keys[j], r.keys[i]
+}
+
+// compareSpilled orders spilled records the same way as rowSorter.
+func (g *GroupByAggregator) compareSpilled(a, b *zng.Record) int {
+	ats, _ := a.AccessTimeByColumn(0)
+	bts, _ := b.AccessTimeByColumn(0)
+	if ats != bts {
+		if (ats < bts) != g.reverse {
+			return -1
+		}
+		return 1
+	}
+	akey, _ := a.Slice(1)
+	bkey, _ := b.Slice(1)
+	return bytes.Compare(akey, bkey)
+}
+
+// spilledResults returns the next batch of results combined from the runs
+// of the bin being drained, or nil when they have all been returned.
+func (g *GroupByAggregator) spilledResults() (zbuf.Batch, error) {
+	var recs []*zng.Record
+	for len(recs) < groupByBatchSize {
+		rec, err := g.merger.Read()
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil {
+			if g.row != nil {
+				rec, err := g.recordForRow(g.row)
+				if err != nil {
+					return nil, err
+				}
+				recs = append(recs, rec)
+				g.row = nil
+			}
+			g.stopDraining()
+			break
+		}
+		ts, err := rec.AccessTimeByColumn(0)
+		if err != nil {
+			return nil, err
+		}
+		key, err := rec.Slice(1)
+		if err != nil {
+			return nil, err
+		}
+		if g.row == nil || g.row.ts != ts || !bytes.Equal(g.rowKey, key) {
+			if g.row != nil {
+				rec, err := g.recordForRow(g.row)
+				if err != nil {
+					return nil, err
+				}
+				recs = append(recs, rec)
+			}
+			if len(key) < 4 {
+				return nil, fmt.Errorf("groupby: bad spilled key")
+			}
+			keyCols, ok := g.keyRows[int(binary.BigEndian.Uint32(key))]
+			if !ok {
+				return nil, fmt.Errorf("groupby: unknown spilled key type")
+			}
+			g.row = g.createRow(keyCols, ts, key[4:])
+			g.rowKey = append(g.rowKey[:0], key...)
+		}
+		parts := make([]zng.Value, 0, len(rec.Type.Columns)-2)
+		for k := 2; k < len(rec.Type.Columns); k++ {
+			parts = append(parts, rec.Value(k))
+		}
+		if err := g.row.reducers.ConsumePart(parts); err != nil {
+			return nil, err
+		}
+	}
+	if len(recs) == 0 {
+		return nil, nil
+	}
+	return g.batch(recs), nil
+}
+
+// stopDraining closes the merger of the bin being drained and removes the
+// bin's runs.
+func (g *GroupByAggregator) stopDraining() {
+	if g.merger != nil {
+		g.merger.Close()
+		g.merger = nil
+	}
+	if g.draining != nil {
+		g.draining.cleanup()
+		g.draining = nil
+	}
+}
+
+// Cleanup removes any results spilled to disk.
+func (g *GroupByAggregator) Cleanup() {
+	g.stopDraining()
+	for ts, spiller := range g.spillers {
+		spiller.cleanup()
+		delete(g.spillers, ts)
+	}
+}
+
 // Results returns a batch of aggregation result records.
 // If this is a time-binned aggregation, this can be called multiple
 // times; all completed time bins at the time of the invocation are
@@ -315,35 +610,82 @@ func (g *GroupByAggregator) Consume(r *zng.Record) error {
 // final (possibly incomplete) time bin.
 // If this is not a time-binned aggregation, a single call (with
 // eof=true) should be made after all records have been Consumed()'d.
-func (g *GroupByAggregator) Results(eof bool, minTs nano.Ts, maxTs nano.Ts) zbuf.Batch {
+// The rows of a bin that has spilled to disk are returned a batch at a
+// time once the bin is complete, so at EOF, Results should be called
+// until it returns nil.
+func (g *GroupByAggregator) Results(eof bool, minTs nano.Ts, maxTs nano.Ts) (zbuf.Batch, error) {
+	for {
+		if g.merger != nil {
+			batch, err := g.spilledResults()
+			if batch != nil || err != nil {
+				return batch, err
+			}
+		}
+		var recs []*zng.Record
+		for _, b := range g.completeBins(eof, minTs, maxTs) {
+			if _, ok := g.spillers[b]; ok {
+				if len(recs) > 0 {
+					// Return the bins before this one first.
+					break
+				}
+				if err := g.drain(b); err != nil {
+					return nil, err
+				}
+				break
+			}
+			tableRecs, err := g.recordsForTable(g.tables[b])
+			if err != nil {
+				return nil, err
+			}
+			recs = append(recs, tableRecs...)
+			delete(g.tables, b)
+		}
+		if len(recs) > 0 {
+			return g.batch(recs), nil
+		}
+		if g.merger == nil {
+			// Don't propagate empty batches.
+			return nil, nil
+		}
+	}
+}
+
+// completeBins returns the bins whose rows are ready to be returned by
+// Results in output order.
+func (g *GroupByAggregator) completeBins(eof bool, minTs nano.Ts, maxTs nano.Ts) []nano.Ts {
 	var bins []nano.Ts
 	for b := range g.tables {
 		bins = append(bins, b)
 	}
+	for b := range g.spillers {
+		if _, ok := g.tables[b]; !ok {
+			bins = append(bins, b)
+		}
+	}
 	if g.reverse {
 		sort.Slice(bins, func(i, j int) bool { return bins[i] > bins[j] })
 	} else {
 		sort.Slice(bins, func(i, j int) bool { return bins[i] < bins[j] })
 	}
-	var recs []*zng.Record
+	if g.TimeBinDuration == 0 || eof {
+		return bins
+	}
+	complete := bins[:0]
 	for _, b := range bins {
-		if g.TimeBinDuration > 0 && !eof {
-			// We're not yet at EOF, so for a reverse search, we haven't
-			// seen all of g.minTs's bin and should skip it.
-			// Similarly, for a forward search, we haven't seen all
-			// of g.maxTs's bin and should skip it.
-			if g.reverse && b == minTs.Trunc(g.TimeBinDuration) ||
-				!g.reverse && b == maxTs.Trunc(g.TimeBinDuration) {
-				continue
-			}
+		// We're not yet at EOF, so for a reverse search, we haven't
+		// seen all of g.minTs's bin and should skip it.
+		// Similarly, for a forward search, we haven't seen all
+		// of g.maxTs's bin and should skip it.
+		if g.reverse && b == minTs.Trunc(g.TimeBinDuration) ||
+			!g.reverse && b == maxTs.Trunc(g.TimeBinDuration) {
+			continue
 		}
-		recs = append(recs, g.recordsForTable(g.tables[b])...)
-		delete(g.tables, b)
-	}
-	if len(recs) == 0 {
-		// Don't propagate empty batches.
-		return nil
+		complete = append(complete, b)
 	}
+	return complete
+}
+
+func (g *GroupByAggregator) batch(recs []*zng.Record) zbuf.Batch {
 	first, last := recs[0], recs[len(recs)-1]
 	if g.reverse {
 		first, last = last, first
@@ -354,7 +696,7 @@ func (g *GroupByAggregator) Results(eof bool, minTs nano.Ts, maxTs nano.Ts) zbuf
 
 // recordsForTable returns a slice of records with one record per table entry
 // in a deterministic but undefined order.
-func (g *GroupByAggregator) recordsForTable(table map[string]*GroupByRow) []*zng.Record {
+func (g *GroupByAggregator) recordsForTable(table map[string]*GroupByRow) ([]*zng.Record, error) {
 	var keys []string
 	for k := range table {
 		keys = append(keys, k)
@@ -363,28 +705,43 @@ func (g *GroupByAggregator) recordsForTable(table map[string]*GroupByRow) []*zng
 
 	var recs []*zng.Record
 	for _, k := range keys {
-		row := table[k]
-		var zv zcode.Bytes
-		if g.TimeBinDuration > 0 {
-			zv = zcode.AppendPrimitive(zv, zng.EncodeTime(row.ts))
+		rec, err := g.recordForRow(table[k])
+		if err != nil {
+			return nil, err
 		}
-		zv = append(zv, row.keyvals...)
-		for _, red := range row.reducers.Reducers {
-			// a reducer value is never a container
-			v := reducer.Result(red)
-			if v.IsContainer() {
-				panic("internal bug: reducer result cannot be a container!")
+		recs = append(recs, rec)
+	}
+	return recs, nil
+}
+
+func (g *GroupByAggregator) recordForRow(row *GroupByRow) (*zng.Record, error) {
+	var zv zcode.Bytes
+	if g.TimeBinDuration > 0 {
+		zv = zcode.AppendPrimitive(zv, zng.EncodeTime(row.ts))
+	}
+	zv = append(zv, row.keyvals...)
+	vals := make([]zng.Value, 0, len(row.reducers.Reducers))
+	for _, red := range row.reducers.Reducers {
+		var z zng.Value
+		if g.emitPart {
+			var err error
+			z, err = red.ResultPart(g.zctx)
+			if err != nil {
+				return nil, err
 			}
-			zv = v.Encode(zv)
+		} else {
+			z = reducer.Result(red)
 		}
-		typ := g.lookupRowType(row)
-		r := zng.NewRecordTs(typ, row.ts, zv)
-		recs = append(recs, r)
+		vals = append(vals, z)
+		zv = z.Encode(zv)
 	}
-	return recs
+	typ := g.lookupRowType(row, vals)
+	return zng.NewRecordTs(typ, row.ts, zv), nil
 }
 
-func (g *GroupByAggregator) lookupRowType(row *GroupByRow) *zng.TypeRecord {
+// lookupRowType returns the type of the output record for row whose
+// reducers resulted in vals.
+func (g *GroupByAggregator) lookupRowType(row *GroupByRow, vals []zng.Value) *zng.TypeRecord {
 	// This is only done once per row at output time so generally not a
 	// bottleneck, but this could be optimized by keeping a cache of the
 	// descriptor since it is rare for there to be multiple descriptors
@@ -403,8 +760,7 @@ func (g *GroupByAggregator) lookupRowType(row *GroupByRow) *zng.TypeRecord {
 		types[k] = col.Type
 	}
 	cols = append(cols, g.builder.TypedColumns(types)...)
-	for k, red := range row.reducers.Reducers {
-		z := reducer.Result(red)
+	for k, z := range vals {
 		cols = append(cols, zng.NewColumn(row.reducers.Defs[k].Target(), z.Type))
 	}
 	// This could be more efficient but it's only done during group-by output...
@@ -5,6 +5,10 @@ import (
 	"testing"
 
 	"github.com/brimsec/zq/pkg/test"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -143,6 +147,18 @@ const aliasOut = `
 1:[127.0.0.1;1;]
 `
 
+const spillIn = `
+0:[a;x;1;]
+0:[a;y;2;]
+0:[b;z;1;]
+`
+
+const spillOut = `
+#0:record[key1:string,count:uint64,first:string,last:string,sum:int64,avg:float64,countdistinct:uint64]
+0:[a;4;x;y;6;1.5;2;]
+0:[b;2;z;z;2;1;1;]
+`
+
 //XXX this should go in a shared package
 type suite []test.Internal
 
@@ -209,11 +225,53 @@ func tests() suite {
 	s.add(New("mixed-inputs", mixedIn, mixedOut, "first(f), last(f) by key"))
 
 	s.add(New("aliases", aliasIn, aliasOut, "count() by host"))
+
+	// Test that results spilled beyond the limit are combined
+	s.add(New("spill", in+spillIn, spillOut, "count(), first(key2), last(key2), sum(n), avg(n), countdistinct(key2) by key1 -limit 1"))
+
 	// XXX add coverage of time batching (every ..)
 
 	return s
 }
 
+func TestGroupbySpillEvery(t *testing.T) {
+	// Test that a time bin whose rows spilled beyond the limit is
+	// returned once it is complete rather than at the end of input.
+	const in1 = `
+#0:record[ts:time,key:string]
+0:[1;a;]
+0:[2;b;]
+0:[3;a;]
+`
+	const in2 = `
+#0:record[ts:time,key:string]
+0:[65;a;]
+`
+	const out1 = `
+#0:record[ts:time,key:string,count:uint64]
+0:[0;a;2;]
+0:[0;b;1;]
+`
+	const out2 = `
+#0:record[ts:time,key:string,count:uint64]
+0:[60;a;1;]
+`
+	zctx := resolver.NewContext()
+	read := func(s string) zbuf.Batch {
+		b, err := zbuf.ReadBatch(zngio.NewReader(strings.NewReader(s), zctx), 100)
+		require.NoError(t, err)
+		return b
+	}
+	in := []zbuf.Batch{read(in1), read(in2)}
+	expected := []zbuf.Batch{read(out1), read(out2)}
+	pt, err := proc.NewProcTestFromSource("every 1m count() by key -limit 1", zctx, in)
+	require.NoError(t, err)
+	require.NoError(t, pt.Expect(expected[0]))
+	require.NoError(t, pt.Expect(expected[1]))
+	require.NoError(t, pt.ExpectEOS())
+	require.NoError(t, pt.Finish())
+}
+
 func TestGroupbySystem(t *testing.T) {
 	tests().runSystem(t)
 }
@@ -0,0 +1,358 @@
+package proc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+)
+
+var ErrJoinParents = errors.New("join requires two parallel inputs")
+
+// A Join proc combines the records of two upstream inputs by key.
+// The right input is read in its entirety into a table indexed by the
+// right key, then each record from the left input is extended with the
+// fields of the right records whose key matches its left key.
+//
+// Like Merge, each parent is driven by a runnerProc.  Since both
+// parents are typically fed by the same Split, which runs at the rate
+// of its slowest consumer, the left input cannot simply be ignored
+// while the table is built.  Instead, left batches that arrive before
+// the right input is exhausted are held until the table is complete.
+type Join struct {
+	Base
+	node     *ast.JoinProc
+	once     sync.Once
+	parents  []Proc
+	left     *runnerProc
+	right    *runnerProc
+	inner    bool
+	leftKey  expr.FieldExprResolver
+	rightKey expr.FieldExprResolver
+	fields   []expr.FieldExprResolver
+	names    []string
+	table    map[string][]*zng.Record
+	pending  []zbuf.Batch
+	outmap   map[[2]int]*joinInfo
+	err      error
+}
+
+// joinInfo is the cached output layout for a given pair of left and
+// right record types.  For each column of the output record, which holds
+// the index of the right value to write there or -1 to copy the value
+// from the same column of the left record.
+type joinInfo struct {
+	typ   *zng.TypeRecord
+	which []int
+}
+
+func CompileJoinProc(c *Context, parents []Proc, node *ast.JoinProc) (*Join, error) {
+	if len(parents) != 2 {
+		return nil, ErrJoinParents
+	}
+	var inner bool
+	switch node.Kind {
+	case "", "inner":
+		inner = true
+	case "left":
+	default:
+		return nil, fmt.Errorf("unknown join kind: %s", node.Kind)
+	}
+	leftKey, err := expr.CompileFieldExpr(node.LeftKey)
+	if err != nil {
+		return nil, fmt.Errorf("compiling join: %w", err)
+	}
+	rightKey, err := expr.CompileFieldExpr(node.RightKey)
+	if err != nil {
+		return nil, fmt.Errorf("compiling join: %w", err)
+	}
+	fields, err := expr.CompileFieldExprs(node.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("compiling join: %w", err)
+	}
+	var names []string
+	for _, field := range node.Fields {
+		names = append(names, GroupKey(field))
+	}
+	return &Join{
+		Base:     Base{Context: c},
+		node:     node,
+		parents:  parents,
+		left:     newrunnerProc(c, parents[0]),
+		right:    newrunnerProc(c, parents[1]),
+		inner:    inner,
+		leftKey:  leftKey,
+		rightKey: rightKey,
+		fields:   fields,
+		names:    names,
+		table:    make(map[string][]*zng.Record),
+		outmap:   make(map[[2]int]*joinInfo),
+	}, nil
+}
+
+func (j *Join) Parents() []Proc {
+	return j.parents
+}
+
+// Node returns the AST node from which j was compiled.
+func (j *Join) Node() *ast.JoinProc {
+	return j.node
+}
+
+// build reads the right input into the join table, holding onto any
+// batches that arrive from the left input in the meantime.
+func (j *Join) build() error {
+	for j.right != nil {
+		var leftCh chan Result
+		if j.left != nil {
+			leftCh = j.left.ch
+		}
+		select {
+		case result := <-j.right.ch:
+			if result.Err != nil || result.Batch == nil {
+				close(j.right.proceed)
+				j.right = nil
+				if result.Err != nil {
+					return result.Err
+				}
+				break
+			}
+			j.insert(result.Batch)
+			result.Batch.Unref()
+			j.right.proceed <- struct{}{}
+		case result := <-leftCh:
+			if result.Err != nil || result.Batch == nil {
+				close(j.left.proceed)
+				j.left = nil
+				if result.Err != nil {
+					return result.Err
+				}
+				break
+			}
+			j.pending = append(j.pending, result.Batch)
+			j.left.proceed <- struct{}{}
+		}
+	}
+	return nil
+}
+
+func (j *Join) insert(batch zbuf.Batch) {
+	for k := 0; k < batch.Length(); k++ {
+		rec := batch.Index(k)
+		key := j.rightKey(rec)
+		if key.Type == nil {
+			continue
+		}
+		s := tableKey(key)
+		j.table[s] = append(j.table[s], rec.Keep())
+	}
+}
+
+// tableKey returns the join table key of a value, which holds its type as
+// well as its bytes so that values of different types whose bytes happen to
+// be the same don't match.  The type is given by name since the left and
+// right records may come from different type contexts.
+func tableKey(key zng.Value) string {
+	typ := key.Type.String()
+	var buf [binary.MaxVarintLen64]byte
+	b := buf[:binary.PutUvarint(buf[:], uint64(len(typ)))]
+	b = append(b, typ...)
+	return string(append(b, key.Bytes...))
+}
+
+// next returns the next batch from the left input, starting with
+// any batches held while the table was built.
+func (j *Join) next() (zbuf.Batch, error) {
+	if len(j.pending) > 0 {
+		batch := j.pending[0]
+		j.pending = j.pending[1:]
+		return batch, nil
+	}
+	if j.left == nil {
+		return nil, nil
+	}
+	result := <-j.left.ch
+	if result.Err != nil || result.Batch == nil {
+		close(j.left.proceed)
+		j.left = nil
+		return nil, result.Err
+	}
+	j.left.proceed <- struct{}{}
+	return result.Batch, nil
+}
+
+func (j *Join) Pull() (zbuf.Batch, error) {
+	j.once.Do(func() {
+		go j.left.run()
+		go j.right.run()
+		j.err = j.build()
+	})
+	if j.err != nil {
+		j.Done()
+		return nil, j.err
+	}
+	for {
+		batch, err := j.next()
+		if EOS(batch, err) {
+			j.Done()
+			return nil, err
+		}
+		recs := make([]*zng.Record, 0, batch.Length())
+		for k := 0; k < batch.Length(); k++ {
+			recs = j.join(recs, batch.Index(k))
+		}
+		span := batch.Span()
+		batch.Unref()
+		if len(recs) > 0 {
+			return zbuf.NewArray(recs, span), nil
+		}
+	}
+}
+
+// join appends to out the records formed by joining the left record
+// with each of its matches in the table.
+func (j *Join) join(out []*zng.Record, left *zng.Record) []*zng.Record {
+	var matches []*zng.Record
+	if key := j.leftKey(left); key.Type != nil {
+		matches = j.table[tableKey(key)]
+	}
+	if len(matches) == 0 {
+		if !j.inner {
+			// Pass the left record along as is, but with
+			// its type in the output type context.
+			if rec, err := j.combine(left, nil); err == nil {
+				out = append(out, rec)
+			}
+		}
+		return out
+	}
+	for _, right := range matches {
+		rec, err := j.combine(left, right)
+		if err != nil {
+			// A malformed left or right value can't be
+			// combined, so skip this pair.
+			continue
+		}
+		out = append(out, rec)
+	}
+	return out
+}
+
+// values returns the values of the right record to be copied into
+// the output along with their column names.
+func (j *Join) values(right *zng.Record) ([]zng.Value, []string) {
+	if j.fields == nil {
+		vals := make([]zng.Value, len(right.Type.Columns))
+		names := make([]string, len(right.Type.Columns))
+		for k, col := range right.Type.Columns {
+			vals[k] = right.Value(k)
+			names[k] = col.Name
+		}
+		return vals, names
+	}
+	vals := make([]zng.Value, 0, len(j.fields))
+	names := make([]string, 0, len(j.fields))
+	for k, resolver := range j.fields {
+		val := resolver(right)
+		if val.Type == nil {
+			continue
+		}
+		vals = append(vals, val)
+		names = append(names, j.names[k])
+	}
+	return vals, names
+}
+
+func (j *Join) lookup(left *zng.Record, vals []zng.Value, names []string) *joinInfo {
+	cols := make([]zng.Column, len(left.Type.Columns))
+	copy(cols, left.Type.Columns)
+	which := make([]int, len(cols))
+	for k := range which {
+		which[k] = -1
+	}
+	for k, name := range names {
+		col := zng.Column{Name: name, Type: vals[k].Type}
+		if position, ok := left.Type.ColumnOfField(name); ok {
+			// With no field list, the left record wins;
+			// listed fields overwrite the left value.
+			if j.fields == nil {
+				continue
+			}
+			cols[position] = col
+			which[position] = k
+			continue
+		}
+		cols = append(cols, col)
+		which = append(which, k)
+	}
+	return &joinInfo{
+		typ:   j.TypeContext.LookupTypeRecord(cols),
+		which: which,
+	}
+}
+
+// combine forms the output record from the left record and the values
+// of the right record, which may be nil for a left record with no match.
+func (j *Join) combine(left, right *zng.Record) (*zng.Record, error) {
+	var vals []zng.Value
+	var names []string
+	id := [2]int{left.Type.ID(), -1}
+	if right != nil {
+		vals, names = j.values(right)
+		id[1] = right.Type.ID()
+	}
+	info, ok := j.outmap[id]
+	if !ok {
+		info = j.lookup(left, vals, names)
+		j.outmap[id] = info
+	}
+	var bytes zcode.Bytes
+	iter := left.ZvalIter()
+	for _, k := range info.which {
+		var item zcode.Bytes
+		var container bool
+		if !iter.Done() {
+			var err error
+			item, container, err = iter.Next()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if k >= 0 {
+			item = vals[k].Bytes
+			container = vals[k].IsContainer()
+		}
+		if container {
+			bytes = zcode.AppendContainer(bytes, item)
+		} else {
+			bytes = zcode.AppendPrimitive(bytes, item)
+		}
+	}
+	return zng.NewRecord(info.typ, bytes)
+}
+
+func (j *Join) Done() {
+	j.once.Do(func() {
+		// Pull was never called, so the runners were never started.
+		j.left.Done()
+		j.right.Done()
+		j.left, j.right = nil, nil
+	})
+	for _, runner := range []*runnerProc{j.left, j.right} {
+		if runner != nil {
+			<-runner.ch
+			close(runner.proceed)
+		}
+	}
+	j.left, j.right = nil, nil
+	for _, batch := range j.pending {
+		batch.Unref()
+	}
+	j.pending = nil
+}
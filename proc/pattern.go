@@ -0,0 +1,123 @@
+package proc
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/reglob"
+	"github.com/brimsec/zq/zng"
+)
+
+// A fieldPattern is a field name, possibly of a field in a nested record,
+// that may contain glob wildcards, e.g., "id.*" or "*_bytes".  A pattern
+// matches the full dotted names of fields.
+type fieldPattern struct {
+	field ast.FieldExpr
+	name  string
+	re    *regexp.Regexp
+}
+
+func compileFieldPatterns(fields []ast.FieldExpr) ([]fieldPattern, error) {
+	patterns := make([]fieldPattern, 0, len(fields))
+	for _, field := range fields {
+		names, err := split(field)
+		if err != nil {
+			return nil, err
+		}
+		p := fieldPattern{field: field, name: strings.Join(names, ".")}
+		if reglob.IsGlobby(p.name) {
+			p.re, err = regexp.Compile(reglob.Reglob(p.name))
+			if err != nil {
+				return nil, err
+			}
+		}
+		patterns = append(patterns, p)
+	}
+	return patterns, nil
+}
+
+func hasGlob(patterns []fieldPattern) bool {
+	for _, p := range patterns {
+		if p.re != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (p fieldPattern) match(name string) bool {
+	if p.re != nil {
+		return p.re.MatchString(name)
+	}
+	return p.name == name
+}
+
+func matchAny(patterns []fieldPattern, name string) bool {
+	for _, p := range patterns {
+		if p.match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// expandFieldPatterns returns the fields of a record of type typ named by
+// the patterns, where each glob is replaced by the fields, other than
+// nested records, that it matches.  A field named by more than one pattern
+// is returned once.  It returns nil if a glob matches no fields.
+func expandFieldPatterns(typ *zng.TypeRecord, patterns []fieldPattern) []ast.FieldExpr {
+	var leaves [][]string
+	leaves = appendLeaves(leaves, nil, typ)
+	var fields []ast.FieldExpr
+	seen := make(map[string]struct{})
+	for _, p := range patterns {
+		if p.re == nil {
+			if _, ok := seen[p.name]; !ok {
+				seen[p.name] = struct{}{}
+				fields = append(fields, p.field)
+			}
+			continue
+		}
+		var matched bool
+		for _, names := range leaves {
+			name := strings.Join(names, ".")
+			if !p.re.MatchString(name) {
+				continue
+			}
+			matched = true
+			if _, ok := seen[name]; !ok {
+				seen[name] = struct{}{}
+				fields = append(fields, fieldExpr(names))
+			}
+		}
+		if !matched {
+			return nil
+		}
+	}
+	return fields
+}
+
+func appendLeaves(leaves [][]string, prefix []string, typ *zng.TypeRecord) [][]string {
+	for _, col := range typ.Columns {
+		names := make([]string, len(prefix)+1)
+		copy(names, prefix)
+		names[len(prefix)] = col.Name
+		if recType, ok := col.Type.(*zng.TypeRecord); ok {
+			leaves = appendLeaves(leaves, names, recType)
+			continue
+		}
+		leaves = append(leaves, names)
+	}
+	return leaves
+}
+
+// fieldExpr returns the ast.FieldExpr for the field of a nested record
+// given by names.
+func fieldExpr(names []string) ast.FieldExpr {
+	var field ast.FieldExpr = &ast.FieldRead{ast.Node{"FieldRead"}, names[0]}
+	for _, name := range names[1:] {
+		field = &ast.FieldCall{ast.Node{"FieldCall"}, "RecordFieldRead", field, name}
+	}
+	return field
+}
@@ -125,7 +125,7 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 	case *ast.ReducerProc:
 		reducers := make([]compile.CompiledReducer, 0)
 		for _, reducer := range v.Reducers {
-			compiled, err := compile.Compile(reducer)
+			compiled, err := compile.Compile(reducer, c.TypeContext, c.Warnings)
 			if err != nil {
 				return nil, err
 			}
@@ -138,7 +138,7 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 		return []Proc{NewReducer(c, parent, params)}, nil
 
 	case *ast.GroupByProc:
-		params, err := CompileGroupBy(v, c.TypeContext)
+		params, err := CompileGroupBy(v, c.TypeContext, c.Warnings)
 		if err != nil {
 			return nil, err
 		}
@@ -151,6 +151,20 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 		}
 		return []Proc{cut}, nil
 
+	case *ast.DropProc:
+		drop, err := CompileDropProc(c, parent, v)
+		if err != nil {
+			return nil, err
+		}
+		return []Proc{drop}, nil
+
+	case *ast.RenameProc:
+		rename, err := CompileRenameProc(c, parent, v)
+		if err != nil {
+			return nil, err
+		}
+		return []Proc{rename}, nil
+
 	case *ast.SortProc:
 		sort, err := CompileSortProc(c, parent, v)
 		if err != nil {
@@ -179,7 +193,7 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 		return []Proc{NewPass(c, parent)}, nil
 
 	case *ast.FilterProc:
-		f, err := filter.Compile(v.Filter)
+		f, err := filter.Compile(c.TypeContext, v.Filter)
 		if err != nil {
 			return nil, fmt.Errorf("compiling filter: %w", err)
 		}
@@ -204,14 +218,25 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 		var err error
 		n := len(v.Procs)
 		for k := 0; k < n; k++ {
+			if join, ok := v.Procs[k].(*ast.JoinProc); ok {
+				// A join consumes the outputs of the
+				// preceding parallel procs directly.
+				j, err := CompileJoinProc(c, parents, join)
+				if err != nil {
+					return nil, err
+				}
+				parents = []Proc{j}
+				parent = j
+				continue
+			}
 			parents, err = CompileProc(custom, v.Procs[k], c, parent)
 			if err != nil {
 				return nil, err
 			}
 			// merge unless we're at the end of the chain,
 			// in which case the output layer will mux
-			// into channels.
-			if len(parents) > 1 && k < n-1 {
+			// into channels, or the next proc is a join.
+			if len(parents) > 1 && k < n-1 && !isJoin(v.Procs[k+1]) {
 				parent = NewMerge(c, parents)
 			} else {
 				parent = parents[0]
@@ -238,11 +263,38 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 		}
 		return procs, nil
 
+	case *ast.JoinProc:
+		return nil, ErrJoinParents
+
 	default:
 		return nil, fmt.Errorf("unknown AST type: %v", v)
 	}
 }
 
+// Limit returns the limit chosen for p if p is a proc that holds a limited
+// number of records or groups, e.g., a sort, a group-by, or a head, and
+// false otherwise.
+func Limit(p Proc) (int, bool) {
+	switch p := p.(type) {
+	case *Sort:
+		return p.limit, true
+	case *GroupBy:
+		return p.agg.limit, true
+	case *Top:
+		return p.limit, true
+	case *Head:
+		return p.limit, true
+	case *Tail:
+		return p.limit, true
+	}
+	return 0, false
+}
+
+func isJoin(node ast.Proc) bool {
+	_, ok := node.(*ast.JoinProc)
+	return ok
+}
+
 // Compile the proc AST and return a Executor ready to go
 // "scanner" is embedded into the source node during compilation... XXX fix
 func Compile(node ast.Proc, c *Context, custom Compiler) ([]Proc, error) {
@@ -29,7 +29,7 @@ type Put struct {
 }
 
 func CompilePutProc(c *Context, parent Proc, node *ast.PutProc) (*Put, error) {
-	eval, err := expr.CompileExpr(node.Expr)
+	eval, err := expr.CompileExpr(c.TypeContext, node.Expr)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,278 @@
+package proc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// A Rename proc renames fields of its input records.  A field may be
+// moved into or out of a nested record, e.g., "rename src=id.orig_h"
+// moves the orig_h field of the id record to the top-level field src.
+// The renames are applied in order, and a rename whose source field is
+// not present in a record is ignored.  If the target field is present,
+// it is replaced by the renamed field.
+type Rename struct {
+	Base
+	renames [][2][]string
+	plans   map[int]*renamePlan
+	warned  map[string]struct{}
+}
+
+// A renamePlan is the cached layout of the output record for a given
+// input record type.  A plan with no fields copies the value of the input
+// record, changing only its type to one in the output type context.
+type renamePlan struct {
+	typ    *zng.TypeRecord
+	fields []*renameNode
+}
+
+// A renameNode is a column of an output record.  A column with fields is
+// a nested record rebuilt from those fields.  Otherwise, its value is
+// copied from the input column reached by following the column indexes
+// in src through the nested records of the input.
+type renameNode struct {
+	name   string
+	typ    zng.Type
+	src    []int
+	fields []*renameNode
+}
+
+func CompileRenameProc(c *Context, parent Proc, node *ast.RenameProc) (*Rename, error) {
+	var renames [][2][]string
+	for _, fa := range node.Fields {
+		target, err := split(fa.Target)
+		if err != nil {
+			return nil, fmt.Errorf("compiling rename: %w", err)
+		}
+		source, err := split(fa.Source)
+		if err != nil {
+			return nil, fmt.Errorf("compiling rename: %w", err)
+		}
+		renames = append(renames, [2][]string{target, source})
+	}
+	return &Rename{
+		Base:    Base{Context: c, Parent: parent},
+		renames: renames,
+		plans:   make(map[int]*renamePlan),
+		warned:  make(map[string]struct{}),
+	}, nil
+}
+
+func (r *Rename) maybeWarn(msg string) {
+	if _, ok := r.warned[msg]; !ok {
+		r.Warnings <- msg
+		r.warned[msg] = struct{}{}
+	}
+}
+
+func (r *Rename) plan(typ *zng.TypeRecord) (*renamePlan, error) {
+	root := &renameNode{typ: typ}
+	changed := false
+	for _, rename := range r.renames {
+		target, source := rename[0], rename[1]
+		node := root.remove(source)
+		if node == nil {
+			continue
+		}
+		node.name = target[len(target)-1]
+		if err := root.insert(target, node); err != nil {
+			return nil, fmt.Errorf("rename %s: %w", strings.Join(target, "."), err)
+		}
+		changed = true
+	}
+	if !changed {
+		return r.passPlan(typ), nil
+	}
+	return &renamePlan{
+		typ:    r.TypeContext.LookupTypeRecord(root.columns(r.TypeContext)),
+		fields: root.fields,
+	}, nil
+}
+
+func (r *Rename) passPlan(typ *zng.TypeRecord) *renamePlan {
+	return &renamePlan{typ: r.TypeContext.LookupTypeRecord(typ.Columns)}
+}
+
+// expand makes the columns of the record type of n into fields of n so
+// that they may be rearranged.
+func (n *renameNode) expand() bool {
+	if n.fields != nil {
+		return true
+	}
+	typ, ok := n.typ.(*zng.TypeRecord)
+	if !ok {
+		return false
+	}
+	n.fields = make([]*renameNode, 0, len(typ.Columns))
+	for k, col := range typ.Columns {
+		src := make([]int, len(n.src)+1)
+		copy(src, n.src)
+		src[len(n.src)] = k
+		n.fields = append(n.fields, &renameNode{name: col.Name, typ: col.Type, src: src})
+	}
+	n.typ = nil
+	n.src = nil
+	return true
+}
+
+func (n *renameNode) lookup(name string) int {
+	for k, field := range n.fields {
+		if field.name == name {
+			return k
+		}
+	}
+	return -1
+}
+
+// remove removes the field at path from n and returns it or returns nil
+// if there is no such field.  A nested record left with no fields is
+// removed as well.
+func (n *renameNode) remove(path []string) *renameNode {
+	if !n.expand() {
+		return nil
+	}
+	k := n.lookup(path[0])
+	if k < 0 {
+		return nil
+	}
+	field := n.fields[k]
+	if len(path) > 1 {
+		removed := field.remove(path[1:])
+		if removed != nil && len(field.fields) == 0 {
+			n.fields = append(n.fields[:k], n.fields[k+1:]...)
+		}
+		return removed
+	}
+	n.fields = append(n.fields[:k], n.fields[k+1:]...)
+	return field
+}
+
+// insert places field at path in n, creating any nested records along
+// the path that don't exist.
+func (n *renameNode) insert(path []string, field *renameNode) error {
+	if !n.expand() {
+		return fmt.Errorf("%s is not a record", n.name)
+	}
+	k := n.lookup(path[0])
+	if len(path) == 1 {
+		if k < 0 {
+			n.fields = append(n.fields, field)
+		} else {
+			n.fields[k] = field
+		}
+		return nil
+	}
+	if k < 0 {
+		k = len(n.fields)
+		n.fields = append(n.fields, &renameNode{name: path[0], fields: []*renameNode{}})
+	}
+	return n.fields[k].insert(path[1:], field)
+}
+
+// columns returns the columns of the record formed by the fields of n,
+// creating the types of any rebuilt nested records in zctx.
+func (n *renameNode) columns(zctx *resolver.Context) []zng.Column {
+	cols := make([]zng.Column, 0, len(n.fields))
+	for _, field := range n.fields {
+		typ := field.typ
+		if field.fields != nil {
+			typ = zctx.LookupTypeRecord(field.columns(zctx))
+		}
+		cols = append(cols, zng.NewColumn(field.name, typ))
+	}
+	return cols
+}
+
+// build appends the values of the fields of n, taken from the body of the
+// input record in raw, to b.
+func (n *renameNode) build(b *zcode.Builder, raw zcode.Bytes) error {
+	for _, field := range n.fields {
+		if field.fields != nil {
+			b.BeginContainer()
+			if err := field.build(b, raw); err != nil {
+				return err
+			}
+			b.EndContainer()
+			continue
+		}
+		val, err := valueAt(raw, field.src)
+		if err != nil {
+			return err
+		}
+		if zng.IsContainerType(field.typ) {
+			b.AppendContainer(val)
+		} else {
+			b.AppendPrimitive(val)
+		}
+	}
+	return nil
+}
+
+// valueAt returns the value found by following the column indexes in src
+// through the nested records in the record body raw.
+func valueAt(raw zcode.Bytes, src []int) (zcode.Bytes, error) {
+	val := raw
+	for _, k := range src {
+		if val == nil {
+			// The columns of an unset record are unset.
+			return nil, nil
+		}
+		it := val.Iter()
+		for j := 0; j <= k; j++ {
+			var err error
+			if val, _, err = it.Next(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return val, nil
+}
+
+func (r *Rename) rename(in *zng.Record) (*zng.Record, error) {
+	id := in.Type.ID()
+	plan, ok := r.plans[id]
+	if !ok {
+		var err error
+		plan, err = r.plan(in.Type)
+		if err != nil {
+			// Pass records of this type through unmodified.
+			r.maybeWarn(err.Error())
+			plan = r.passPlan(in.Type)
+		}
+		r.plans[id] = plan
+	}
+	if plan.fields == nil {
+		return zng.NewRecordTs(plan.typ, in.Ts, in.Keep().Raw), nil
+	}
+	b := zcode.NewBuilder()
+	root := &renameNode{fields: plan.fields}
+	if err := root.build(b, in.Raw); err != nil {
+		return nil, err
+	}
+	return zng.NewRecord(plan.typ, b.Bytes())
+}
+
+func (r *Rename) Pull() (zbuf.Batch, error) {
+	batch, err := r.Get()
+	if EOS(batch, err) {
+		return nil, err
+	}
+	recs := make([]*zng.Record, 0, batch.Length())
+	for k := 0; k < batch.Length(); k++ {
+		rec, err := r.rename(batch.Index(k))
+		if err != nil {
+			batch.Unref()
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+	span := batch.Span()
+	batch.Unref()
+	return zbuf.NewArray(recs, span), nil
+}
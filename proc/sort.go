@@ -1,6 +1,7 @@
 package proc
 
 import (
+	"container/heap"
 	"fmt"
 
 	"github.com/brimsec/zq/ast"
@@ -10,27 +11,32 @@ import (
 	"github.com/brimsec/zq/zng"
 )
 
-type ErrSortLimitReached int
-
-func (e ErrSortLimitReached) Error() string {
-	return fmt.Sprintf("sort limit hit (%d)", e)
-}
-
 type Sort struct {
 	Base
 	dir        int
 	limit      int
+	head       int
 	nullsFirst bool
 	fields     []ast.FieldExpr
 	resolvers  []expr.FieldExprResolver
+	unseen     map[ast.FieldExpr]expr.FieldExprResolver
 	out        []*zng.Record
+	heap       *sortHeap
+	spiller    *spiller
+	merger     *runMerger
 }
 
 // defaultSortLimit is the default limit of the number of records that
-// sort will process and, otherwise, return an error if this limit is exceeded.
-// The value can be overridden by setting the limit param on the SortProc.
+// sort will hold in memory.  When this limit is exceeded, the records
+// are sorted and spilled to a temporary file, and the spilled runs are
+// merged once the input is exhausted.  The value can be overridden by
+// setting the limit param on the SortProc.
 const defaultSortLimit = 1000000
 
+// sortBatchSize is the number of records in each batch sort emits
+// when merging spilled runs.
+const sortBatchSize = 100
+
 func CompileSortProc(c *Context, parent Proc, node *ast.SortProc) (*Sort, error) {
 	limit := node.Limit
 	if limit == 0 {
@@ -40,10 +46,18 @@ func CompileSortProc(c *Context, parent Proc, node *ast.SortProc) (*Sort, error)
 	if err != nil {
 		return nil, err
 	}
+	// The first records of the output are kept in a heap, which isn't
+	// spilled, so a head larger than the limit is handled by sorting
+	// all of the input.
+	head := node.Head
+	if head > limit {
+		head = 0
+	}
 	return &Sort{
 		Base:       Base{Context: c, Parent: parent},
 		dir:        node.SortDir,
 		limit:      limit,
+		head:       head,
 		nullsFirst: node.NullsFirst,
 		fields:     node.Fields,
 		resolvers:  resolvers,
@@ -94,22 +108,95 @@ func guessSortField(rec *zng.Record) string {
 }
 
 func (s *Sort) Pull() (zbuf.Batch, error) {
+	if s.merger != nil {
+		return s.next()
+	}
 	for {
 		batch, err := s.Get()
 		if err != nil {
+			s.cleanup()
 			return nil, err
 		}
 		if batch == nil {
-			return s.sort(), nil
-		}
-		if len(s.out)+batch.Length() > s.limit {
-			batch.Unref()
-			return nil, ErrSortLimitReached(s.limit)
+			if s.spiller == nil {
+				return s.sort(), nil
+			}
+			if err := s.startMerge(); err != nil {
+				s.cleanup()
+				return nil, err
+			}
+			return s.next()
 		}
 		// XXX this should handle group-by every ... need to change how we do this
-		s.consume(batch)
+		if s.head > 0 {
+			s.consumeHead(batch)
+		} else {
+			s.consume(batch)
+		}
 		batch.Unref()
+		if len(s.out) >= s.limit {
+			if err := s.spill(); err != nil {
+				s.cleanup()
+				return nil, err
+			}
+		}
+	}
+}
+
+// spill sorts the records held in memory and writes them out as a run.
+func (s *Sort) spill() error {
+	if s.spiller == nil {
+		var err error
+		s.spiller, err = newSpiller()
+		if err != nil {
+			return err
+		}
 	}
+	out := s.sortOut()
+	if len(out) == 0 {
+		return nil
+	}
+	return s.spiller.spill(out)
+}
+
+func (s *Sort) startMerge() error {
+	if err := s.spill(); err != nil {
+		return err
+	}
+	s.warnAboutUnseenFields()
+	merger, err := s.spiller.merge(s.TypeContext, s.compareFn())
+	if err != nil {
+		return err
+	}
+	s.merger = merger
+	return nil
+}
+
+// next returns the next batch of merged records from the spilled runs.
+func (s *Sort) next() (zbuf.Batch, error) {
+	batch, err := zbuf.ReadBatch(s.merger, sortBatchSize)
+	if EOS(batch, err) {
+		s.cleanup()
+	}
+	return batch, err
+}
+
+func (s *Sort) cleanup() {
+	if s.merger != nil {
+		s.merger.Close()
+		s.merger = nil
+	}
+	if s.spiller != nil {
+		s.spiller.cleanup()
+		s.spiller = nil
+	}
+	s.out = nil
+	s.heap = nil
+}
+
+func (s *Sort) Done() {
+	s.cleanup()
+	s.Base.Done()
 }
 
 func (s *Sort) consume(batch zbuf.Batch) {
@@ -119,59 +206,167 @@ func (s *Sort) consume(batch zbuf.Batch) {
 	}
 }
 
+// consumeHead adds the records of batch to s.heap, which holds the first
+// s.head records in the order of the sort.
+func (s *Sort) consumeHead(batch zbuf.Batch) {
+	for k := 0; k < batch.Length(); k++ {
+		rec := batch.Index(k)
+		if s.heap == nil {
+			if s.resolvers == nil {
+				s.guessResolver(rec)
+			}
+			s.heap = &sortHeap{compare: s.compareFn()}
+		}
+		s.heap.add(rec, s.head)
+	}
+	if len(s.fields) > 0 {
+		s.updateUnseenFields(batch.Records())
+	}
+}
+
 func (s *Sort) sort() zbuf.Batch {
+	out := s.sortOut()
+	if len(out) == 0 {
+		return nil
+	}
+	s.warnAboutUnseenFields()
+	return zbuf.NewArray(out, nano.NewSpanTs(s.MinTs, s.MaxTs))
+}
+
+// sortOut sorts and returns the records held in memory.
+func (s *Sort) sortOut() []*zng.Record {
+	if s.heap != nil {
+		out := s.heap.sorted()
+		s.heap = nil
+		return out
+	}
 	out := s.out
 	if len(out) == 0 {
 		return nil
 	}
 	s.out = nil
 	if s.resolvers == nil {
-		fld := guessSortField(out[0])
-		resolver := func(r *zng.Record) zng.Value {
-			e, err := r.Access(fld)
-			if err != nil {
-				return zng.Value{}
-			}
-			return e
+		s.guessResolver(out[0])
+	} else if len(s.fields) > 0 {
+		s.updateUnseenFields(out)
+	}
+	expr.SortStable(out, s.compareFn())
+	return out
+}
+
+// guessResolver sets the sort key to the field chosen by guessSortField
+// for rec.
+func (s *Sort) guessResolver(rec *zng.Record) {
+	fld := guessSortField(rec)
+	resolver := func(r *zng.Record) zng.Value {
+		e, err := r.Access(fld)
+		if err != nil {
+			return zng.Value{}
 		}
-		s.resolvers = []expr.FieldExprResolver{resolver}
-	} else {
-		s.warnAboutUnseenFields(out)
+		return e
 	}
+	s.resolvers = []expr.FieldExprResolver{resolver}
+}
+
+func (s *Sort) compareFn() expr.SortFn {
 	nullsMax := !s.nullsFirst
 	if s.dir < 0 {
 		nullsMax = !nullsMax
 	}
 	sorter := expr.NewSortFn(nullsMax, s.resolvers...)
-	sortWithDir := func(a, b *zng.Record) int {
+	return func(a, b *zng.Record) int {
 		return s.dir * sorter(a, b)
 	}
-	expr.SortStable(out, sortWithDir)
-	return zbuf.NewArray(out, nano.NewSpanTs(s.MinTs, s.MaxTs))
 }
 
-func (s *Sort) warnAboutUnseenFields(records []*zng.Record) {
-	unseenFields := make(map[ast.FieldExpr]expr.FieldExprResolver)
-	for i, r := range s.resolvers {
-		unseenFields[s.fields[i]] = r
+// updateUnseenFields removes from s.unseen any sort field that is present
+// in the records.  Since sorted runs may be spilled several times, the
+// warnings for fields not present in any of them are issued at the end.
+func (s *Sort) updateUnseenFields(records []*zng.Record) {
+	if s.unseen == nil {
+		s.unseen = make(map[ast.FieldExpr]expr.FieldExprResolver)
+		for i, r := range s.resolvers {
+			s.unseen[s.fields[i]] = r
+		}
 	}
 	sawType := make(map[*zng.TypeRecord]bool)
 	for _, rec := range records {
+		if len(s.unseen) == 0 {
+			break
+		}
 		if !sawType[rec.Type] {
 			sawType[rec.Type] = true
-			for field, res := range unseenFields {
+			for field, res := range s.unseen {
 				if !res(rec).IsNil() {
-					delete(unseenFields, field)
+					delete(s.unseen, field)
 				}
 			}
-			if len(unseenFields) == 0 {
-				break
-			}
 		}
 	}
+}
+
+func (s *Sort) warnAboutUnseenFields() {
 	for _, f := range s.fields {
-		if _, ok := unseenFields[f]; ok {
+		if _, ok := s.unseen[f]; ok {
 			s.Warnings <- fmt.Sprintf("Sort field %s not present in input", expr.FieldExprToString(f))
 		}
 	}
 }
+
+// sortHeap is a max-heap holding the records that come first in the order
+// of a sort, where the root is the last of them.  Records that compare
+// equal are ordered by their arrival so that the records are those that
+// come first in a stable sort.
+type sortHeap struct {
+	entries []sortEntry
+	compare expr.SortFn
+	seq     int
+}
+
+type sortEntry struct {
+	rec *zng.Record
+	seq int
+}
+
+func (h *sortHeap) Len() int { return len(h.entries) }
+
+func (h *sortHeap) Less(i, j int) bool {
+	if c := h.compare(h.entries[i].rec, h.entries[j].rec); c != 0 {
+		return c > 0
+	}
+	return h.entries[i].seq > h.entries[j].seq
+}
+
+func (h *sortHeap) Swap(i, j int) { h.entries[i], h.entries[j] = h.entries[j], h.entries[i] }
+
+func (h *sortHeap) Push(e interface{}) { h.entries = append(h.entries, e.(sortEntry)) }
+
+func (h *sortHeap) Pop() interface{} {
+	n := len(h.entries) - 1
+	e := h.entries[n]
+	h.entries = h.entries[:n]
+	return e
+}
+
+// add adds rec to the heap if it is among the first max records seen.
+func (h *sortHeap) add(rec *zng.Record, max int) {
+	h.seq++
+	if len(h.entries) < max {
+		heap.Push(h, sortEntry{rec.Keep(), h.seq})
+		return
+	}
+	// A record equal to the root arrived after it and so comes later.
+	if h.compare(rec, h.entries[0].rec) < 0 {
+		h.entries[0] = sortEntry{rec.Keep(), h.seq}
+		heap.Fix(h, 0)
+	}
+}
+
+// sorted empties the heap and returns its records in order.
+func (h *sortHeap) sorted() []*zng.Record {
+	out := make([]*zng.Record, len(h.entries))
+	for k := len(out) - 1; k >= 0; k-- {
+		out[k] = heap.Pop(h).(sortEntry).rec
+	}
+	return out
+}
@@ -1,9 +1,18 @@
 package proc_test
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/test"
 	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Data sets for tests:
@@ -164,3 +173,116 @@ func TestSort(t *testing.T) {
 	const warning = "Sort field bar not present in input"
 	proc.TestOneProcWithWarnings(t, unsortedInts, ascendingInts, []string{warning}, "sort foo, bar")
 }
+
+func TestSortSpill(t *testing.T) {
+	// Test that records spilled to disk in several sorted runs
+	// are merged back into order.
+	const in1 = `
+#0:record[foo:int32,bar:string]
+0:[3;a;]
+0:[1;b;]
+`
+	const in2 = `
+#0:record[foo:int32,bar:string]
+0:[2;c;]
+0:[1;d;]
+`
+	const in3 = `
+#0:record[foo:int32,bar:string]
+0:[-;e;]
+0:[3;f;]
+`
+	const out = `
+#0:record[foo:int32,bar:string]
+0:[1;b;]
+0:[1;d;]
+0:[2;c;]
+0:[3;a;]
+0:[3;f;]
+0:[-;e;]
+`
+	proc.TestOneProcWithBatches(t, "sort -limit 2 foo", in1, in2, in3, out)
+
+	const outReverse = `
+#0:record[foo:int32,bar:string]
+0:[3;a;]
+0:[3;f;]
+0:[2;c;]
+0:[1;b;]
+0:[1;d;]
+0:[-;e;]
+`
+	proc.TestOneProcWithBatches(t, "sort -limit 2 -r foo", in1, in2, in3, outReverse)
+
+	// Test that sort picks a field once when runs are spilled.
+	proc.TestOneProcWithBatches(t, "sort -limit 1", in1, in2, in3, out)
+}
+
+func TestSortHead(t *testing.T) {
+	// Test that a sort with a head keeps the first records of a stable
+	// sort across batches.
+	const in1 = `
+#0:record[foo:int32,bar:string]
+0:[3;a;]
+0:[1;b;]
+0:[-;c;]
+`
+	const in2 = `
+#0:record[foo:int32,bar:string]
+0:[1;d;]
+0:[2;e;]
+0:[1;f;]
+`
+	run := func(node *ast.SortProc, out string) {
+		zctx := resolver.NewContext()
+		var batches []zbuf.Batch
+		for _, s := range []string{in1, in2} {
+			r := zngio.NewReader(strings.NewReader(s), zctx)
+			b, err := zbuf.ReadBatch(r, 100)
+			require.NoError(t, err)
+			batches = append(batches, b)
+		}
+		c := proc.NewTestContext(zctx)
+		sort, err := proc.CompileSortProc(c, proc.NewTestSource(batches), node)
+		require.NoError(t, err)
+		pt := proc.NewProcTest(sort, c)
+		result, err := pt.Pull()
+		require.NoError(t, err)
+		require.NoError(t, pt.ExpectEOS())
+		require.NoError(t, pt.Finish())
+		var buf bytes.Buffer
+		w := zngio.NewWriter(&buf)
+		for _, rec := range result.Records() {
+			require.NoError(t, w.Write(rec))
+		}
+		assert.Equal(t, test.Trim(out), buf.String())
+	}
+	foo := []ast.FieldExpr{&ast.FieldRead{Node: ast.Node{"FieldRead"}, Field: "foo"}}
+	run(&ast.SortProc{Fields: foo, SortDir: 1, Head: 3}, `
+#0:record[foo:int32,bar:string]
+0:[1;b;]
+0:[1;d;]
+0:[1;f;]
+`)
+	run(&ast.SortProc{Fields: foo, SortDir: -1, Head: 2}, `
+#0:record[foo:int32,bar:string]
+0:[3;a;]
+0:[2;e;]
+`)
+	// The sort field is guessed from the first record.
+	run(&ast.SortProc{SortDir: 1, NullsFirst: true, Head: 2}, `
+#0:record[foo:int32,bar:string]
+0:[-;c;]
+0:[1;b;]
+`)
+	// A head larger than the limit is ignored.
+	run(&ast.SortProc{Fields: foo, SortDir: 1, Limit: 2, Head: 4}, `
+#0:record[foo:int32,bar:string]
+0:[1;b;]
+0:[1;d;]
+0:[1;f;]
+0:[2;e;]
+0:[3;a;]
+0:[-;c;]
+`)
+}
@@ -0,0 +1,171 @@
+package proc
+
+import (
+	"bufio"
+	"container/heap"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/bzngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// A spiller writes sorted runs of records to temporary bzng files so a
+// proc can work on more records than it is willing to hold in memory.
+// The runs are later merged back into a single sorted stream.
+type spiller struct {
+	dir   string
+	nruns int
+}
+
+func newSpiller() (*spiller, error) {
+	dir, err := ioutil.TempDir("", "zq-spill-")
+	if err != nil {
+		return nil, err
+	}
+	return &spiller{dir: dir}, nil
+}
+
+func (s *spiller) path(run int) string {
+	return filepath.Join(s.dir, strconv.Itoa(run))
+}
+
+// spill writes the records, which must already be sorted, as a new run.
+func (s *spiller) spill(recs []*zng.Record) error {
+	f, err := os.Create(s.path(s.nruns))
+	if err != nil {
+		return err
+	}
+	s.nruns++
+	bw := bufio.NewWriter(f)
+	w := bzngio.NewWriter(bw, zio.Flags{})
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// merge opens each run and returns a runMerger that reads the records
+// of all the runs in the order given by cmp.  Records from different runs
+// that compare equal are returned in the order their runs were spilled.
+func (s *spiller) merge(zctx *resolver.Context, cmp expr.SortFn) (*runMerger, error) {
+	m := &runMerger{cmp: cmp}
+	for k := 0; k < s.nruns; k++ {
+		f, err := os.Open(s.path(k))
+		if err != nil {
+			m.Close()
+			return nil, err
+		}
+		r := &run{
+			file:   f,
+			reader: bzngio.NewReader(f, zctx),
+			index:  k,
+		}
+		m.runs = append(m.runs, r)
+		if err := m.advance(r); err != nil {
+			m.Close()
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// cleanup removes all of the spilled runs.
+func (s *spiller) cleanup() error {
+	return os.RemoveAll(s.dir)
+}
+
+type run struct {
+	file   *os.File
+	reader *bzngio.Reader
+	index  int
+	rec    *zng.Record
+}
+
+// runMerger implements zbuf.Reader over the k-way merge of a set of runs.
+type runMerger struct {
+	runs []*run
+	heap []*run
+	cmp  expr.SortFn
+	last *run
+}
+
+func (m *runMerger) advance(r *run) error {
+	rec, err := r.reader.Read()
+	if err != nil {
+		return fmt.Errorf("reading spilled records: %w", err)
+	}
+	if rec != nil {
+		r.rec = rec
+		heap.Push(m, r)
+	}
+	return nil
+}
+
+// Read returns the next record in sorted order.  As with other readers,
+// the returned record is only valid until the next call to Read.
+func (m *runMerger) Read() (*zng.Record, error) {
+	// The last record returned may live in its run's read buffer, so
+	// the run isn't advanced until the caller is done with it.
+	if m.last != nil {
+		if err := m.advance(m.last); err != nil {
+			return nil, err
+		}
+		m.last = nil
+	}
+	if len(m.heap) == 0 {
+		return nil, nil
+	}
+	r := heap.Pop(m).(*run)
+	m.last = r
+	return r.rec, nil
+}
+
+func (m *runMerger) Close() error {
+	var err error
+	for _, r := range m.runs {
+		if e := r.file.Close(); err == nil {
+			err = e
+		}
+	}
+	m.runs = nil
+	m.heap = nil
+	m.last = nil
+	return err
+}
+
+func (m *runMerger) Len() int { return len(m.heap) }
+
+func (m *runMerger) Less(i, j int) bool {
+	a, b := m.heap[i], m.heap[j]
+	if c := m.cmp(a.rec, b.rec); c != 0 {
+		return c < 0
+	}
+	return a.index < b.index
+}
+
+func (m *runMerger) Swap(i, j int) { m.heap[i], m.heap[j] = m.heap[j], m.heap[i] }
+
+func (m *runMerger) Push(x interface{}) {
+	m.heap = append(m.heap, x.(*run))
+}
+
+func (m *runMerger) Pop() interface{} {
+	n := len(m.heap)
+	r := m.heap[n-1]
+	m.heap = m.heap[:n-1]
+	return r
+}
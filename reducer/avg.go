@@ -2,7 +2,9 @@ package reducer
 
 import (
 	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zngnative"
 )
 
@@ -54,3 +56,37 @@ func (a *Avg) Result() zng.Value {
 	}
 	return zng.Value{Type: zng.TypeFloat64}
 }
+
+const (
+	sumName   = "sum"
+	countName = "count"
+)
+
+func (a *Avg) ConsumePart(p zng.Value) error {
+	cols, err := partColumns(p, zng.TypeFloat64, zng.TypeUint64)
+	if err != nil {
+		return err
+	}
+	sum, err := zng.DecodeFloat64(cols[0])
+	if err != nil {
+		return err
+	}
+	count, err := zng.DecodeUint(cols[1])
+	if err != nil {
+		return err
+	}
+	a.sum += sum
+	a.count += count
+	return nil
+}
+
+func (a *Avg) ResultPart(zctx *resolver.Context) (zng.Value, error) {
+	var zv zcode.Bytes
+	zv = zcode.AppendPrimitive(zv, zng.EncodeFloat64(a.sum))
+	zv = zcode.AppendPrimitive(zv, zng.EncodeUint(a.count))
+	typ := zctx.LookupTypeRecord([]zng.Column{
+		zng.NewColumn(sumName, zng.TypeFloat64),
+		zng.NewColumn(countName, zng.TypeUint64),
+	})
+	return zng.Value{Type: typ, Bytes: zv}, nil
+}
@@ -0,0 +1,147 @@
+package reducer
+
+import (
+	"fmt"
+
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// DefaultContainerLimit is the default maximum number of values held by
+// each instance of a reducer whose result is a container.
+const DefaultContainerLimit = 1000
+
+// containerLimit caps the number of values in the result of each
+// instance of a container reducer and sends a warning the first time
+// any instance exceeds the cap.
+type containerLimit struct {
+	target   string
+	limit    int
+	warnings chan string
+	warned   bool
+}
+
+func newContainerLimit(target string, limit int, warnings chan string) *containerLimit {
+	if limit == 0 {
+		limit = DefaultContainerLimit
+	}
+	return &containerLimit{target, limit, warnings, false}
+}
+
+func (c *containerLimit) exceeded() {
+	if c.warned || c.warnings == nil {
+		return
+	}
+	c.warned = true
+	c.warnings <- fmt.Sprintf("%s: more than %d values in a group; result truncated", c.target, c.limit)
+}
+
+type CollectProto struct {
+	target   string
+	resolver expr.FieldExprResolver
+	zctx     *resolver.Context
+	limit    *containerLimit
+}
+
+func (cp *CollectProto) Target() string {
+	return cp.target
+}
+
+func (cp *CollectProto) Instantiate(rec *zng.Record) Interface {
+	return &Collect{
+		Resolver: cp.resolver,
+		zctx:     cp.zctx,
+		limit:    cp.limit,
+		initial:  initialType(cp.resolver, rec),
+	}
+}
+
+// NewCollectProto returns a prototype for reducers that collect up to
+// limit values of a field into an array, whose type is created in zctx.
+// If limit is zero, DefaultContainerLimit is used.  A warning is sent on
+// warnings, if it is not nil, when a reducer has more values than limit.
+func NewCollectProto(target string, resolver expr.FieldExprResolver, limit int, zctx *resolver.Context, warnings chan string) *CollectProto {
+	return &CollectProto{
+		target:   target,
+		resolver: resolver,
+		zctx:     zctx,
+		limit:    newContainerLimit(target, limit, warnings),
+	}
+}
+
+// Collect builds an array of the values of a field in the order they are
+// consumed.  The type of the array elements is the type of the first
+// value and values of other types are ignored.
+type Collect struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	zctx     *resolver.Context
+	limit    *containerLimit
+	// initial is the element type of an empty result.
+	initial zng.Type
+	typ     zng.Type
+	n       int
+	body    zcode.Bytes
+}
+
+func (c *Collect) Consume(r *zng.Record) {
+	v := c.Resolver(r)
+	if v.Type == nil {
+		c.FieldNotFound++
+		return
+	}
+	if v.Bytes == nil {
+		return
+	}
+	c.append(v)
+}
+
+func (c *Collect) append(v zng.Value) {
+	if c.typ == nil {
+		c.typ = v.Type
+	} else if v.Type != c.typ {
+		c.TypeMismatch++
+		return
+	}
+	if c.n >= c.limit.limit {
+		c.limit.exceeded()
+		return
+	}
+	c.body = v.Encode(c.body)
+	c.n++
+}
+
+func (c *Collect) Result() zng.Value {
+	if c.typ == nil {
+		return zng.Value{Type: c.zctx.LookupTypeArray(c.initial)}
+	}
+	return zng.Value{Type: c.zctx.LookupTypeArray(c.typ), Bytes: c.body}
+}
+
+// ConsumePart appends the elements of an array produced by ResultPart.
+func (c *Collect) ConsumePart(p zng.Value) error {
+	typ, ok := p.Type.(*zng.TypeArray)
+	if !ok {
+		return ErrBadPart
+	}
+	if p.Bytes == nil {
+		if c.initial == zng.TypeNull {
+			c.initial = typ.Type
+		}
+		return nil
+	}
+	for it := p.Bytes.Iter(); !it.Done(); {
+		zv, _, err := it.Next()
+		if err != nil {
+			return err
+		}
+		c.append(zng.Value{Type: typ.Type, Bytes: zv})
+	}
+	return nil
+}
+
+func (c *Collect) ResultPart(*resolver.Context) (zng.Value, error) {
+	return c.Result(), nil
+}
@@ -3,12 +3,14 @@ package compile
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/reducer"
 	"github.com/brimsec/zq/reducer/field"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 var (
@@ -21,7 +23,10 @@ type CompiledReducer interface {
 	Instantiate(*zng.Record) reducer.Interface
 }
 
-func Compile(params ast.Reducer) (CompiledReducer, error) {
+// Compile returns the CompiledReducer for params.  Any types needed by the
+// reducer's results are created in zctx and any warnings are sent on
+// warnings.
+func Compile(params ast.Reducer, zctx *resolver.Context, warnings chan string) (CompiledReducer, error) {
 	name := params.Var
 	var fld expr.FieldExprResolver
 	if params.Field != nil {
@@ -29,6 +34,11 @@ func Compile(params ast.Reducer) (CompiledReducer, error) {
 		if fld, err = expr.CompileFieldExpr(params.Field); err != nil {
 			return nil, err
 		}
+	} else if params.Expr != nil {
+		var err error
+		if fld, err = expr.CompileExprResolver(zctx, params.Expr); err != nil {
+			return nil, err
+		}
 	}
 
 	switch params.Op {
@@ -54,6 +64,53 @@ func Compile(params ast.Reducer) (CompiledReducer, error) {
 			return nil, ErrFieldRequired
 		}
 		return reducer.NewCountDistinctProto(name, fld), nil
+	case "Median":
+		if fld == nil {
+			return nil, ErrFieldRequired
+		}
+		return reducer.NewQuantileProto(name, fld, 0.5), nil
+	case "Quantile":
+		if fld == nil {
+			return nil, ErrFieldRequired
+		}
+		if params.Param < 0 || params.Param > 1 {
+			return nil, fmt.Errorf("quantile must be between 0 and 1: %g", params.Param)
+		}
+		return reducer.NewQuantileProto(name, fld, params.Param), nil
+	case "Percentile":
+		if fld == nil {
+			return nil, ErrFieldRequired
+		}
+		if params.Param < 0 || params.Param > 100 {
+			return nil, fmt.Errorf("percentile must be between 0 and 100: %g", params.Param)
+		}
+		return reducer.NewQuantileProto(name, fld, params.Param/100), nil
+	case "Var", "Stddev", "Stdev":
+		if fld == nil {
+			return nil, ErrFieldRequired
+		}
+		return reducer.NewVarianceProto(name, fld, params.Op != "Var"), nil
+	case "Histogram":
+		if fld == nil {
+			return nil, ErrFieldRequired
+		}
+		buckets := int(params.Param)
+		if float64(buckets) != params.Param || buckets < 1 {
+			return nil, fmt.Errorf("histogram buckets must be a positive integer: %g", params.Param)
+		}
+		return reducer.NewHistogramProto(name, fld, buckets, zctx), nil
+	case "Collect", "Union":
+		if fld == nil {
+			return nil, ErrFieldRequired
+		}
+		limit := int(params.Param)
+		if float64(limit) != params.Param || limit < 0 {
+			return nil, fmt.Errorf("%s limit must be a non-negative integer: %g", strings.ToLower(params.Op), params.Param)
+		}
+		if params.Op == "Collect" {
+			return reducer.NewCollectProto(name, fld, limit, zctx, warnings), nil
+		}
+		return reducer.NewUnionProto(name, fld, limit, zctx, warnings), nil
 	case "Sum", "Min", "Max":
 		if fld == nil {
 			return nil, ErrFieldRequired
@@ -43,6 +43,32 @@ func (r *Row) Consume(rec *zng.Record) {
 	}
 }
 
+// ConsumePart combines a partial result for each reducer, as produced by
+// ResultPart, into the row.
+func (r *Row) ConsumePart(parts []zng.Value) error {
+	r.Touch(nil)
+	for k, red := range r.Reducers {
+		if err := red.ConsumePart(parts[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResultPart returns the partial result of each reducer.  Any new types
+// needed to represent the partial results are created in zctx.
+func (r *Row) ResultPart(zctx *resolver.Context) ([]zng.Value, error) {
+	parts := make([]zng.Value, 0, len(r.Reducers))
+	for _, red := range r.Reducers {
+		part, err := red.ResultPart(zctx)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+	}
+	return parts, nil
+}
+
 // Result creates a new record from the results of the reducers.
 func (r *Row) Result(zctx *resolver.Context) *zng.Record {
 	n := len(r.Reducers)
@@ -3,6 +3,7 @@ package reducer
 import (
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 type CountProto struct {
@@ -40,3 +41,19 @@ func (c *Count) Consume(r *zng.Record) {
 func (c *Count) Result() zng.Value {
 	return zng.NewUint64(c.count)
 }
+
+func (c *Count) ConsumePart(p zng.Value) error {
+	if p.Type != zng.TypeUint64 {
+		return ErrBadPart
+	}
+	u, err := zng.DecodeUint(p.Bytes)
+	if err != nil {
+		return err
+	}
+	c.count += u
+	return nil
+}
+
+func (c *Count) ResultPart(*resolver.Context) (zng.Value, error) {
+	return c.Result(), nil
+}
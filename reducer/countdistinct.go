@@ -4,6 +4,7 @@ import (
 	"github.com/axiomhq/hyperloglog"
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 type CountDistinctProto struct {
@@ -43,6 +44,27 @@ func (c *CountDistinct) Result() zng.Value {
 	return zng.NewUint64(c.sketch.Estimate())
 }
 
+// ConsumePart merges a sketch marshaled by ResultPart into the
+// reducer's sketch.
+func (c *CountDistinct) ConsumePart(p zng.Value) error {
+	if p.Type != zng.TypeBstring {
+		return ErrBadPart
+	}
+	var sketch hyperloglog.Sketch
+	if err := sketch.UnmarshalBinary(p.Bytes); err != nil {
+		return err
+	}
+	return c.sketch.Merge(&sketch)
+}
+
+func (c *CountDistinct) ResultPart(*resolver.Context) (zng.Value, error) {
+	b, err := c.sketch.MarshalBinary()
+	if err != nil {
+		return zng.Value{}, err
+	}
+	return zng.Value{Type: zng.TypeBstring, Bytes: b}, nil
+}
+
 // Sketch returns the native structure used to compute the distinct count
 // approixmation. This method is exposed in case someone wants to merge the
 // results with another CountDistinct reducer.
@@ -4,6 +4,7 @@ import (
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/reducer"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 type Streamfn interface {
@@ -22,11 +23,13 @@ func (fp *FieldProto) Target() string {
 }
 
 func (fp *FieldProto) Instantiate(rec *zng.Record) reducer.Interface {
-	v := fp.resolver(rec)
-	if v.Type == nil {
-		v.Type = zng.TypeNull
+	typ := zng.Type(zng.TypeNull)
+	if rec != nil {
+		if v := fp.resolver(rec); v.Type != nil {
+			typ = v.Type
+		}
 	}
-	return &FieldReducer{op: fp.op, resolver: fp.resolver, typ: v.Type}
+	return &FieldReducer{op: fp.op, resolver: fp.resolver, typ: typ}
 }
 
 func NewFieldProto(target string, resolver expr.FieldExprResolver, op string) *FieldProto {
@@ -59,6 +62,28 @@ func (fr *FieldReducer) Consume(r *zng.Record) {
 		fr.FieldNotFound++
 		return
 	}
+	fr.consume(val)
+}
+
+// ConsumePart combines the partial result of another FieldReducer.
+// Since sum, min, and max of partial results are the sum, min, and max
+// of the whole, a part is consumed just like a value of the field.
+func (fr *FieldReducer) ConsumePart(p zng.Value) error {
+	if p.Bytes == nil {
+		if fr.fn == nil && fr.typ == zng.TypeNull {
+			fr.typ = p.Type
+		}
+		return nil
+	}
+	fr.consume(p)
+	return nil
+}
+
+func (fr *FieldReducer) ResultPart(*resolver.Context) (zng.Value, error) {
+	return fr.Result(), nil
+}
+
+func (fr *FieldReducer) consume(val zng.Value) {
 	if val.Bytes == nil {
 		return
 	}
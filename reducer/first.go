@@ -2,7 +2,9 @@ package reducer
 
 import (
 	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 type FirstProto struct {
@@ -15,11 +17,7 @@ func (fp *FirstProto) Target() string {
 }
 
 func (fp *FirstProto) Instantiate(rec *zng.Record) Interface {
-	v := fp.resolver(rec)
-	if v.Type == nil {
-		v.Type = zng.TypeNull
-	}
-	return &First{Resolver: fp.resolver, typ: v.Type}
+	return &First{Resolver: fp.resolver, typ: initialType(fp.resolver, rec)}
 }
 
 func NewFirstProto(target string, field expr.FieldExprResolver) *FirstProto {
@@ -30,22 +28,66 @@ type First struct {
 	Reducer
 	Resolver expr.FieldExprResolver
 	typ      zng.Type
-	record   *zng.Record
+	val      *zng.Value
 }
 
 func (f *First) Consume(r *zng.Record) {
-	if f.record != nil {
+	if f.val != nil {
 		return
 	}
-	if v := f.Resolver(r); v.Type == nil {
+	v := f.Resolver(r)
+	if v.Type == nil {
 		return
 	}
-	f.record = r
+	f.val = copyValue(v)
 }
 
 func (f *First) Result() zng.Value {
-	if f.record == nil {
+	if f.val == nil {
 		return zng.Value{Type: f.typ, Bytes: nil}
 	}
-	return f.Resolver(f.record)
+	return *f.val
+}
+
+func (f *First) ConsumePart(p zng.Value) error {
+	if f.val != nil {
+		return nil
+	}
+	if p.Bytes == nil {
+		// An unset part carries no value but may carry the type
+		// of the field, which is better than the null type.
+		if f.typ == zng.TypeNull {
+			f.typ = p.Type
+		}
+		return nil
+	}
+	f.val = copyValue(p)
+	return nil
+}
+
+func (f *First) ResultPart(*resolver.Context) (zng.Value, error) {
+	return f.Result(), nil
+}
+
+// initialType returns the type of the field in rec, which is used as the
+// type of an unset result, or the null type if rec is nil or does not
+// have the field.
+func initialType(resolver expr.FieldExprResolver, rec *zng.Record) zng.Type {
+	if rec != nil {
+		if v := resolver(rec); v.Type != nil {
+			return v.Type
+		}
+	}
+	return zng.TypeNull
+}
+
+// copyValue returns a copy of v that does not alias the buffer of the
+// record it came from.
+func copyValue(v zng.Value) *zng.Value {
+	var b zcode.Bytes
+	if v.Bytes != nil {
+		b = make(zcode.Bytes, len(v.Bytes))
+		copy(b, v.Bytes)
+	}
+	return &zng.Value{Type: v.Type, Bytes: b}
 }
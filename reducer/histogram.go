@@ -0,0 +1,201 @@
+package reducer
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zngnative"
+)
+
+type HistogramProto struct {
+	target   string
+	resolver expr.FieldExprResolver
+	buckets  int
+	typ      zng.Type
+}
+
+func (hp *HistogramProto) Target() string {
+	return hp.target
+}
+
+func (hp *HistogramProto) Instantiate(*zng.Record) Interface {
+	return &Histogram{
+		Resolver: hp.resolver,
+		buckets:  hp.buckets,
+		typ:      hp.typ,
+		min:      math.Inf(1),
+		max:      math.Inf(-1),
+	}
+}
+
+// NewHistogramProto returns a prototype for reducers that count the values
+// of a numeric field in the given number of equal-width buckets spanning
+// the smallest to the largest value.  The result is an array[uint64]
+// whose type is created in zctx.
+func NewHistogramProto(target string, resolver expr.FieldExprResolver, buckets int, zctx *resolver.Context) *HistogramProto {
+	return &HistogramProto{
+		target:   target,
+		resolver: resolver,
+		buckets:  buckets,
+		typ:      zctx.LookupTypeArray(zng.TypeUint64),
+	}
+}
+
+// HistogramBins bounds the number of bins kept by a Histogram.
+const HistogramBins = 1000
+
+// Histogram summarizes the values it consumes as at most HistogramBins
+// bins so that the buckets, which depend on the range of all the values,
+// can be formed once the range is known.  Each bin holds a count of
+// values and their mean.  While there are no more distinct values than
+// bins, each bin holds a single value and the buckets are exact.  Beyond
+// that, the closest bins are merged, as in the streaming histogram of
+// Ben-Haim and Tom-Tov, and the values of a bin are all counted in the
+// bucket holding its mean.  Like Avg, the values are treated as float64.
+type Histogram struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	buckets  int
+	typ      zng.Type
+	bins     []histogramBin
+	min      float64
+	max      float64
+}
+
+type histogramBin struct {
+	mean  float64
+	count uint64
+}
+
+func (h *Histogram) Consume(r *zng.Record) {
+	v := h.Resolver(r)
+	if v.Type == nil {
+		h.FieldNotFound++
+		return
+	}
+	if v.Bytes == nil {
+		return
+	}
+	d, ok := zngnative.CoerceToFloat64(v)
+	if !ok || math.IsNaN(d) {
+		h.TypeMismatch++
+		return
+	}
+	h.add(d, 1)
+}
+
+func (h *Histogram) add(v float64, count uint64) {
+	h.min = math.Min(h.min, v)
+	h.max = math.Max(h.max, v)
+	h.bins = append(h.bins, histogramBin{v, count})
+	if len(h.bins) >= 2*HistogramBins {
+		h.shrink()
+	}
+}
+
+// shrink sorts the bins, combines bins with equal means, and then merges
+// the bins separated by the smallest gaps until at most HistogramBins remain.
+func (h *Histogram) shrink() {
+	sort.Slice(h.bins, func(i, j int) bool { return h.bins[i].mean < h.bins[j].mean })
+	bins := h.bins[:1]
+	for _, b := range h.bins[1:] {
+		last := &bins[len(bins)-1]
+		if b.mean == last.mean {
+			last.count += b.count
+		} else {
+			bins = append(bins, b)
+		}
+	}
+	h.bins = bins
+	excess := len(bins) - HistogramBins
+	if excess <= 0 {
+		return
+	}
+	// gaps[k] is the index of the bin that follows the kth smallest gap.
+	gaps := make([]int, len(bins)-1)
+	for k := range gaps {
+		gaps[k] = k + 1
+	}
+	sort.Slice(gaps, func(i, j int) bool {
+		return bins[gaps[i]].mean-bins[gaps[i]-1].mean < bins[gaps[j]].mean-bins[gaps[j]-1].mean
+	})
+	merge := make([]bool, len(bins))
+	for _, k := range gaps[:excess] {
+		merge[k] = true
+	}
+	out := bins[:1]
+	for k, b := range bins[1:] {
+		if !merge[k+1] {
+			out = append(out, b)
+			continue
+		}
+		last := &out[len(out)-1]
+		last.count += b.count
+		last.mean += (b.mean - last.mean) * float64(b.count) / float64(last.count)
+	}
+	h.bins = out
+}
+
+func (h *Histogram) Result() zng.Value {
+	if len(h.bins) == 0 {
+		return zng.Value{Type: h.typ}
+	}
+	buckets := make([]uint64, h.buckets)
+	for _, b := range h.bins {
+		var k int
+		if h.max > h.min {
+			// The last bucket includes max.
+			k = int((b.mean - h.min) / (h.max - h.min) * float64(h.buckets))
+			if k >= h.buckets {
+				k = h.buckets - 1
+			}
+		}
+		buckets[k] += b.count
+	}
+	var zv zcode.Bytes
+	for _, count := range buckets {
+		zv = zcode.AppendPrimitive(zv, zng.EncodeUint(count))
+	}
+	return zng.Value{Type: h.typ, Bytes: zv}
+}
+
+// ConsumePart adds the bins of another Histogram, which ResultPart
+// encodes as its extrema followed by a sequence of mean and count pairs.
+func (h *Histogram) ConsumePart(p zng.Value) error {
+	if p.Type != zng.TypeBstring || len(p.Bytes) < 16 || len(p.Bytes)%16 != 0 {
+		return ErrBadPart
+	}
+	b := p.Bytes
+	min := math.Float64frombits(binary.BigEndian.Uint64(b))
+	max := math.Float64frombits(binary.BigEndian.Uint64(b[8:]))
+	for b = b[16:]; len(b) > 0; b = b[16:] {
+		v := math.Float64frombits(binary.BigEndian.Uint64(b))
+		h.add(v, binary.BigEndian.Uint64(b[8:]))
+	}
+	// The extrema of the other Histogram may lie beyond its bin means.
+	h.min = math.Min(h.min, min)
+	h.max = math.Max(h.max, max)
+	return nil
+}
+
+func (h *Histogram) ResultPart(*resolver.Context) (zng.Value, error) {
+	if len(h.bins) > 0 {
+		h.shrink()
+	}
+	b := make([]byte, 0, 16*(1+len(h.bins)))
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:], math.Float64bits(h.min))
+	binary.BigEndian.PutUint64(buf[8:], math.Float64bits(h.max))
+	b = append(b, buf[:]...)
+	for _, bin := range h.bins {
+		binary.BigEndian.PutUint64(buf[:], math.Float64bits(bin.mean))
+		binary.BigEndian.PutUint64(buf[8:], bin.count)
+		b = append(b, buf[:]...)
+	}
+	return zng.Value{Type: zng.TypeBstring, Bytes: b}, nil
+}
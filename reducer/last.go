@@ -3,6 +3,7 @@ package reducer
 import (
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 type LastProto struct {
@@ -15,11 +16,7 @@ func (lp *LastProto) Target() string {
 }
 
 func (lp *LastProto) Instantiate(rec *zng.Record) Interface {
-	v := lp.resolver(rec)
-	if v.Type == nil {
-		v.Type = zng.TypeNull
-	}
-	return &Last{Resolver: lp.resolver, typ: v.Type}
+	return &Last{Resolver: lp.resolver, typ: initialType(lp.resolver, rec)}
 }
 
 func NewLastProto(target string, resolver expr.FieldExprResolver) *LastProto {
@@ -30,20 +27,35 @@ type Last struct {
 	Reducer
 	Resolver expr.FieldExprResolver
 	typ      zng.Type
-	record   *zng.Record
+	val      *zng.Value
 }
 
 func (l *Last) Consume(r *zng.Record) {
-	if v := l.Resolver(r); v.Type == nil {
+	v := l.Resolver(r)
+	if v.Type == nil {
 		return
 	}
-	l.record = r
+	l.val = copyValue(v)
 }
 
 func (l *Last) Result() zng.Value {
-	r := l.record
-	if r == nil {
+	if l.val == nil {
 		return zng.Value{Type: l.typ, Bytes: nil}
 	}
-	return l.Resolver(r)
+	return *l.val
+}
+
+func (l *Last) ConsumePart(p zng.Value) error {
+	if p.Bytes == nil {
+		if l.val == nil && l.typ == zng.TypeNull {
+			l.typ = p.Type
+		}
+		return nil
+	}
+	l.val = copyValue(p)
+	return nil
+}
+
+func (l *Last) ResultPart(*resolver.Context) (zng.Value, error) {
+	return l.Result(), nil
 }
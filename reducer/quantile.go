@@ -0,0 +1,89 @@
+package reducer
+
+import (
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/pkg/tdigest"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zngnative"
+)
+
+type QuantileProto struct {
+	target   string
+	resolver expr.FieldExprResolver
+	q        float64
+}
+
+func (qp *QuantileProto) Target() string {
+	return qp.target
+}
+
+func (qp *QuantileProto) Instantiate(*zng.Record) Interface {
+	return &Quantile{
+		Resolver: qp.resolver,
+		q:        qp.q,
+		digest:   tdigest.New(),
+	}
+}
+
+// NewQuantileProto returns a prototype for reducers that estimate the
+// q-quantile, 0 <= q <= 1, of a numeric field.
+func NewQuantileProto(target string, resolver expr.FieldExprResolver, q float64) *QuantileProto {
+	return &QuantileProto{target, resolver, q}
+}
+
+// Quantile uses a t-digest to estimate a quantile of the values of a
+// field.  Like Avg, the values are treated as float64 and the result is
+// a float64.
+type Quantile struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	q        float64
+	digest   *tdigest.TDigest
+}
+
+func (q *Quantile) Consume(r *zng.Record) {
+	v := q.Resolver(r)
+	if v.Type == nil {
+		q.FieldNotFound++
+		return
+	}
+	if v.Bytes == nil {
+		return
+	}
+	d, ok := zngnative.CoerceToFloat64(v)
+	if !ok {
+		q.TypeMismatch++
+		return
+	}
+	q.digest.Add(d)
+}
+
+func (q *Quantile) Result() zng.Value {
+	if q.digest.Count() == 0 {
+		return zng.Value{Type: zng.TypeFloat64}
+	}
+	return zng.NewFloat64(q.digest.Quantile(q.q))
+}
+
+// ConsumePart merges a digest marshaled by ResultPart into the
+// reducer's digest.
+func (q *Quantile) ConsumePart(p zng.Value) error {
+	if p.Type != zng.TypeBstring {
+		return ErrBadPart
+	}
+	var digest tdigest.TDigest
+	if err := digest.UnmarshalBinary(p.Bytes); err != nil {
+		return err
+	}
+	q.digest.Merge(&digest)
+	return nil
+}
+
+func (q *Quantile) ResultPart(*resolver.Context) (zng.Value, error) {
+	b, err := q.digest.MarshalBinary()
+	if err != nil {
+		return zng.Value{}, err
+	}
+	return zng.Value{Type: zng.TypeBstring, Bytes: b}, nil
+}
@@ -7,16 +7,28 @@ package reducer
 import (
 	"errors"
 
+	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 var (
 	ErrUnsupportedType = errors.New("unsupported type")
+	ErrBadPart         = errors.New("bad partial result")
 )
 
+// Interface is implemented by each reducer.  Besides consuming records
+// and producing a result, a reducer can produce a partial result that
+// captures its state (ResultPart) and combine the partial results of
+// other instances of the same reducer into its own (ConsumePart).
+// This lets an aggregation be split up, e.g., when groupby spills
+// its state to disk, and then combined into the same result that a
+// single reducer would produce.
 type Interface interface {
 	Consume(*zng.Record)
 	Result() zng.Value
+	ConsumePart(zng.Value) error
+	ResultPart(*resolver.Context) (zng.Value, error)
 }
 
 // Result returns the Interface's result or a zng.Unset value if r is nil.
@@ -35,3 +47,25 @@ type Stats struct {
 type Reducer struct {
 	Stats
 }
+
+// partColumns checks that the partial result p is a record whose columns
+// have the given types and returns the value of each column.
+func partColumns(p zng.Value, types ...zng.Type) ([]zcode.Bytes, error) {
+	typ, ok := p.Type.(*zng.TypeRecord)
+	if !ok || len(typ.Columns) != len(types) {
+		return nil, ErrBadPart
+	}
+	cols := make([]zcode.Bytes, 0, len(types))
+	it := p.Bytes.Iter()
+	for k, col := range typ.Columns {
+		if col.Type != types[k] || it.Done() {
+			return nil, ErrBadPart
+		}
+		zv, _, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, zv)
+	}
+	return cols, nil
+}
@@ -0,0 +1,193 @@
+package reducer
+
+import (
+	"fmt"
+
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+type UnionProto struct {
+	target   string
+	resolver expr.FieldExprResolver
+	zctx     *resolver.Context
+	limit    *containerLimit
+	mismatch *typeMismatch
+}
+
+func (up *UnionProto) Target() string {
+	return up.target
+}
+
+func (up *UnionProto) Instantiate(rec *zng.Record) Interface {
+	initial, ok := elemType(initialType(up.resolver, rec))
+	if !ok {
+		initial = zng.TypeNull
+	}
+	return &Union{
+		Resolver: up.resolver,
+		zctx:     up.zctx,
+		limit:    up.limit,
+		mismatch: up.mismatch,
+		initial:  initial,
+		vals:     make(map[string]struct{}),
+	}
+}
+
+// NewUnionProto returns a prototype for reducers that collect up to limit
+// distinct values of a field into a set, whose type is created in zctx.
+// If limit is zero, DefaultContainerLimit is used.  A warning is sent on
+// warnings, if it is not nil, when a reducer has more values than limit
+// or is given values it ignores.
+func NewUnionProto(target string, resolver expr.FieldExprResolver, limit int, zctx *resolver.Context, warnings chan string) *UnionProto {
+	return &UnionProto{
+		target:   target,
+		resolver: resolver,
+		zctx:     zctx,
+		limit:    newContainerLimit(target, limit, warnings),
+		mismatch: &typeMismatch{target: target, warnings: warnings},
+	}
+}
+
+// typeMismatch sends a warning the first time any instance of a reducer
+// ignores a value because of its type.
+type typeMismatch struct {
+	target   string
+	warnings chan string
+	warned   bool
+}
+
+func (t *typeMismatch) ignored(typ, elemType zng.Type) {
+	if t.warned || t.warnings == nil {
+		return
+	}
+	t.warned = true
+	if elemType == nil {
+		t.warnings <- fmt.Sprintf("%s: values of type %s can't be set elements and are ignored", t.target, typ)
+		return
+	}
+	t.warnings <- fmt.Sprintf("%s: values of type %s don't match set elements of type %s and are ignored", t.target, typ, elemType)
+}
+
+// elemType returns the type of the set elements added for a value of type
+// typ, which is the type of the elements of a set or array of primitive
+// values or else typ itself.  It returns false if the type is that of
+// values that can't be set elements.
+func elemType(typ zng.Type) (zng.Type, bool) {
+	if inner := zng.InnerType(zng.AliasedType(typ)); inner != nil {
+		typ = inner
+	}
+	return typ, !zng.IsContainerType(zng.AliasedType(typ))
+}
+
+// Union builds a set of the distinct values of a field, or of the elements
+// of the values of a set or array field.  As with Collect, the type of the
+// set elements is the type of the first value and values of other types
+// are ignored.  Since set elements must be primitive values, values of
+// other container types are ignored too.
+type Union struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	zctx     *resolver.Context
+	limit    *containerLimit
+	mismatch *typeMismatch
+	// initial is the element type of an empty result.
+	initial zng.Type
+	typ     zng.Type
+	// vals holds the tag-counted encoding of each element.
+	vals map[string]struct{}
+}
+
+func (u *Union) Consume(r *zng.Record) {
+	v := u.Resolver(r)
+	if v.Type == nil {
+		u.FieldNotFound++
+		return
+	}
+	if v.Bytes == nil {
+		return
+	}
+	u.add(v)
+}
+
+func (u *Union) add(v zng.Value) {
+	typ, ok := elemType(v.Type)
+	if !ok {
+		u.TypeMismatch++
+		u.mismatch.ignored(v.Type, nil)
+		return
+	}
+	if u.typ == nil {
+		u.typ = typ
+	} else if typ != u.typ {
+		u.TypeMismatch++
+		u.mismatch.ignored(v.Type, u.typ)
+		return
+	}
+	if typ == v.Type {
+		// v is a primitive value rather than a set or array.
+		u.addElem(v.Bytes)
+		return
+	}
+	for it := v.Bytes.Iter(); !it.Done(); {
+		zv, _, err := it.Next()
+		if err != nil {
+			u.TypeMismatch++
+			return
+		}
+		if zv != nil {
+			u.addElem(zv)
+		}
+	}
+}
+
+func (u *Union) addElem(zv zcode.Bytes) {
+	elem := zcode.AppendPrimitive(nil, zv)
+	if _, ok := u.vals[string(elem)]; ok {
+		return
+	}
+	if len(u.vals) >= u.limit.limit {
+		u.limit.exceeded()
+		return
+	}
+	u.vals[string(elem)] = struct{}{}
+}
+
+func (u *Union) Result() zng.Value {
+	if u.typ == nil {
+		return zng.Value{Type: u.zctx.LookupTypeSet(u.initial)}
+	}
+	body := make(zcode.Bytes, 0)
+	for elem := range u.vals {
+		body = append(body, elem...)
+	}
+	return zng.Value{Type: u.zctx.LookupTypeSet(u.typ), Bytes: zng.NormalizeSet(body)}
+}
+
+// ConsumePart adds the elements of a set produced by ResultPart.
+func (u *Union) ConsumePart(p zng.Value) error {
+	typ, ok := p.Type.(*zng.TypeSet)
+	if !ok {
+		return ErrBadPart
+	}
+	if p.Bytes == nil {
+		if u.initial == zng.TypeNull {
+			u.initial = typ.InnerType
+		}
+		return nil
+	}
+	for it := p.Bytes.Iter(); !it.Done(); {
+		zv, _, err := it.Next()
+		if err != nil {
+			return err
+		}
+		u.add(zng.Value{Type: typ.InnerType, Bytes: zv})
+	}
+	return nil
+}
+
+func (u *Union) ResultPart(*resolver.Context) (zng.Value, error) {
+	return u.Result(), nil
+}
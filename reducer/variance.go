@@ -0,0 +1,123 @@
+package reducer
+
+import (
+	"math"
+
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zngnative"
+)
+
+type VarianceProto struct {
+	target   string
+	resolver expr.FieldExprResolver
+	stddev   bool
+}
+
+func (vp *VarianceProto) Target() string {
+	return vp.target
+}
+
+func (vp *VarianceProto) Instantiate(*zng.Record) Interface {
+	return &Variance{Resolver: vp.resolver, stddev: vp.stddev}
+}
+
+// NewVarianceProto returns a prototype for reducers that compute the
+// population variance of a numeric field or, if stddev is true, its
+// standard deviation.
+func NewVarianceProto(target string, resolver expr.FieldExprResolver, stddev bool) *VarianceProto {
+	return &VarianceProto{target, resolver, stddev}
+}
+
+// Variance computes the variance with Welford's online algorithm.  Like
+// Avg, the values are treated as float64 and the result is a float64.
+type Variance struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	stddev   bool
+	count    uint64
+	mean     float64
+	m2       float64
+}
+
+func (v *Variance) Consume(r *zng.Record) {
+	val := v.Resolver(r)
+	if val.Type == nil {
+		v.FieldNotFound++
+		return
+	}
+	if val.Bytes == nil {
+		return
+	}
+	d, ok := zngnative.CoerceToFloat64(val)
+	if !ok {
+		v.TypeMismatch++
+		return
+	}
+	v.count++
+	delta := d - v.mean
+	v.mean += delta / float64(v.count)
+	v.m2 += delta * (d - v.mean)
+}
+
+func (v *Variance) Result() zng.Value {
+	if v.count == 0 {
+		return zng.Value{Type: zng.TypeFloat64}
+	}
+	variance := v.m2 / float64(v.count)
+	if v.stddev {
+		return zng.NewFloat64(math.Sqrt(variance))
+	}
+	return zng.NewFloat64(variance)
+}
+
+const (
+	meanName = "mean"
+	m2Name   = "m2"
+)
+
+// ConsumePart combines the count, mean, and sum of squared differences
+// from the mean of another Variance using the parallel algorithm of
+// Chan et al.
+func (v *Variance) ConsumePart(p zng.Value) error {
+	cols, err := partColumns(p, zng.TypeUint64, zng.TypeFloat64, zng.TypeFloat64)
+	if err != nil {
+		return err
+	}
+	count, err := zng.DecodeUint(cols[0])
+	if err != nil {
+		return err
+	}
+	mean, err := zng.DecodeFloat64(cols[1])
+	if err != nil {
+		return err
+	}
+	m2, err := zng.DecodeFloat64(cols[2])
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return nil
+	}
+	n := v.count + count
+	delta := mean - v.mean
+	v.mean += delta * float64(count) / float64(n)
+	v.m2 += m2 + delta*delta*float64(v.count)*float64(count)/float64(n)
+	v.count = n
+	return nil
+}
+
+func (v *Variance) ResultPart(zctx *resolver.Context) (zng.Value, error) {
+	var zv zcode.Bytes
+	zv = zcode.AppendPrimitive(zv, zng.EncodeUint(v.count))
+	zv = zcode.AppendPrimitive(zv, zng.EncodeFloat64(v.mean))
+	zv = zcode.AppendPrimitive(zv, zng.EncodeFloat64(v.m2))
+	typ := zctx.LookupTypeRecord([]zng.Column{
+		zng.NewColumn(countName, zng.TypeUint64),
+		zng.NewColumn(meanName, zng.TypeFloat64),
+		zng.NewColumn(m2Name, zng.TypeFloat64),
+	})
+	return zng.Value{Type: typ, Bytes: zv}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
@@ -64,6 +66,18 @@ func (c *Combiner) Read() (*zng.Record, error) {
 	return tup, nil
 }
 
+// Pushdown implements Pushdown for a Combiner, which matches its records
+// itself only if all of its readers do.
+func (c *Combiner) Pushdown(f filter.Filter, span nano.Span) bool {
+	ok := true
+	for _, r := range c.readers {
+		if p, isPushdown := r.(Pushdown); !isPushdown || !p.Pushdown(f, span) {
+			ok = false
+		}
+	}
+	return ok
+}
+
 func (c *Combiner) closeReader(r zbuf.Reader) error {
 	if closer, ok := r.(io.Closer); ok {
 		return closer.Close()
@@ -3,8 +3,11 @@ package scanner
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio/detector"
 	"github.com/brimsec/zq/zng"
@@ -14,6 +17,7 @@ import (
 type File struct {
 	zbuf.Reader
 	file *os.File
+	r    io.ReadCloser
 }
 
 func OpenFile(zctx *resolver.Context, path, ifmt string) (*File, error) {
@@ -28,7 +32,7 @@ func OpenFile(zctx *resolver.Context, path, ifmt string) (*File, error) {
 	if err != nil {
 		return nil, err
 	}
-	r := detector.GzipReader(f)
+	r := detector.DecompressReader(f)
 	var zr zbuf.Reader
 	if ifmt == "auto" {
 		zr, err = detector.NewReader(r, zctx)
@@ -36,12 +40,15 @@ func OpenFile(zctx *resolver.Context, path, ifmt string) (*File, error) {
 		zr, err = detector.LookupReader(ifmt, r, zctx)
 	}
 	if err != nil {
+		r.Close()
+		f.Close()
 		return nil, err
 	}
-	return &File{zr, f}, nil
+	return &File{zr, f, r}, nil
 }
 
 func (r *File) Close() error {
+	r.r.Close()
 	return r.file.Close()
 }
 
@@ -68,3 +75,15 @@ func (w *warningReader) Read() (*zng.Record, error) {
 	}
 	return rec, nil
 }
+
+func (w *warningReader) Close() error {
+	if closer, ok := w.zr.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func (w *warningReader) Pushdown(f filter.Filter, span nano.Span) bool {
+	p, ok := w.zr.(Pushdown)
+	return ok && p.Pushdown(f, span)
+}
@@ -0,0 +1,88 @@
+package scanner
+
+import (
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+)
+
+// Live is a zbuf.Reader that reads each of its readers in a separate
+// goroutine and returns their records in the order they arrive.  It is
+// meant for readers that block waiting for input, such as those reading
+// from a Tail, where a Scanner reading from a Live ends each batch
+// when no more records are ready instead of waiting for a full batch.
+type Live struct {
+	ch      chan liveResult
+	done    chan struct{}
+	readers []zbuf.Reader
+	running int
+}
+
+type liveResult struct {
+	rec *zng.Record
+	err error
+}
+
+func NewLive(readers []zbuf.Reader) *Live {
+	l := &Live{
+		ch:      make(chan liveResult, batchSize),
+		done:    make(chan struct{}),
+		readers: readers,
+		running: len(readers),
+	}
+	for _, r := range readers {
+		go l.run(r)
+	}
+	return l
+}
+
+func (l *Live) run(r zbuf.Reader) {
+	for {
+		rec, err := r.Read()
+		if rec != nil {
+			rec = rec.Keep()
+		}
+		select {
+		case l.ch <- liveResult{rec, err}:
+		case <-l.done:
+			return
+		}
+		if rec == nil || err != nil {
+			return
+		}
+	}
+}
+
+func (l *Live) Read() (*zng.Record, error) {
+	rec, _, err := l.read(true)
+	return rec, err
+}
+
+// read returns the next record or, at the end of all the readers, nil.
+// If wait is false and no record is ready, read returns false instead of
+// waiting for one.
+func (l *Live) read(wait bool) (*zng.Record, bool, error) {
+	for l.running > 0 {
+		var res liveResult
+		if wait {
+			res = <-l.ch
+		} else {
+			select {
+			case res = <-l.ch:
+			default:
+				return nil, false, nil
+			}
+		}
+		if res.rec == nil && res.err == nil {
+			l.running--
+			continue
+		}
+		return res.rec, true, res.err
+	}
+	return nil, true, nil
+}
+
+// Close stops reading and closes the readers implementing io.Closer.
+func (l *Live) Close() error {
+	close(l.done)
+	return NewCombiner(l.readers).Close()
+}
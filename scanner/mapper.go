@@ -1,6 +1,8 @@
 package scanner
 
 import (
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
@@ -34,3 +36,10 @@ func (m *Mapper) Read() (*zng.Record, error) {
 	rec.Type = sharedType
 	return rec, nil
 }
+
+// Pushdown implements Pushdown by passing f and span to the underlying
+// reader.
+func (m *Mapper) Pushdown(f filter.Filter, span nano.Span) bool {
+	p, ok := m.Reader.(Pushdown)
+	return ok && p.Pushdown(f, span)
+}
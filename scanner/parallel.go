@@ -0,0 +1,241 @@
+package scanner
+
+import (
+	"io"
+	"os"
+	"sync"
+
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zio/bzngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// ParallelPieceSize is the size in bytes above which the streams of a
+// file read by a Parallel are divided among its workers.  Each worker
+// reads a run of whole streams of at least this size, or the rest of the
+// file.
+var ParallelPieceSize int64 = 4 * 1024 * 1024
+
+// A Pushdown is a zbuf.Reader that can itself discard the records that
+// don't match the filter and span of the Scanner reading from it.
+// Pushdown returns true if the reader will do so for all of its records,
+// in which case the Scanner doesn't match them again.
+type Pushdown interface {
+	Pushdown(f filter.Filter, span nano.Span) bool
+}
+
+// Parallel is a zbuf.Reader of a bzng file that splits the file at the
+// boundaries between its streams, as found by a bzngio.Splitter, and
+// decodes the pieces on a number of goroutines.  Since each stream
+// defines its own types, the pieces are decoded independently, and the
+// records of each piece are returned in the order of the file.  Parallel
+// implements Pushdown so that the workers also match the records against
+// the filter and span of the Scanner reading from it.
+type Parallel struct {
+	file    *os.File
+	size    int64
+	zctx    *resolver.Context
+	workers int
+	filter  filter.Filter
+	span    nano.Span
+	once    sync.Once
+	wg      sync.WaitGroup
+	done    chan struct{}
+	// pieces holds the pieces in the order they are to be read.
+	pieces chan *piece
+	piece  *piece
+	recs   []*zng.Record
+}
+
+// A piece is a run of whole streams from off to end.  The worker
+// decoding it sends the matching records on ch and closes ch at the end.
+type piece struct {
+	off int64
+	end int64
+	ch  chan pieceResult
+}
+
+type pieceResult struct {
+	recs []*zng.Record
+	err  error
+}
+
+// OpenParallel opens the bzng file at path for reading by workers
+// goroutines, which decode its records into zctx.
+func OpenParallel(zctx *resolver.Context, path string, workers int) (*Parallel, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	return &Parallel{
+		file:    f,
+		size:    info.Size(),
+		zctx:    zctx,
+		workers: workers,
+		span:    nano.MaxSpan,
+		done:    make(chan struct{}),
+		pieces:  make(chan *piece, workers),
+	}, nil
+}
+
+// Pushdown implements Pushdown.  It must be called before the first Read.
+func (p *Parallel) Pushdown(f filter.Filter, span nano.Span) bool {
+	p.filter = f
+	p.span = span
+	return true
+}
+
+func (p *Parallel) start() {
+	work := make(chan *piece)
+	p.wg.Add(p.workers + 1)
+	go p.split(work)
+	for k := 0; k < p.workers; k++ {
+		go p.work(work)
+	}
+}
+
+// split sends the pieces of the file to both the workers and the reader,
+// which reads them in order.
+func (p *Parallel) split(work chan<- *piece) {
+	defer p.wg.Done()
+	defer close(work)
+	defer close(p.pieces)
+	s := bzngio.NewSplitter(io.NewSectionReader(p.file, 0, p.size))
+	var off int64
+	for {
+		end, err := s.Next()
+		if err != nil {
+			// The last piece holds the rest of the file, so any
+			// malformed data is reported by the worker decoding it.
+			if off < p.size {
+				p.send(work, off, p.size)
+			}
+			return
+		}
+		if end-off >= ParallelPieceSize {
+			if !p.send(work, off, end) {
+				return
+			}
+			off = end
+		}
+	}
+}
+
+func (p *Parallel) send(work chan<- *piece, off, end int64) bool {
+	pc := &piece{
+		off: off,
+		end: end,
+		ch:  make(chan pieceResult, 4),
+	}
+	select {
+	case p.pieces <- pc:
+	case <-p.done:
+		return false
+	}
+	select {
+	case work <- pc:
+		return true
+	case <-p.done:
+		return false
+	}
+}
+
+func (p *Parallel) work(work <-chan *piece) {
+	defer p.wg.Done()
+	for pc := range work {
+		if !p.decode(pc) {
+			return
+		}
+	}
+}
+
+// decode sends the matching records of pc in batches.  It returns false
+// if the Parallel was closed.
+func (p *Parallel) decode(pc *piece) bool {
+	defer close(pc.ch)
+	r := bzngio.NewReader(io.NewSectionReader(p.file, pc.off, pc.end-pc.off), p.zctx)
+	recs := make([]*zng.Record, 0, batchSize)
+	for {
+		rec, err := r.Read()
+		if err != nil {
+			return p.result(pc, pieceResult{err: err})
+		}
+		if rec == nil {
+			break
+		}
+		if !match(rec, p.filter, p.span) {
+			continue
+		}
+		recs = append(recs, rec.Keep())
+		if len(recs) == batchSize {
+			if !p.result(pc, pieceResult{recs: recs}) {
+				return false
+			}
+			recs = make([]*zng.Record, 0, batchSize)
+		}
+	}
+	if len(recs) == 0 {
+		return true
+	}
+	return p.result(pc, pieceResult{recs: recs})
+}
+
+func (p *Parallel) result(pc *piece, res pieceResult) bool {
+	select {
+	case pc.ch <- res:
+		return true
+	case <-p.done:
+		return false
+	}
+}
+
+// Read implements zbuf.Reader.Read.
+func (p *Parallel) Read() (*zng.Record, error) {
+	p.once.Do(p.start)
+	for len(p.recs) == 0 {
+		if p.piece == nil {
+			pc, ok := <-p.pieces
+			if !ok {
+				return nil, nil
+			}
+			p.piece = pc
+		}
+		res, ok := <-p.piece.ch
+		if !ok {
+			p.piece = nil
+			continue
+		}
+		if res.err != nil {
+			return nil, res.err
+		}
+		p.recs = res.recs
+	}
+	rec := p.recs[0]
+	p.recs = p.recs[1:]
+	return rec, nil
+}
+
+// Close stops the workers and closes the file.
+func (p *Parallel) Close() error {
+	select {
+	case <-p.done:
+	default:
+		close(p.done)
+	}
+	p.wg.Wait()
+	return p.file.Close()
+}
+
+func (p *Parallel) String() string {
+	return p.file.Name()
+}
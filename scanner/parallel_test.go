@@ -0,0 +1,93 @@
+package scanner
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/bzngio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readAll returns the records of the Scanner of reader as zng text.
+func readAll(t *testing.T, reader zbuf.Reader, f filter.Filter, span nano.Span) string {
+	var out strings.Builder
+	w := zngio.NewWriter(&out)
+	s := NewScanner(reader, f, span)
+	for {
+		batch, err := s.Pull()
+		require.NoError(t, err)
+		if batch == nil {
+			break
+		}
+		for _, rec := range batch.Records() {
+			require.NoError(t, w.Write(rec))
+		}
+	}
+	return out.String()
+}
+
+func TestParallel(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("#0:record[ts:time,n:int64]\n")
+	b.WriteString("#1:record[ts:time,s:string,a:array[int64]]\n")
+	for k := 0; k < 1000; k++ {
+		if k%3 == 0 {
+			b.WriteString("1:[" + strconv.Itoa(k) + ";s" + strconv.Itoa(k) + ";[" + strconv.Itoa(k) + ";]]\n")
+		} else {
+			b.WriteString("0:[" + strconv.Itoa(k) + ";" + strconv.Itoa(k) + ";]\n")
+		}
+	}
+	dir, err := ioutil.TempDir("", "parallel")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	saved := ParallelPieceSize
+	ParallelPieceSize = 1
+	defer func() { ParallelPieceSize = saved }()
+
+	for _, flags := range []zio.Flags{
+		{StreamRecordsMax: 7},
+		{StreamRecordsMax: 7, BzngCompress: true},
+		{},
+	} {
+		path := filepath.Join(dir, "in.bzng")
+		f, err := os.Create(path)
+		require.NoError(t, err)
+		r := zngio.NewReader(strings.NewReader(b.String()), resolver.NewContext())
+		w := bzngio.NewWriter(f, flags)
+		require.NoError(t, zbuf.Copy(w, r))
+		require.NoError(t, w.Flush())
+		require.NoError(t, f.Close())
+
+		program, err := zql.ParseProc("n > 500 or s=s9*")
+		require.NoError(t, err)
+		flt, err := filter.Compile(resolver.NewContext(), program.(*ast.FilterProc).Filter)
+		require.NoError(t, err)
+		span := nano.NewSpanTs(100*1e9, 900*1e9)
+
+		f, err = os.Open(path)
+		require.NoError(t, err)
+		expected := readAll(t, bzngio.NewReader(f, resolver.NewContext()), flt, span)
+		require.NoError(t, f.Close())
+
+		for _, workers := range []int{1, 4} {
+			p, err := OpenParallel(resolver.NewContext(), path, workers)
+			require.NoError(t, err)
+			assert.Equal(t, expected, readAll(t, p, flt, span))
+			require.NoError(t, p.Close())
+		}
+	}
+}
@@ -15,7 +15,13 @@ type Scanner struct {
 	span   nano.Span
 }
 
+// NewScanner returns a Scanner of the records of reader that are within
+// s and match f.  If reader is a Pushdown that matches its records itself,
+// the Scanner leaves the matching to it.
 func NewScanner(reader zbuf.Reader, f filter.Filter, s nano.Span) *Scanner {
+	if p, ok := reader.(Pushdown); ok && p.Pushdown(f, s) {
+		f, s = nil, nano.MaxSpan
+	}
 	return &Scanner{
 		reader: reader,
 		filter: f,
@@ -27,9 +33,43 @@ const batchSize = 100
 
 // Pull implements Proc.Pull.
 func (s *Scanner) Pull() (zbuf.Batch, error) {
+	if live, ok := s.reader.(*Live); ok {
+		return s.pullLive(live)
+	}
 	return zbuf.ReadBatch(s, batchSize)
 }
 
+// pullLive returns the matching records that are ready to be read from
+// live, waiting only for the first.
+func (s *Scanner) pullLive(live *Live) (zbuf.Batch, error) {
+	batch := zbuf.NewArray(nil, nano.Span{})
+	for batch.Length() < batchSize {
+		rec, ok, err := live.read(batch.Length() == 0)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || rec == nil {
+			break
+		}
+		if s.match(rec) {
+			batch.Append(rec)
+		}
+	}
+	if batch.Length() == 0 {
+		return nil, nil
+	}
+	return batch, nil
+}
+
+func (s *Scanner) match(rec *zng.Record) bool {
+	return match(rec, s.filter, s.span)
+}
+
+func match(rec *zng.Record, f filter.Filter, span nano.Span) bool {
+	return (span == nano.MaxSpan || span.Contains(rec.Ts)) &&
+		(f == nil || f(rec))
+}
+
 // Read implements zbuf.Reader.Read.
 func (s *Scanner) Read() (*zng.Record, error) {
 	for {
@@ -37,8 +77,7 @@ func (s *Scanner) Read() (*zng.Record, error) {
 		if err != nil || rec == nil {
 			return nil, err
 		}
-		if s.span != nano.MaxSpan && !s.span.Contains(rec.Ts) ||
-			s.filter != nil && !s.filter(rec) {
+		if !s.match(rec) {
 			continue
 		}
 		// Copy the underlying buffer (if volatile) because next call to
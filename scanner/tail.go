@@ -0,0 +1,160 @@
+package scanner
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+)
+
+// TailPollInterval is how often a Tail at the end of its file checks for
+// more data.
+var TailPollInterval = 250 * time.Millisecond
+
+// Tail is an io.ReadCloser that reads the file at a path and, at the end of
+// the file, waits for data to be appended to it rather than returning
+// io.EOF.  Read returns io.EOF once the context is canceled or, when the
+// file at the path is replaced, e.g., by log rotation, or truncated, at the
+// end of the data read from the original file.  In the latter case,
+// Rotated returns true, and Reopen continues reading from the start of the
+// new file.
+type Tail struct {
+	ctx     context.Context
+	path    string
+	file    *os.File
+	rotated bool
+}
+
+func OpenTail(ctx context.Context, path string) (*Tail, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Tail{ctx: ctx, path: path, file: f}, nil
+}
+
+func (t *Tail) Read(b []byte) (int, error) {
+	if t.rotated {
+		return 0, io.EOF
+	}
+	for {
+		n, err := t.file.Read(b)
+		if n > 0 || (err != nil && err != io.EOF) {
+			return n, err
+		}
+		rotated, err := t.checkRotated()
+		if err != nil {
+			return 0, err
+		}
+		if rotated {
+			// Finish reading anything appended to the old file
+			// before it was replaced.
+			if n, err := t.file.Read(b); n > 0 || (err != nil && err != io.EOF) {
+				return n, err
+			}
+			t.rotated = true
+			return 0, io.EOF
+		}
+		select {
+		case <-t.ctx.Done():
+			return 0, io.EOF
+		case <-time.After(TailPollInterval):
+		}
+	}
+}
+
+// checkRotated returns true if the file being read is no longer the file at
+// the path or has been truncated.
+func (t *Tail) checkRotated() (bool, error) {
+	info, err := os.Stat(t.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// The file has been moved away, and its replacement
+			// has yet to be created.
+			return false, nil
+		}
+		return false, err
+	}
+	cur, err := t.file.Stat()
+	if err != nil {
+		return false, err
+	}
+	if !os.SameFile(info, cur) {
+		return true, nil
+	}
+	off, err := t.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return false, err
+	}
+	return info.Size() < off, nil
+}
+
+// Rotated returns true if Read has returned io.EOF because the file was
+// rotated or truncated.
+func (t *Tail) Rotated() bool {
+	return t.rotated
+}
+
+// Reopen opens the file now at the path after a rotation so that Read
+// continues from its start.
+func (t *Tail) Reopen() error {
+	f, err := os.Open(t.path)
+	if err != nil {
+		return err
+	}
+	t.file.Close()
+	t.file = f
+	t.rotated = false
+	return nil
+}
+
+func (t *Tail) Close() error {
+	return t.file.Close()
+}
+
+func (t *Tail) String() string {
+	return t.path
+}
+
+// Follower is a zbuf.Reader of the records of a file being read by a Tail.
+// Since a rotated file begins anew, e.g., with the headers of a Zeek log,
+// Follower reads each file from the Tail with a new reader returned by open.
+type Follower struct {
+	tail   *Tail
+	open   func(io.Reader) (zbuf.Reader, error)
+	reader zbuf.Reader
+}
+
+func NewFollower(tail *Tail, open func(io.Reader) (zbuf.Reader, error)) (*Follower, error) {
+	reader, err := open(tail)
+	if err != nil {
+		return nil, err
+	}
+	return &Follower{tail: tail, open: open, reader: reader}, nil
+}
+
+func (f *Follower) Read() (*zng.Record, error) {
+	for {
+		rec, err := f.reader.Read()
+		if err != nil || rec != nil || !f.tail.Rotated() {
+			return rec, err
+		}
+		if err := f.tail.Reopen(); err != nil {
+			return nil, err
+		}
+		if f.reader, err = f.open(f.tail); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (f *Follower) Close() error {
+	return f.tail.Close()
+}
+
+func (f *Follower) String() string {
+	return f.tail.String()
+}
@@ -0,0 +1,75 @@
+package scanner
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func appendFile(t *testing.T, path, s string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString(s)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestFollower(t *testing.T) {
+	TailPollInterval = time.Millisecond
+	dir, err := ioutil.TempDir("", "tail")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "log.zng")
+	appendFile(t, path, "#0:record[a:int64]\n0:[1;]\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tail, err := OpenTail(ctx, path)
+	require.NoError(t, err)
+	zctx := resolver.NewContext()
+	f, err := NewFollower(tail, func(r io.Reader) (zbuf.Reader, error) {
+		return zngio.NewReader(r, zctx), nil
+	})
+	require.NoError(t, err)
+	defer f.Close()
+
+	read := func(expected string) {
+		rec, err := f.Read()
+		require.NoError(t, err)
+		require.NotNil(t, rec)
+		assert.Equal(t, expected, rec.Type.String()+" "+rec.Value(0).String())
+	}
+	read("record[a:int64] 1")
+
+	// Records appended after the end of the file are read.
+	appendFile(t, path, "0:[2;]\n")
+	read("record[a:int64] 2")
+
+	// Records appended to a file before it is rotated are read before
+	// those of the new file, which may reuse its type IDs.
+	appendFile(t, path, "0:[3;]\n")
+	require.NoError(t, os.Rename(path, path+".1"))
+	appendFile(t, path, "#0:record[b:string]\n0:[x;]\n")
+	read("record[a:int64] 3")
+	read("record[b:string] x")
+
+	// A truncated file is read from its start.
+	require.NoError(t, os.Truncate(path, 0))
+	appendFile(t, path, "#0:record[c:bool]\n0:[T;]\n")
+	read("record[c:bool] T")
+
+	cancel()
+	rec, err := f.Read()
+	assert.NoError(t, err)
+	assert.Nil(t, rec)
+}
@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/driver"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/scanner"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+	"github.com/brimsec/zq/ztest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// optimizerQueries are run over the input of each ztest in addition to
+// its own query.
+var optimizerQueries = []string{
+	"sort ts | head 2",
+	"sort -r ts | filter ts>0 | head 3",
+	"put x=1+2 | filter x=3 | cut ts,x",
+	"cut ts | filter ts>1 | sort -r ts",
+	"put ts=ts | filter ts>1 | head 1",
+	"filter 1+1=2 | count()",
+}
+
+// TestOptimizer checks that the queries of the ztests in the suite compute
+// the same records with and without the optimizer.
+func TestOptimizer(t *testing.T) {
+	t.Parallel()
+	var files []string
+	err := filepath.Walk("suite", func(path string, info os.FileInfo, err error) error {
+		if strings.HasSuffix(path, ".yaml") {
+			files = append(files, path)
+		}
+		return err
+	})
+	require.NoError(t, err)
+	for _, file := range files {
+		zt, err := ztest.FromYAMLFile(file)
+		require.NoError(t, err)
+		if len(zt.Input) == 0 {
+			continue
+		}
+		for _, query := range append([]string{zt.ZQL}, optimizerQueries...) {
+			program, err := zql.ParseProc(query)
+			if err != nil {
+				continue
+			}
+			expected, err := runUnoptimized(program, zt.Input)
+			if err != nil {
+				continue
+			}
+			actual, err := runOptimized(program, zt.Input)
+			require.NoError(t, err, "%s: %s", file, query)
+			assert.Equal(t, expected, actual, "%s: %s", file, query)
+		}
+	}
+}
+
+func loadInputs(inputs []string, zctx *resolver.Context) (zbuf.ReadCloser, error) {
+	var readers []zbuf.Reader
+	for _, input := range inputs {
+		r := detector.DecompressReader(strings.NewReader(input))
+		zr, err := detector.NewReader(r, zctx)
+		if err != nil {
+			return nil, err
+		}
+		readers = append(readers, zbuf.NewReadCloser(zr, r))
+	}
+	return scanner.NewCombiner(readers), nil
+}
+
+func runOptimized(program ast.Proc, inputs []string) (string, error) {
+	reader, err := loadInputs(inputs, resolver.NewContext())
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	mux, err := driver.Compile(context.Background(), program, reader, false, nano.MaxSpan, zap.NewNop())
+	if err != nil {
+		return "", err
+	}
+	return runMux(mux)
+}
+
+// runUnoptimized runs program as driver.Compile does but without first
+// optimizing it.
+func runUnoptimized(program ast.Proc, inputs []string) (string, error) {
+	reader, err := loadInputs(inputs, resolver.NewContext())
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	pctx := &proc.Context{
+		Context:     context.Background(),
+		TypeContext: resolver.NewContext(),
+		Logger:      zap.NewNop(),
+		Warnings:    make(chan string, 5),
+	}
+	leaves, err := proc.CompileProc(nil, program, pctx, scanner.NewScanner(reader, nil, nano.MaxSpan))
+	if err != nil {
+		return "", err
+	}
+	return runMux(driver.NewMuxOutput(pctx, leaves))
+}
+
+// runMux runs mux and returns the records of its outputs in order.
+func runMux(mux *driver.MuxOutput) (string, error) {
+	bufs := make([]bytes.Buffer, mux.N())
+	var writers []zbuf.Writer
+	for k := range bufs {
+		writers = append(writers, zngio.NewWriter(&bufs[k]))
+	}
+	if err := driver.Run(mux, driver.NewCLI(writers...), 0); err != nil {
+		return "", err
+	}
+	var out string
+	for k := range bufs {
+		out += bufs[k].String()
+	}
+	return out, nil
+}
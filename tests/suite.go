@@ -2,10 +2,13 @@ package tests
 
 import (
 	"github.com/brimsec/zq/pkg/test"
+	"github.com/brimsec/zq/tests/suite/compress"
 	"github.com/brimsec/zq/tests/suite/diropt"
 	"github.com/brimsec/zq/tests/suite/errors"
 	"github.com/brimsec/zq/tests/suite/jsontype"
+	"github.com/brimsec/zq/tests/suite/parallel"
 	"github.com/brimsec/zq/tests/suite/pcap"
+	"github.com/brimsec/zq/tests/suite/repl"
 	"github.com/brimsec/zq/tests/suite/utf8"
 	"github.com/brimsec/zq/tests/suite/zeek"
 )
@@ -49,6 +52,13 @@ var scripts = []test.Shell{
 	errors.StopErrContinueMid,
 	diropt.Test,
 	diropt.Test2,
+	compress.Gzip,
+	compress.Zstd,
+	compress.StdinGzip,
+	compress.StdinZstd,
+	compress.Dir,
+	repl.Test,
+	parallel.Test,
 	jsontype.Test,
 	jsontype.TestInferPath,
 	jsontype.TestSet,
@@ -0,0 +1,56 @@
+package compress
+
+import (
+	"github.com/brimsec/zq/pkg/test"
+)
+
+var Gzip = test.Shell{
+	Name:     "compress-gzip",
+	Script:   `zq -z gzip -o out.zng.gz "*" in.zng && zq "*" out.zng.gz > out.zng`,
+	Input:    []test.File{test.File{"in.zng", test.Trim(input)}},
+	Expected: []test.File{test.File{"out.zng", test.Trim(input)}},
+}
+
+var Zstd = test.Shell{
+	Name:     "compress-zstd",
+	Script:   `zq -z zstd -f bzng -o out.bzng.zst "*" in.zng && zq "*" out.bzng.zst > out.zng`,
+	Input:    []test.File{test.File{"in.zng", test.Trim(input)}},
+	Expected: []test.File{test.File{"out.zng", test.Trim(input)}},
+}
+
+var StdinGzip = test.Shell{
+	Name:     "compress-stdin-gzip",
+	Script:   `zq -z gzip -o in.zng.gz "*" in.zng && zq "*" - < in.zng.gz > out.zng`,
+	Input:    []test.File{test.File{"in.zng", test.Trim(input)}},
+	Expected: []test.File{test.File{"out.zng", test.Trim(input)}},
+}
+
+var StdinZstd = test.Shell{
+	Name:     "compress-stdin-zstd",
+	Script:   `zq -z zstd -o in.zng.zst "*" in.zng && zq "*" - < in.zng.zst > out.zng`,
+	Input:    []test.File{test.File{"in.zng", test.Trim(input)}},
+	Expected: []test.File{test.File{"out.zng", test.Trim(input)}},
+}
+
+var Dir = test.Shell{
+	Name:   "compress-dir",
+	Script: `zq -z zstd -d out "*" in.zng && zq "*" out/conn.zng.zst > conn.zng`,
+	Input:  []test.File{test.File{"in.zng", test.Trim(input)}},
+	Expected: []test.File{
+		test.File{"conn.zng", test.Trim(conn)},
+	},
+}
+
+const input = `
+#0:record[_path:string,a:string]
+0:[conn;foo;]
+#1:record[_path:string,a:int64]
+1:[dns;1;]
+0:[conn;hello;]
+`
+
+const conn = `
+#0:record[_path:string,a:string]
+0:[conn;foo;]
+0:[conn;hello;]
+`
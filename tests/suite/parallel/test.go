@@ -0,0 +1,30 @@
+package parallel
+
+import (
+	"github.com/brimsec/zq/pkg/test"
+)
+
+var Test = test.Shell{
+	Name:     "parallel",
+	Script:   `zq -f bzng -b 2 -o in.bzng "*" in.zng && zq -P 4 "a=foo or a=1" in.bzng > out.zng`,
+	Input:    []test.File{test.File{"in.zng", test.Trim(input)}},
+	Expected: []test.File{test.File{"out.zng", test.Trim(output)}},
+}
+
+const input = `
+#0:record[_path:string,a:string]
+0:[conn;foo;]
+#1:record[_path:string,a:int64]
+1:[dns;1;]
+0:[conn;hello;]
+1:[dns;2;]
+0:[conn;foo;]
+`
+
+const output = `
+#0:record[_path:string,a:string]
+0:[conn;foo;]
+#1:record[_path:string,a:int64]
+1:[dns;1;]
+0:[conn;foo;]
+`
@@ -0,0 +1,53 @@
+package repl
+
+import (
+	"github.com/brimsec/zq/pkg/test"
+)
+
+// The queries and commands are read from standard input, which is not a
+// terminal, so zq writes no prompts.
+var Test = test.Shell{
+	Name:   "repl",
+	Script: `zq -repl in.zng < queries > out.txt`,
+	Input: []test.File{
+		test.File{"in.zng", test.Trim(input)},
+		test.File{"queries", test.Trim(queries)},
+	},
+	Expected: []test.File{
+		test.File{"out.txt", test.Trim(expected)},
+	},
+}
+
+const input = `
+#0:record[ts:time,id:record[orig_h:ip,resp_p:port],proto:string]
+0:[1;[10.0.0.1;80;]tcp;]
+0:[2;[10.0.0.2;53;]udp;]
+0:[15;[10.0.0.1;443;]tcp;]
+`
+
+const queries = `
+count() by proto | sort proto
+\format zng
+\span 2 15
+count()
+\span all
+cut id.orig_h
+\fields
+`
+
+const expected = `
+PROTO COUNT
+tcp   2
+udp   1
+#0:record[count:uint64]
+0:[2;]
+#0:record[id:record[orig_h:ip]]
+0:[[10.0.0.1;]]
+0:[[10.0.0.2;]]
+0:[[10.0.0.1;]]
+id
+id.orig_h
+id.resp_p
+proto
+ts
+`
@@ -67,6 +67,15 @@ func checkReader(t *testing.T, r zbuf.Reader, checkReads bool) {
 }
 
 func TestBzngIndex(t *testing.T) {
+	t.Run("uncompressed", func(t *testing.T) {
+		testBzngIndex(t, zio.Flags{StreamRecordsMax: 2})
+	})
+	t.Run("compressed", func(t *testing.T) {
+		testBzngIndex(t, zio.Flags{StreamRecordsMax: 2, BzngCompress: true})
+	})
+}
+
+func testBzngIndex(t *testing.T, flags zio.Flags) {
 	// get a scratch directory
 	dir, err := ioutil.TempDir("", "")
 	require.NoError(t, err)
@@ -78,7 +87,6 @@ func TestBzngIndex(t *testing.T) {
 	fp, err := os.Create(fname)
 	require.NoError(t, err)
 
-	flags := zio.Flags{StreamRecordsMax: 2}
 	writer := NewWriter(fp, flags)
 
 	for {
@@ -91,6 +99,8 @@ func TestBzngIndex(t *testing.T) {
 		err = writer.Write(rec)
 		require.NoError(t, err)
 	}
+	require.NoError(t, writer.Flush())
+	require.NoError(t, fp.Close())
 
 	index := NewTimeIndex()
 
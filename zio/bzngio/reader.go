@@ -1,6 +1,8 @@
 package bzngio
 
 import (
+	"bytes"
+	"compress/flate"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -23,6 +25,10 @@ type Reader struct {
 	mapper   *resolver.Mapper
 	position int64
 	sos      int64
+	// frame holds the unread messages of the current compressed frame.
+	frame    []byte
+	framebuf []byte
+	inflater io.ReadCloser
 }
 
 func NewReader(reader io.Reader, zctx *resolver.Context) *Reader {
@@ -38,11 +44,29 @@ func NewReaderWithSize(reader io.Reader, zctx *resolver.Context, size int) *Read
 }
 
 func (r *Reader) read(n int) ([]byte, error) {
+	if len(r.frame) > 0 {
+		if n > len(r.frame) {
+			return nil, zng.ErrBadFormat
+		}
+		b := r.frame[:n]
+		r.frame = r.frame[n:]
+		return b, nil
+	}
 	b, err := r.peeker.Read(n)
 	r.position += int64(len(b))
 	return b, err
 }
 
+func (r *Reader) peek(n int) ([]byte, error) {
+	if len(r.frame) > 0 {
+		if n > len(r.frame) {
+			n = len(r.frame)
+		}
+		return r.frame[:n], nil
+	}
+	return r.peeker.Peek(n)
+}
+
 func (r *Reader) Position() int64 {
 	return r.position
 }
@@ -112,8 +136,17 @@ again:
 		case zng.TypeDefAlias:
 			err = r.readTypeAlias()
 		case zng.CtrlEOS:
+			if len(r.frame) > 0 {
+				return nil, nil, zng.ErrBadFormat
+			}
 			r.zctx.Reset()
+			r.mapper.Reset()
 			r.sos = r.position
+		case zng.CtrlCompressed:
+			if len(r.frame) > 0 {
+				return nil, nil, zng.ErrBadFormat
+			}
+			err = r.readCompressed()
 		default:
 			// XXX we should return the control code
 			len, err := r.readUvarint()
@@ -155,8 +188,46 @@ again:
 	return rec, nil, nil
 }
 
+// readCompressed reads a compressed frame and decompresses its messages
+// into r.frame so that subsequent reads consume them.
+func (r *Reader) readCompressed() error {
+	format, err := r.readUvarint()
+	if err != nil {
+		return zng.ErrBadFormat
+	}
+	size, err := r.readUvarint()
+	if err != nil || size > MaxSize {
+		return zng.ErrBadFormat
+	}
+	zlen, err := r.readUvarint()
+	if err != nil {
+		return zng.ErrBadFormat
+	}
+	if format != CompressionFormatFlate {
+		return fmt.Errorf("bzng: unknown compression format %d", format)
+	}
+	b, err := r.read(zlen)
+	if err != nil {
+		return zng.ErrBadFormat
+	}
+	if r.inflater == nil {
+		r.inflater = flate.NewReader(bytes.NewReader(b))
+	} else if err := r.inflater.(flate.Resetter).Reset(bytes.NewReader(b), nil); err != nil {
+		return err
+	}
+	if cap(r.framebuf) < size {
+		r.framebuf = make([]byte, size)
+	}
+	r.framebuf = r.framebuf[:size]
+	if _, err := io.ReadFull(r.inflater, r.framebuf); err != nil {
+		return zng.ErrBadFormat
+	}
+	r.frame = r.framebuf
+	return nil
+}
+
 func (r *Reader) readUvarint() (int, error) {
-	b, err := r.peeker.Peek(binary.MaxVarintLen64)
+	b, err := r.peek(binary.MaxVarintLen64)
 	if err != nil && err != io.EOF && err != peeker.ErrTruncated {
 		return 0, zng.ErrBadFormat
 	}
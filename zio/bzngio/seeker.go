@@ -24,7 +24,9 @@ func NewSeekerWithSize(s io.ReadSeeker, zctx *resolver.Context, framesize int) *
 
 func (s *Seeker) Seek(offset int64) (int64, error) {
 	s.peeker.Reset()
+	s.frame = nil
 	s.zctx.Reset()
+	s.mapper.Reset()
 	n, err := s.seeker.Seek(offset, io.SeekStart)
 	s.position = n
 	return n, err
@@ -0,0 +1,146 @@
+package bzngio
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/brimsec/zq/pkg/peeker"
+	"github.com/brimsec/zq/zng"
+)
+
+// A Splitter finds the boundaries between the streams of bzng data, i.e.,
+// the offsets at which the Reader resets its type context and a TimeIndex
+// may mark the start of a stream.  It parses only the framing of the
+// messages and skips over their values and compressed frames, so it is
+// much faster than reading the data with a Reader.
+type Splitter struct {
+	peeker   *peeker.Reader
+	position int64
+}
+
+func NewSplitter(reader io.Reader) *Splitter {
+	return &Splitter{peeker: peeker.NewReader(reader, ReadSize, MaxSize)}
+}
+
+// Next returns the offset of the start of the next stream, which follows
+// an end-of-stream message.  At the end of the data, Next returns the
+// offset of its end and io.EOF.
+func (s *Splitter) Next() (int64, error) {
+	for {
+		b, err := s.peeker.Read(1)
+		if err == io.EOF || len(b) == 0 {
+			return s.position, io.EOF
+		}
+		s.position++
+		code := b[0]
+		switch code {
+		case zng.CtrlEOS:
+			return s.position, nil
+		case zng.TypeDefRecord:
+			err = s.skipTypeRecord()
+		case zng.TypeDefArray:
+			_, err = s.readUvarint()
+		case zng.TypeDefSet, zng.TypeDefUnion:
+			err = s.skipTypeList()
+		case zng.TypeDefAlias:
+			if err = s.skipCounted(); err == nil {
+				_, err = s.readUvarint()
+			}
+		case zng.CtrlCompressed:
+			err = s.skipCompressed()
+		default:
+			if code&0x80 != 0 {
+				// Other control messages are counted bytes.
+				err = s.skipCounted()
+				break
+			}
+			if code&0x40 != 0 {
+				// The type ID continues in a uvarint.
+				if _, err = s.readUvarint(); err != nil {
+					break
+				}
+			}
+			err = s.skipCounted()
+		}
+		if err != nil {
+			return s.position, err
+		}
+	}
+}
+
+func (s *Splitter) skipTypeRecord() error {
+	ncol, err := s.readUvarint()
+	if err != nil {
+		return err
+	}
+	for k := 0; k < ncol; k++ {
+		if err := s.skipCounted(); err != nil {
+			return err
+		}
+		if _, err := s.readUvarint(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Splitter) skipTypeList() error {
+	n, err := s.readUvarint()
+	if err != nil {
+		return err
+	}
+	for k := 0; k < n; k++ {
+		if _, err := s.readUvarint(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Splitter) skipCompressed() error {
+	// Skip the format and uncompressed size to reach the length.
+	for k := 0; k < 2; k++ {
+		if _, err := s.readUvarint(); err != nil {
+			return err
+		}
+	}
+	return s.skipCounted()
+}
+
+// skipCounted skips over a uvarint length and that many bytes.
+func (s *Splitter) skipCounted() error {
+	n, err := s.readUvarint()
+	if err != nil {
+		return err
+	}
+	return s.skip(n)
+}
+
+func (s *Splitter) skip(n int) error {
+	for n > 0 {
+		chunk := n
+		if chunk > ReadSize {
+			chunk = ReadSize
+		}
+		if _, err := s.peeker.Read(chunk); err != nil {
+			return zng.ErrBadFormat
+		}
+		s.position += int64(chunk)
+		n -= chunk
+	}
+	return nil
+}
+
+func (s *Splitter) readUvarint() (int, error) {
+	b, err := s.peeker.Peek(binary.MaxVarintLen64)
+	if err != nil && err != io.EOF && err != peeker.ErrTruncated {
+		return 0, zng.ErrBadFormat
+	}
+	v, n := binary.Uvarint(b)
+	if n <= 0 {
+		return 0, zng.ErrBadFormat
+	}
+	s.peeker.Read(n)
+	s.position += int64(n)
+	return int(v), nil
+}
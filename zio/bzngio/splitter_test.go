@@ -0,0 +1,87 @@
+package bzngio
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/require"
+)
+
+// splitterData has types of each kind so that the Splitter skips over
+// each kind of type definition.
+const splitterData = `
+#myint=int32
+#0:record[ts:time,value:int32]
+0:[1586886160;0;]
+0:[1586886161;1;]
+#1:record[ts:time,s:string,a:array[int32],set:set[string]]
+1:[1586886162;foo;[1;2;][x;y;]]
+0:[1586886163;3;]
+#2:record[ts:time,r:record[u:union[int32,string]]]
+2:[1586886164;[1:bar;]]
+0:[1586886165;5;]
+#3:record[ts:time,v:myint]
+3:[1586886166;6;]
+`
+
+func TestSplitter(t *testing.T) {
+	t.Run("uncompressed", func(t *testing.T) {
+		testSplitter(t, zio.Flags{StreamRecordsMax: 2})
+	})
+	t.Run("compressed", func(t *testing.T) {
+		testSplitter(t, zio.Flags{StreamRecordsMax: 2, BzngCompress: true})
+	})
+}
+
+func testSplitter(t *testing.T, flags zio.Flags) {
+	reader := zngio.NewReader(strings.NewReader(splitterData), resolver.NewContext())
+	var buf bytes.Buffer
+	writer := NewWriter(&buf, flags)
+	for {
+		rec, err := reader.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		require.NoError(t, writer.Write(rec))
+	}
+	require.NoError(t, writer.Flush())
+
+	// The offsets found by a Splitter are the start-of-stream
+	// offsets seen by a Reader.
+	var expected []int64
+	r := NewReader(bytes.NewReader(buf.Bytes()), resolver.NewContext())
+	for {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		if sos := r.LastSOS(); sos != 0 && (len(expected) == 0 || expected[len(expected)-1] != sos) {
+			expected = append(expected, sos)
+		}
+	}
+	var offsets []int64
+	s := NewSplitter(bytes.NewReader(buf.Bytes()))
+	for {
+		off, err := s.Next()
+		if err == io.EOF {
+			require.Equal(t, int64(buf.Len()), off)
+			break
+		}
+		require.NoError(t, err)
+		offsets = append(offsets, off)
+	}
+	// The writer ends the last stream when it reaches the stream
+	// size limit, so the last offset may be the end of the data.
+	if len(offsets) > 0 && offsets[len(offsets)-1] == int64(buf.Len()) {
+		offsets = offsets[:len(offsets)-1]
+	}
+	require.Len(t, expected, 3)
+	require.Equal(t, expected, offsets)
+}
@@ -1,6 +1,8 @@
 package bzngio
 
 import (
+	"bytes"
+	"compress/flate"
 	"io"
 
 	"github.com/brimsec/zq/zcode"
@@ -9,6 +11,15 @@ import (
 	"github.com/brimsec/zq/zng/resolver"
 )
 
+const (
+	// CompressionFormatFlate identifies a compressed frame whose messages
+	// are compressed with DEFLATE (RFC 1951).
+	CompressionFormatFlate = 0
+	// FrameSize is the size in bytes of the uncompressed messages above
+	// which the writer ends a compressed frame.
+	FrameSize = 256 * 1024
+)
+
 type Writer struct {
 	io.Writer
 	zio.Flags
@@ -16,6 +27,10 @@ type Writer struct {
 	buffer        []byte
 	streamRecords int
 	position      int64
+	// When compressing, frame holds the messages of the current frame.
+	frame    []byte
+	deflated bytes.Buffer
+	deflater *flate.Writer
 }
 
 func NewWriter(w io.Writer, flags zio.Flags) *Writer {
@@ -27,22 +42,73 @@ func NewWriter(w io.Writer, flags zio.Flags) *Writer {
 	}
 }
 
+// write writes b to the underlying writer or, when compressing, appends
+// it to the current frame.
 func (w *Writer) write(b []byte) error {
+	if w.BzngCompress {
+		w.frame = append(w.frame, b...)
+		return nil
+	}
+	return w.writeRaw(b)
+}
+
+func (w *Writer) writeRaw(b []byte) error {
 	n, err := w.Writer.Write(b)
 	w.position += int64(n)
 	return err
 }
 
+// Position returns the number of bytes written to the underlying writer.
+// Since a compressed frame is written when it ends, this does not include
+// the messages of the current frame.
 func (w *Writer) Position() int64 {
 	return w.position
 }
 
+// flushFrame compresses the messages of the current frame, if any, and
+// writes them as a compressed frame.  A frame never spans an end-of-stream
+// marker so that readers may seek to the start of any stream.
+func (w *Writer) flushFrame() error {
+	if len(w.frame) == 0 {
+		return nil
+	}
+	w.deflated.Reset()
+	if w.deflater == nil {
+		var err error
+		w.deflater, err = flate.NewWriter(&w.deflated, flate.DefaultCompression)
+		if err != nil {
+			return err
+		}
+	} else {
+		w.deflater.Reset(&w.deflated)
+	}
+	if _, err := w.deflater.Write(w.frame); err != nil {
+		return err
+	}
+	if err := w.deflater.Close(); err != nil {
+		return err
+	}
+	hdr := w.buffer[:0]
+	hdr = append(hdr, zng.CtrlCompressed)
+	hdr = zcode.AppendUvarint(hdr, CompressionFormatFlate)
+	hdr = zcode.AppendUvarint(hdr, uint64(len(w.frame)))
+	hdr = zcode.AppendUvarint(hdr, uint64(w.deflated.Len()))
+	w.buffer = hdr
+	w.frame = w.frame[:0]
+	if err := w.writeRaw(hdr); err != nil {
+		return err
+	}
+	return w.writeRaw(w.deflated.Bytes())
+}
+
 func (w *Writer) EndStream() error {
 	w.encoder.Reset()
 	w.streamRecords = 0
-
+	if err := w.flushFrame(); err != nil {
+		return err
+	}
 	marker := []byte{zng.CtrlEOS}
-	return w.write(marker)
+	return w.writeRaw(marker)
 }
 
 func (w *Writer) Write(r *zng.Record) error {
@@ -80,21 +146,30 @@ func (w *Writer) Write(r *zng.Record) error {
 	w.streamRecords++
 	if w.StreamRecordsMax > 0 && w.streamRecords >= w.StreamRecordsMax {
 		w.EndStream()
+	} else if len(w.frame) >= FrameSize {
+		if err := w.flushFrame(); err != nil {
+			return err
+		}
 	}
 
 	return err
 }
 
 func (w *Writer) WriteControl(b []byte) error {
+	// End the current frame so the payload follows the values written
+	// before it.
+	if err := w.flushFrame(); err != nil {
+		return err
+	}
 	dst := w.buffer[:0]
 	//XXX 0xff for now.  need to pass through control codes?
 	dst = append(dst, 0xff)
 	dst = zcode.AppendUvarint(dst, uint64(len(b)))
-	err := w.write(dst)
+	err := w.writeRaw(dst)
 	if err != nil {
 		return err
 	}
-	return w.write(b)
+	return w.writeRaw(b)
 }
 
 func (w *Writer) Flush() error {
@@ -0,0 +1,70 @@
+package bzngio
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressedFrames(t *testing.T) {
+	var in strings.Builder
+	in.WriteString("#0:record[n:int64,s:string]\n")
+	const n = 20000
+	for k := 0; k < n; k++ {
+		fmt.Fprintf(&in, "0:[%d;record number %d;]\n", k, k)
+	}
+	r := zngio.NewReader(strings.NewReader(in.String()), resolver.NewContext())
+	var compressed bytes.Buffer
+	w := NewWriter(&compressed, zio.Flags{BzngCompress: true})
+	require.NoError(t, zbuf.Copy(w, r))
+	// The messages must span more than one frame and compress well.
+	assert.Greater(t, bytes.Count(compressed.Bytes(), []byte{zng.CtrlCompressed}), 1)
+	assert.Less(t, compressed.Len(), in.Len()/4)
+
+	reader := NewReader(bytes.NewReader(compressed.Bytes()), resolver.NewContext())
+	for k := 0; k < n; k++ {
+		rec, err := reader.Read()
+		require.NoError(t, err)
+		require.NotNil(t, rec)
+		v, err := rec.AccessInt("n")
+		require.NoError(t, err)
+		require.EqualValues(t, k, v)
+	}
+	rec, err := reader.Read()
+	require.NoError(t, err)
+	assert.Nil(t, rec)
+}
+
+func TestCompressedControl(t *testing.T) {
+	r := zngio.NewReader(strings.NewReader("#0:record[a:int64]\n0:[1;]\n"), resolver.NewContext())
+	rec, err := r.Read()
+	require.NoError(t, err)
+	var out bytes.Buffer
+	w := NewWriter(&out, zio.Flags{BzngCompress: true})
+	require.NoError(t, w.Write(rec))
+	require.NoError(t, w.WriteControl([]byte("message")))
+	require.NoError(t, w.Write(rec))
+	require.NoError(t, w.Flush())
+
+	reader := NewReader(bytes.NewReader(out.Bytes()), resolver.NewContext())
+	rec, b, err := reader.ReadPayload()
+	require.NoError(t, err)
+	assert.Nil(t, b)
+	assert.Equal(t, "record[1]", rec.String())
+	rec, b, err = reader.ReadPayload()
+	require.NoError(t, err)
+	assert.Nil(t, rec)
+	assert.Equal(t, "message", string(b))
+	rec, _, err = reader.ReadPayload()
+	require.NoError(t, err)
+	assert.NotNil(t, rec)
+}
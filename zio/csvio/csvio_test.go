@@ -0,0 +1,90 @@
+package csvio_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/csvio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader(t *testing.T) {
+	const csv = `a,b,c.d,c.e,ts,f
+1,"x, y",fe80::1,,2020-01-01T00:00:00Z,
+2.5,10.0.0.0/8,10.0.0.1,inf,,
+,true,,1,2020-01-02T00:00:00Z,
+`
+	const expected = `#0:record[a:float64,b:string,c:record[d:ip,e:string],ts:time,f:string]
+0:[1;x, y;[fe80::1;-;]1577836800;-;]
+0:[2.5;10.0.0.0/8;[10.0.0.1;inf;]-;-;]
+0:[-;true;[-;1;]1577923200;-;]
+`
+	r := csvio.NewReader(strings.NewReader(csv), resolver.NewContext(), ',')
+	var out bytes.Buffer
+	require.NoError(t, zbuf.Copy(zbuf.NopFlusher(zngio.NewWriter(&out)), r))
+	assert.Equal(t, expected, out.String())
+}
+
+func TestReaderTypeMismatch(t *testing.T) {
+	// A value after the sample that doesn't fit the inferred type of its
+	// column makes it a string column from then on.
+	csv := "a,b\n" + strings.Repeat("1,x\n", csvio.SampleSize) + "y,x\n2,x\n"
+	r := csvio.NewReader(strings.NewReader(csv), resolver.NewContext(), ',')
+	for k := 0; k < csvio.SampleSize; k++ {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		require.Equal(t, "record[a:int64,b:string]", rec.Type.String())
+	}
+	for _, expected := range []string{"y", "2"} {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		assert.Equal(t, "record[a:string,b:string]", rec.Type.String())
+		a, err := rec.AccessString("a")
+		require.NoError(t, err)
+		assert.Equal(t, expected, a)
+	}
+	rec, err := r.Read()
+	require.NoError(t, err)
+	assert.Nil(t, rec)
+}
+
+func TestReaderDuplicateField(t *testing.T) {
+	for _, hdr := range []string{"a,b,a", "a.b,a", "a,a.b", "a,,b"} {
+		r := csvio.NewReader(strings.NewReader(hdr+"\n1,2,3\n"), resolver.NewContext(), ',')
+		_, err := r.Read()
+		assert.Error(t, err, hdr)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	const zng = `#0:record[ts:time,id:record[orig_h:ip,resp:record[h:ip,p:port]],s:string,set:set[int64]]
+0:[1.5;[10.0.0.1;[10.0.0.2;80;]]"a, b";[1;2;]]
+0:[2;[10.0.0.3;-;]-;[]]
+`
+	const csv = `ts,id.orig_h,id.resp.h,id.resp.p,s,set
+1970-01-01T00:00:01.5Z,10.0.0.1,10.0.0.2,80,"""a, b""","1,2"
+1970-01-01T00:00:02Z,10.0.0.3,,,,
+`
+	r := zngio.NewReader(strings.NewReader(zng), resolver.NewContext())
+	var out bytes.Buffer
+	w := csvio.NewWriter(&out, ',', zio.Flags{})
+	require.NoError(t, zbuf.Copy(w, r))
+	assert.Equal(t, csv, out.String())
+}
+
+func TestWriterTypeChange(t *testing.T) {
+	const zng = `#0:record[a:string]
+0:[a;]
+#1:record[b:string]
+1:[b;]
+`
+	r := zngio.NewReader(strings.NewReader(zng), resolver.NewContext())
+	w := csvio.NewWriter(&bytes.Buffer{}, ',', zio.Flags{})
+	assert.Equal(t, csvio.ErrNotDataFrame, zbuf.Copy(w, r))
+}
@@ -0,0 +1,264 @@
+// Package csvio reads and writes records as comma-separated or
+// tab-separated values.  The first line of the input holds the field
+// names, where a dotted name such as "id.orig_h" denotes a field of a
+// nested record.  Since CSV carries no type information, the reader
+// infers the type of each column from the values in the first rows of
+// the input and gives the records read that type.  A column with a later
+// value that doesn't fit its inferred type is a string column in that
+// record and those that follow.
+package csvio
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// SampleSize is the number of records the reader examines to infer the
+// type of each column.
+const SampleSize = 1000
+
+// inferTypes are the types a column can be inferred as in order of
+// preference.  A column whose values don't all parse as one of these is
+// a string column.
+var inferTypes = []zng.Type{
+	zng.TypeInt64,
+	zng.TypeFloat64,
+	zng.TypeBool,
+	zng.TypeIP,
+	zng.TypeNet,
+	zng.TypeTime,
+}
+
+type Reader struct {
+	reader *csv.Reader
+	zctx   *resolver.Context
+	names  []string
+	root   *field
+	types  []zng.Type
+	typ    *zng.TypeRecord
+	sample [][]string
+}
+
+// A field is a column of the records read.  A field with fields is a
+// nested record.  Otherwise, its value is taken from the CSV column at
+// index.
+type field struct {
+	name   string
+	index  int
+	fields []*field
+}
+
+func NewReader(r io.Reader, zctx *resolver.Context, comma rune) *Reader {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	return &Reader{
+		reader: reader,
+		zctx:   zctx,
+	}
+}
+
+func (r *Reader) Read() (*zng.Record, error) {
+	if r.root == nil {
+		hdr, err := r.reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if r.root, err = parseHeader(hdr); err != nil {
+			return nil, err
+		}
+		r.names = hdr
+		if err := r.infer(); err != nil {
+			return nil, err
+		}
+	}
+	var row []string
+	if len(r.sample) > 0 {
+		row = r.sample[0]
+		r.sample = r.sample[1:]
+	} else {
+		var err error
+		row, err = r.reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil, nil
+			}
+			return nil, err
+		}
+	}
+	vals := make([]zcode.Bytes, len(row))
+	for k, s := range row {
+		val, ok := parseValue(r.types[k], s)
+		if !ok {
+			// The value doesn't fit the type inferred from the
+			// sample, so the column is a string column from here on.
+			r.types[k] = zng.TypeString
+			r.typ = r.zctx.LookupTypeRecord(r.root.columns(r.zctx, r.types))
+			val, _ = parseValue(zng.TypeString, s)
+		}
+		vals[k] = val
+	}
+	b := zcode.NewBuilder()
+	r.root.build(b, vals)
+	return zng.NewRecord(r.typ, b.Bytes())
+}
+
+// infer reads up to SampleSize records and sets the type of each column
+// to the first of inferTypes that all of its non-empty values in the sample
+// parse as.
+func (r *Reader) infer() error {
+	candidates := make([][]zng.Type, len(r.names))
+	for k := range candidates {
+		candidates[k] = inferTypes
+	}
+	for len(r.sample) < SampleSize {
+		row, err := r.reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		r.sample = append(r.sample, row)
+		for k, s := range row {
+			if s == "" {
+				continue
+			}
+			var types []zng.Type
+			for _, typ := range candidates[k] {
+				if _, ok := parseValue(typ, s); ok {
+					types = append(types, typ)
+				}
+			}
+			candidates[k] = types
+		}
+	}
+	r.types = make([]zng.Type, len(r.names))
+	for k, types := range candidates {
+		if len(types) == len(inferTypes) || len(types) == 0 {
+			// Every value was empty or no inferred type fits.
+			r.types[k] = zng.TypeString
+		} else {
+			r.types[k] = types[0]
+		}
+	}
+	r.typ = r.zctx.LookupTypeRecord(r.root.columns(r.zctx, r.types))
+	return nil
+}
+
+func parseHeader(hdr []string) (*field, error) {
+	root := &field{index: -1}
+	for k, name := range hdr {
+		if name == "" {
+			return nil, fmt.Errorf("csv header: column %d has no name", k+1)
+		}
+		node := root
+		path := strings.Split(name, ".")
+		for _, elem := range path[:len(path)-1] {
+			child := node.lookup(elem)
+			if child == nil {
+				child = &field{name: elem, index: -1}
+				node.fields = append(node.fields, child)
+			} else if child.fields == nil {
+				return nil, fmt.Errorf("csv header: duplicate field %s", name)
+			}
+			node = child
+		}
+		elem := path[len(path)-1]
+		if node.lookup(elem) != nil {
+			return nil, fmt.Errorf("csv header: duplicate field %s", name)
+		}
+		node.fields = append(node.fields, &field{name: elem, index: k})
+	}
+	return root, nil
+}
+
+func (f *field) lookup(name string) *field {
+	for _, child := range f.fields {
+		if child.name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+func (f *field) columns(zctx *resolver.Context, types []zng.Type) []zng.Column {
+	cols := make([]zng.Column, 0, len(f.fields))
+	for _, child := range f.fields {
+		var typ zng.Type
+		if child.fields != nil {
+			typ = zctx.LookupTypeRecord(child.columns(zctx, types))
+		} else {
+			typ = types[child.index]
+		}
+		cols = append(cols, zng.NewColumn(child.name, typ))
+	}
+	return cols
+}
+
+func (f *field) build(b *zcode.Builder, vals []zcode.Bytes) {
+	for _, child := range f.fields {
+		if child.fields != nil {
+			b.BeginContainer()
+			child.build(b, vals)
+			b.EndContainer()
+			continue
+		}
+		b.AppendPrimitive(vals[child.index])
+	}
+}
+
+// parseValue returns the encoding of a CSV field as a value of type typ,
+// which is string or one of inferTypes, and whether the field parses as
+// that type.  An empty field is an unset value of any type.
+func parseValue(typ zng.Type, s string) (zcode.Bytes, bool) {
+	if s == "" {
+		return nil, true
+	}
+	switch typ {
+	case zng.TypeInt64:
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return zng.EncodeInt(i), true
+		}
+	case zng.TypeFloat64:
+		// Require a digit so that words like "inf" and "nan" remain strings.
+		if strings.ContainsAny(s, "0123456789") {
+			if f, err := strconv.ParseFloat(s, 64); err == nil {
+				return zng.EncodeFloat64(f), true
+			}
+		}
+	case zng.TypeBool:
+		switch s {
+		case "true":
+			return zng.EncodeBool(true), true
+		case "false":
+			return zng.EncodeBool(false), true
+		}
+	case zng.TypeIP:
+		if ip := net.ParseIP(s); ip != nil {
+			return zng.EncodeIP(ip), true
+		}
+	case zng.TypeNet:
+		if ip, subnet, err := net.ParseCIDR(s); err == nil && ip.Equal(subnet.IP) {
+			return zng.EncodeNet(subnet), true
+		}
+	case zng.TypeTime:
+		if ts, err := nano.ParseRFC3339Nano([]byte(s)); err == nil {
+			return zng.EncodeTime(ts), true
+		}
+	case zng.TypeString:
+		return zng.EncodeString(s), true
+	}
+	return nil, false
+}
@@ -0,0 +1,85 @@
+package csvio
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zeekio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+var ErrNotDataFrame = errors.New("csv output requires uniform records but different types encountered")
+
+// Writer writes records as comma-separated (or, given a different comma,
+// e.g., tab-separated) values.  Nested records are flattened into columns
+// with dotted names, e.g., "id.orig_h".  Since the output has a single
+// header, all records written must have the same flattened type.
+type Writer struct {
+	writer     *csv.Writer
+	flattener  *zeekio.Flattener
+	typ        *zng.TypeRecord
+	epochDates bool
+}
+
+func NewWriter(w io.Writer, comma rune, flags zio.Flags) *Writer {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	return &Writer{
+		writer:     writer,
+		flattener:  zeekio.NewFlattener(resolver.NewContext()),
+		epochDates: flags.EpochDates,
+	}
+}
+
+func (w *Writer) Write(rec *zng.Record) error {
+	rec, err := w.flattener.Flatten(rec)
+	if err != nil {
+		return err
+	}
+	if w.typ == nil {
+		w.typ = rec.Type
+		var hdr []string
+		for _, col := range rec.Type.Columns {
+			hdr = append(hdr, col.Name)
+		}
+		if err := w.writer.Write(hdr); err != nil {
+			return err
+		}
+	} else if rec.Type != w.typ {
+		return ErrNotDataFrame
+	}
+	var fields []string
+	for k, col := range rec.Type.Columns {
+		value := rec.Value(k)
+		// Unset values are written as empty fields.
+		var v string
+		switch {
+		case value.IsUnsetOrNil():
+		case col.Type == zng.TypeTime && !w.epochDates:
+			ts, err := zng.DecodeTime(value.Bytes)
+			if err != nil {
+				return err
+			}
+			v = ts.Time().UTC().Format(time.RFC3339Nano)
+		default:
+			v = value.Format(zng.OutFormatUnescaped)
+		}
+		fields = append(fields, v)
+	}
+	return w.writer.Write(fields)
+}
+
+func (w *Writer) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// FlushOutput writes any buffered rows.  Since the output is complete after
+// each row, this is the same as Flush.
+func (w *Writer) FlushOutput() error {
+	return w.Flush()
+}
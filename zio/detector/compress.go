@@ -0,0 +1,55 @@
+package detector
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+var (
+	// A bzip2 stream begins with "BZh", the block size, and the magic
+	// number of either a block or the end of the stream.
+	bzip2Magic      = []byte("BZh")
+	bzip2BlockMagic = []byte{0x31, 0x41, 0x59, 0x26, 0x53, 0x59}
+	bzip2EOSMagic   = []byte{0x17, 0x72, 0x45, 0x38, 0x50, 0x90}
+	zstdMagic       = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// DecompressReader returns a reader of the uncompressed data of r if r is
+// compressed with gzip, bzip2, or zstd.  Otherwise, it returns a reader of
+// the data of r as is.  Closing the returned reader releases the resources
+// of the decompressor but does not close r.
+func DecompressReader(r io.Reader) io.ReadCloser {
+	recorder := NewRecorder(r)
+	track := NewTrack(recorder)
+	_, err := gzip.NewReader(track)
+	if err == nil {
+		// create a new reader from recorder (track keeps a copy of read data)
+		r, _ := gzip.NewReader(recorder)
+		return r
+	}
+	track.Reset()
+	var hdr [10]byte
+	n, _ := io.ReadFull(track, hdr[:])
+	b := hdr[:n]
+	if isBzip2(b) {
+		return ioutil.NopCloser(bzip2.NewReader(recorder))
+	}
+	if bytes.HasPrefix(b, zstdMagic) {
+		if zr, err := zstd.NewReader(recorder); err == nil {
+			return zr.IOReadCloser()
+		}
+	}
+	return ioutil.NopCloser(recorder)
+}
+
+func isBzip2(b []byte) bool {
+	if len(b) < 10 || !bytes.HasPrefix(b, bzip2Magic) || b[3] < '1' || b[3] > '9' {
+		return false
+	}
+	return bytes.Equal(b[4:], bzip2BlockMagic) || bytes.Equal(b[4:], bzip2EOSMagic)
+}
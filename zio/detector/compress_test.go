@@ -0,0 +1,54 @@
+package detector
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const data = "#0:record[a:int64]\n0:[1;]\n"
+
+// data compressed by bzip2 -9
+var bzip2Data = []byte{
+	0x42, 0x5a, 0x68, 0x39, 0x31, 0x41, 0x59, 0x26, 0x53, 0x59, 0xfa, 0x74,
+	0xaf, 0xfd, 0x00, 0x00, 0x01, 0x5b, 0x80, 0x00, 0x10, 0x08, 0x00, 0x65,
+	0x18, 0x00, 0x0a, 0x2e, 0x21, 0x94, 0x00, 0x20, 0x00, 0x31, 0x4c, 0x98,
+	0x99, 0x06, 0x46, 0x0d, 0x4d, 0x06, 0x8d, 0x1a, 0x1a, 0x7a, 0x8c, 0x10,
+	0x4d, 0xae, 0x57, 0x49, 0x8c, 0xed, 0xdd, 0xb8, 0x42, 0xc4, 0x1a, 0x1f,
+	0x8b, 0xb9, 0x22, 0x9c, 0x28, 0x48, 0x7d, 0x3a, 0x57, 0xfe, 0x80,
+}
+
+func decompress(t *testing.T, b []byte) string {
+	r := DecompressReader(bytes.NewReader(b))
+	out, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	return string(out)
+}
+
+func TestDecompressReader(t *testing.T) {
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	_, err := gw.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+
+	var zst bytes.Buffer
+	zw, err := zstd.NewWriter(&zst)
+	require.NoError(t, err)
+	_, err = zw.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	assert.Equal(t, data, decompress(t, []byte(data)))
+	assert.Equal(t, data, decompress(t, gz.Bytes()))
+	assert.Equal(t, data, decompress(t, bzip2Data))
+	assert.Equal(t, data, decompress(t, zst.Bytes()))
+	// Text that begins like a bzip2 stream is not decompressed.
+	assert.Equal(t, "BZh9 is not bzip2", decompress(t, []byte("BZh9 is not bzip2")))
+}
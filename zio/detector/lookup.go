@@ -6,7 +6,9 @@ import (
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio"
 	"github.com/brimsec/zq/zio/bzngio"
+	"github.com/brimsec/zq/zio/csvio"
 	"github.com/brimsec/zq/zio/ndjsonio"
+	"github.com/brimsec/zq/zio/parquetio"
 	"github.com/brimsec/zq/zio/tableio"
 	"github.com/brimsec/zq/zio/textio"
 	"github.com/brimsec/zq/zio/zeekio"
@@ -47,6 +49,12 @@ func LookupWriter(format string, w io.WriteCloser, optionalFlags *zio.Flags) *zi
 		f = zbuf.NopFlusher(textio.NewWriter(w, flags))
 	case "table":
 		f = tableio.NewWriter(w, flags)
+	case "csv":
+		f = csvio.NewWriter(w, ',', flags)
+	case "tsv":
+		f = csvio.NewWriter(w, '\t', flags)
+	case "parquet":
+		f = parquetio.NewWriter(w)
 	}
 	return &zio.Writer{
 		WriteFlusher: f,
@@ -66,6 +74,10 @@ func LookupReader(format string, r io.Reader, zctx *resolver.Context) (zbuf.Read
 		return zjsonio.NewReader(r, zctx), nil
 	case "bzng":
 		return bzngio.NewReader(r, zctx), nil
+	case "csv":
+		return csvio.NewReader(r, zctx, ','), nil
+	case "tsv":
+		return csvio.NewReader(r, zctx, '\t'), nil
 	}
 	return nil, nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio/bzngio"
+	"github.com/brimsec/zq/zio/csvio"
 	"github.com/brimsec/zq/zio/ndjsonio"
 	"github.com/brimsec/zq/zio/zeekio"
 	"github.com/brimsec/zq/zio/zjsonio"
@@ -51,6 +52,14 @@ func NewReader(r io.Reader, zctx *resolver.Context) (zbuf.Reader, error) {
 	if match(bzngio.NewReader(track, resolver.NewContext())) {
 		return bzngio.NewReader(recorder, zctx), nil
 	}
+	// csv and tsv must come last since almost any text parses as one
+	// or the other
+	for _, comma := range []rune{',', '\t'} {
+		track.Reset()
+		if matchCSV(csvio.NewReader(track, resolver.NewContext(), comma)) {
+			return csvio.NewReader(recorder, zctx, comma), nil
+		}
+	}
 	return nil, ErrUnknown
 }
 
@@ -58,3 +67,10 @@ func match(r zbuf.Reader) bool {
 	_, err := r.Read()
 	return err == nil
 }
+
+// matchCSV requires a header and a first record with more than one field
+// so that other text isn't mistaken for CSV.
+func matchCSV(r zbuf.Reader) bool {
+	rec, err := r.Read()
+	return err == nil && rec != nil && len(rec.Type.Columns) > 1
+}
@@ -0,0 +1,307 @@
+package parquetio
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/brimsec/zq/zng"
+)
+
+// Parquet physical types.
+const (
+	typeBoolean   = 0
+	typeInt32     = 1
+	typeInt64     = 2
+	typeDouble    = 5
+	typeByteArray = 6
+)
+
+// Parquet field repetition types.
+const (
+	required = 0
+	optional = 1
+	repeated = 2
+)
+
+// Parquet converted types, which older readers use in place of logical
+// types.
+const (
+	convertedNone   = -1
+	convertedUTF8   = 0
+	convertedList   = 3
+	convertedUint8  = 11
+	convertedUint16 = 12
+	convertedUint32 = 13
+	convertedUint64 = 14
+	convertedInt16  = 16
+)
+
+// Parquet logical types.
+const (
+	logicalNone = iota
+	logicalString
+	logicalList
+	logicalTimestamp
+	logicalInteger
+)
+
+const (
+	kindLeaf = iota
+	kindGroup
+	kindList
+)
+
+// A node is an element of the Parquet schema.  A group holds the columns
+// of a nested record.  A list holds the elements of an array or set and
+// is written as the standard three-level structure of a group annotated
+// as a list holding a repeated group named "list" that holds the element
+// named "element".  A leaf holds values of the zng type typ.
+type node struct {
+	name       string
+	kind       int
+	repetition int
+	typ        zng.Type
+	children   []*node
+	// For leaves.
+	physical  int32
+	converted int32
+	logical   int
+	bitWidth  int8
+	signed    bool
+	column    *column
+	// The maximum definition and repetition levels of the node.
+	maxDef int
+	maxRep int
+	// The number of rows written before the node was added to the schema.
+	firstRow int64
+	// For groups outside of any list, the definition level of the nearest
+	// present group at or above this one for each row since firstRow.
+	defs []levelRun
+}
+
+type levelRun struct {
+	level int
+	count int64
+}
+
+func newRoot() *node {
+	return &node{name: "schema", kind: kindGroup, repetition: required, converted: convertedNone}
+}
+
+// merge adds the columns of typ not already present to the group n, noting
+// that row rows were written before they were added.  It returns an error
+// if a column is present with a different type.
+func (n *node) merge(typ *zng.TypeRecord, row int64) error {
+	for _, col := range typ.Columns {
+		child := n.lookup(col.Name)
+		if child == nil {
+			var err error
+			if child, err = newNode(col.Name, col.Type, row); err != nil {
+				return err
+			}
+			n.children = append(n.children, child)
+		}
+		recType, isRecord := zng.AliasedType(col.Type).(*zng.TypeRecord)
+		if isRecord && child.kind == kindGroup {
+			if err := child.merge(recType, row); err != nil {
+				return err
+			}
+			continue
+		}
+		if isRecord || child.kind == kindGroup || zng.AliasedType(child.typ).String() != zng.AliasedType(col.Type).String() {
+			return fmt.Errorf("parquet: field %s has conflicting types", col.Name)
+		}
+	}
+	return nil
+}
+
+func (n *node) lookup(name string) *node {
+	for _, child := range n.children {
+		if child.name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+func newNode(name string, typ zng.Type, row int64) (*node, error) {
+	n := &node{name: name, repetition: optional, typ: typ, converted: convertedNone, firstRow: row}
+	switch typ := zng.AliasedType(typ).(type) {
+	case *zng.TypeRecord:
+		n.kind = kindGroup
+		n.typ = nil
+		return n, n.merge(typ, row)
+	case *zng.TypeArray, *zng.TypeSet:
+		elem, err := newNode("element", zng.InnerType(typ), row)
+		if err != nil {
+			return nil, err
+		}
+		n.kind = kindList
+		n.converted = convertedList
+		n.logical = logicalList
+		n.children = []*node{{
+			name:       "list",
+			kind:       kindGroup,
+			repetition: repeated,
+			converted:  convertedNone,
+			children:   []*node{elem},
+			firstRow:   row,
+		}}
+		return n, nil
+	}
+	n.kind = kindLeaf
+	switch zng.AliasedType(typ) {
+	case zng.TypeBool:
+		n.physical = typeBoolean
+	case zng.TypeByte:
+		n.setInteger(typeInt32, convertedUint8, 8, false)
+	case zng.TypeInt16:
+		n.setInteger(typeInt32, convertedInt16, 16, true)
+	case zng.TypeUint16, zng.TypePort:
+		n.setInteger(typeInt32, convertedUint16, 16, false)
+	case zng.TypeInt32:
+		n.physical = typeInt32
+	case zng.TypeUint32:
+		n.setInteger(typeInt32, convertedUint32, 32, false)
+	case zng.TypeInt64, zng.TypeDuration:
+		n.physical = typeInt64
+	case zng.TypeUint64:
+		n.setInteger(typeInt64, convertedUint64, 64, false)
+	case zng.TypeFloat64:
+		n.physical = typeDouble
+	case zng.TypeTime:
+		n.physical = typeInt64
+		n.logical = logicalTimestamp
+	case zng.TypeString, zng.TypeBstring, zng.TypeIP, zng.TypeNet:
+		n.physical = typeByteArray
+		n.converted = convertedUTF8
+		n.logical = logicalString
+	default:
+		return nil, fmt.Errorf("parquet: field %s has unsupported type %s", name, typ)
+	}
+	return n, nil
+}
+
+func (n *node) setInteger(physical, converted int32, bitWidth int8, signed bool) {
+	n.physical = physical
+	n.converted = converted
+	n.logical = logicalInteger
+	n.bitWidth = bitWidth
+	n.signed = signed
+}
+
+// leaves sets the levels of the nodes of the schema rooted at n and
+// returns its leaves in schema order.
+func (n *node) leaves(path []string, def, rep int, leaves []*node) []*node {
+	if n.repetition != required {
+		def++
+	}
+	if n.repetition == repeated {
+		rep++
+	}
+	n.maxDef, n.maxRep = def, rep
+	if n.kind == kindLeaf {
+		if n.column == nil {
+			n.column = &column{}
+		}
+		n.column.path = path
+		n.column.defWidth = bits.Len(uint(def))
+		n.column.repWidth = bits.Len(uint(rep))
+		return append(leaves, n)
+	}
+	for _, child := range n.children {
+		childPath := append(append([]string{}, path...), child.name)
+		leaves = child.leaves(childPath, def, rep, leaves)
+	}
+	return leaves
+}
+
+// visit notes that the columns of the group n are visited for the next row
+// with definition level def.  Only groups outside of any list, which are
+// visited once per row, are noted since only they can gain columns.
+func (n *node) visit(def int) {
+	if n.maxRep > 0 {
+		return
+	}
+	if k := len(n.defs) - 1; k >= 0 && n.defs[k].level == def {
+		n.defs[k].count++
+		return
+	}
+	n.defs = append(n.defs, levelRun{def, 1})
+}
+
+// levels returns the definition levels noted by visit for the count rows
+// following the first row rows written.
+func (n *node) levels(row, count int64) []int {
+	levels := make([]int, 0, count)
+	skip := row - n.firstRow
+	for _, run := range n.defs {
+		if skip >= run.count {
+			skip -= run.count
+			continue
+		}
+		for k := skip; k < run.count && int64(len(levels)) < count; k++ {
+			levels = append(levels, run.level)
+		}
+		skip = 0
+	}
+	return levels
+}
+
+// count returns the number of schema elements in the schema rooted at n.
+func (n *node) count() int {
+	k := 1
+	for _, child := range n.children {
+		k += child.count()
+	}
+	return k
+}
+
+// encode appends the schema elements of the schema rooted at n, in
+// depth-first order, to the list being written by t.
+func (n *node) encode(t *thriftWriter, root bool) {
+	t.beginElem()
+	if n.kind == kindLeaf {
+		t.i32Field(1, n.physical)
+	}
+	if !root {
+		t.i32Field(3, int32(n.repetition))
+	}
+	t.stringField(4, n.name)
+	if n.kind != kindLeaf {
+		t.i32Field(5, int32(len(n.children)))
+	}
+	if n.converted != convertedNone {
+		t.i32Field(6, n.converted)
+	}
+	if n.logical != logicalNone {
+		t.beginStruct(10)
+		switch n.logical {
+		case logicalString:
+			t.beginStruct(1)
+			t.endStruct()
+		case logicalList:
+			t.beginStruct(3)
+			t.endStruct()
+		case logicalTimestamp:
+			t.beginStruct(8)
+			t.boolField(1, true)
+			t.beginStruct(2)
+			// Nanoseconds
+			t.beginStruct(3)
+			t.endStruct()
+			t.endStruct()
+			t.endStruct()
+		case logicalInteger:
+			t.beginStruct(10)
+			t.byteField(1, n.bitWidth)
+			t.boolField(2, n.signed)
+			t.endStruct()
+		}
+		t.endStruct()
+	}
+	t.endStruct()
+	for _, child := range n.children {
+		child.encode(t, false)
+	}
+}
@@ -0,0 +1,118 @@
+package parquetio
+
+import (
+	"encoding/binary"
+)
+
+// Type codes of the Thrift compact protocol, in which Parquet encodes its
+// page headers and file metadata.
+const (
+	ctBoolTrue  = 1
+	ctBoolFalse = 2
+	ctByte      = 3
+	ctI32       = 5
+	ctI64       = 6
+	ctBinary    = 8
+	ctList      = 9
+	ctStruct    = 12
+)
+
+// thriftWriter encodes a Thrift struct using the compact protocol.  Each
+// field is written with its ID, and nested structs are bracketed by calls
+// to beginStruct (or beginElem for a struct in a list) and endStruct.
+type thriftWriter struct {
+	buf  []byte
+	last []int16
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{last: []int16{0}}
+}
+
+// bytes ends the top-level struct and returns its encoding.
+func (t *thriftWriter) bytes() []byte {
+	t.buf = append(t.buf, 0)
+	return t.buf
+}
+
+func (t *thriftWriter) uvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	t.buf = append(t.buf, b[:n]...)
+}
+
+func (t *thriftWriter) varint(v int64) {
+	t.uvarint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (t *thriftWriter) field(id int16, typ byte) {
+	last := &t.last[len(t.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		t.buf = append(t.buf, byte(delta<<4)|typ)
+	} else {
+		t.buf = append(t.buf, typ)
+		t.varint(int64(id))
+	}
+	*last = id
+}
+
+func (t *thriftWriter) boolField(id int16, v bool) {
+	if v {
+		t.field(id, ctBoolTrue)
+	} else {
+		t.field(id, ctBoolFalse)
+	}
+}
+
+func (t *thriftWriter) byteField(id int16, v int8) {
+	t.field(id, ctByte)
+	t.buf = append(t.buf, byte(v))
+}
+
+func (t *thriftWriter) i32Field(id int16, v int32) {
+	t.field(id, ctI32)
+	t.varint(int64(v))
+}
+
+func (t *thriftWriter) i64Field(id int16, v int64) {
+	t.field(id, ctI64)
+	t.varint(v)
+}
+
+func (t *thriftWriter) stringField(id int16, s string) {
+	t.field(id, ctBinary)
+	t.string(s)
+}
+
+func (t *thriftWriter) string(s string) {
+	t.uvarint(uint64(len(s)))
+	t.buf = append(t.buf, s...)
+}
+
+func (t *thriftWriter) i32(v int32) {
+	t.varint(int64(v))
+}
+
+func (t *thriftWriter) listField(id int16, elemType byte, n int) {
+	t.field(id, ctList)
+	if n < 15 {
+		t.buf = append(t.buf, byte(n<<4)|elemType)
+	} else {
+		t.buf = append(t.buf, 0xf0|elemType)
+		t.uvarint(uint64(n))
+	}
+}
+
+func (t *thriftWriter) beginStruct(id int16) {
+	t.field(id, ctStruct)
+	t.beginElem()
+}
+
+func (t *thriftWriter) beginElem() {
+	t.last = append(t.last, 0)
+}
+
+func (t *thriftWriter) endStruct() {
+	t.buf = append(t.buf, 0)
+	t.last = t.last[:len(t.last)-1]
+}
@@ -0,0 +1,499 @@
+// Package parquetio writes records in the Apache Parquet format.
+//
+// A Parquet file has a single schema, which is written in the file's
+// footer along with the layout of each row group.  The writer writes a row
+// group each time the record type changes or the records of the current
+// row group reach RowGroupSize bytes, so it holds at most one row group in
+// memory.  The schema is formed from the fields of all the record types
+// seen, where a field missing from a record is null.  Since every row group
+// must hold every column of the schema, a column added to the schema after
+// a row group was written is given a chunk of nulls in that row group when
+// the writer is flushed.
+//
+// Nested records map to Parquet groups and arrays and sets to lists.
+// Values of type time are written as nanosecond timestamps and those of
+// type port as unsigned 16-bit integers.  Parquet has no type for network
+// addresses, so values of type ip and net are written as strings.  Pages
+// are written uncompressed.
+package parquetio
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+)
+
+const magic = "PAR1"
+
+// RowGroupSize is the number of bytes of zng record data above which the
+// writer ends a row group.
+const RowGroupSize = 64 * 1024 * 1024
+
+// Parquet encodings and page types.
+const (
+	encodingPlain = 0
+	encodingRLE   = 3
+	pageData      = 0
+)
+
+var ErrWriteAfterFlush = errors.New("parquet output already flushed")
+
+type Writer struct {
+	writer       io.Writer
+	rowGroupSize int
+	root         *node
+	leaves       []*node
+	typ          *zng.TypeRecord
+	groups       []rowGroup
+	// The number of rows in and the size of the row group being shredded.
+	nrows   int64
+	size    int
+	numRows int64
+	flushed bool
+	offset  int64
+}
+
+// A column holds the values and levels of a leaf of the schema for the
+// row group being written.
+type column struct {
+	path     []string
+	defWidth int
+	repWidth int
+	defs     []int
+	reps     []int
+	values   []byte
+	bools    []bool
+}
+
+// A chunk is the metadata of a column chunk that has been written.
+type chunk struct {
+	leaf      *node
+	column    *column
+	physical  int32
+	offset    int64
+	size      int64
+	numValues int64
+}
+
+type rowGroup struct {
+	chunks   []chunk
+	size     int64
+	firstRow int64
+	numRows  int64
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		writer:       w,
+		rowGroupSize: RowGroupSize,
+		root:         newRoot(),
+	}
+}
+
+func (w *Writer) Write(rec *zng.Record) error {
+	if w.flushed {
+		return ErrWriteAfterFlush
+	}
+	if rec.Type != w.typ {
+		if err := w.writeRowGroup(); err != nil {
+			return err
+		}
+		if err := w.root.merge(rec.Type, w.numRows); err != nil {
+			return err
+		}
+		w.leaves = w.root.leaves(nil, 0, 0, nil)
+		w.typ = rec.Type
+	}
+	if err := shredRecord(w.root, rec.Type, rec.Raw, 0, 0); err != nil {
+		return err
+	}
+	w.nrows++
+	w.size += len(rec.Raw)
+	if w.size >= w.rowGroupSize {
+		return w.writeRowGroup()
+	}
+	return nil
+}
+
+// Flush writes the last row group and the footer, completing the Parquet
+// file.  Since the file is complete once written, Write fails after Flush.
+func (w *Writer) Flush() error {
+	if w.flushed || (w.nrows == 0 && len(w.groups) == 0) {
+		return nil
+	}
+	w.flushed = true
+	if err := w.writeRowGroup(); err != nil {
+		return err
+	}
+	order := make(map[*node]int)
+	for k, leaf := range w.leaves {
+		order[leaf] = k
+	}
+	for k := range w.groups {
+		group := &w.groups[k]
+		have := make(map[*node]bool)
+		for _, ch := range group.chunks {
+			have[ch.leaf] = true
+		}
+		if err := w.writeNullChunks(group, w.root, w.root, have); err != nil {
+			return err
+		}
+		sort.Slice(group.chunks, func(i, j int) bool {
+			return order[group.chunks[i].leaf] < order[group.chunks[j].leaf]
+		})
+	}
+	footer := encodeFooter(w.root, w.groups, w.numRows)
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(footer)))
+	if err := w.write(footer); err != nil {
+		return err
+	}
+	if err := w.write(size[:]); err != nil {
+		return err
+	}
+	return w.write([]byte(magic))
+}
+
+func (w *Writer) write(b []byte) error {
+	n, err := w.writer.Write(b)
+	w.offset += int64(n)
+	return err
+}
+
+// writeRowGroup writes the columns of the records shredded since the last
+// row group was written.
+func (w *Writer) writeRowGroup() error {
+	if w.nrows == 0 {
+		return nil
+	}
+	if w.offset == 0 {
+		if err := w.write([]byte(magic)); err != nil {
+			return err
+		}
+	}
+	group := rowGroup{firstRow: w.numRows, numRows: w.nrows}
+	for _, leaf := range w.leaves {
+		c := leaf.column
+		ch, err := w.writeChunk(leaf, c)
+		if err != nil {
+			return err
+		}
+		group.chunks = append(group.chunks, ch)
+		group.size += ch.size
+		// Start the column anew for the next row group.
+		leaf.column = &column{path: c.path, defWidth: c.defWidth, repWidth: c.repWidth}
+	}
+	w.groups = append(w.groups, group)
+	w.numRows += w.nrows
+	w.nrows = 0
+	w.size = 0
+	return nil
+}
+
+func (w *Writer) writeChunk(leaf *node, c *column) (chunk, error) {
+	header, body := encodePage(c)
+	ch := chunk{
+		leaf:      leaf,
+		column:    c,
+		physical:  leaf.physical,
+		offset:    w.offset,
+		size:      int64(len(header) + len(body)),
+		numValues: int64(len(c.defs)),
+	}
+	if err := w.write(header); err != nil {
+		return chunk{}, err
+	}
+	return ch, w.write(body)
+}
+
+// writeNullChunks writes a chunk of nulls for each leaf under n that is
+// missing from group because it was added to the schema after group was
+// written.  The definition level of each null is that noted for anchor,
+// the deepest group at or above n that was present in the schema.
+func (w *Writer) writeNullChunks(group *rowGroup, n, anchor *node, have map[*node]bool) error {
+	if n.kind == kindGroup && n.maxRep == 0 && n.firstRow <= group.firstRow {
+		anchor = n
+	}
+	if n.kind != kindLeaf {
+		for _, child := range n.children {
+			if err := w.writeNullChunks(group, child, anchor, have); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if have[n] {
+		return nil
+	}
+	c := &column{
+		path:     n.column.path,
+		defWidth: n.column.defWidth,
+		repWidth: n.column.repWidth,
+		defs:     anchor.levels(group.firstRow, group.numRows),
+		reps:     make([]int, group.numRows),
+	}
+	ch, err := w.writeChunk(n, c)
+	if err != nil {
+		return err
+	}
+	group.chunks = append(group.chunks, ch)
+	group.size += ch.size
+	return nil
+}
+
+// shredRecord adds the values of the columns of the record zv of type typ
+// to the leaves under the group n, whose definition level is def, along
+// with their definition and repetition levels.  A column of n that is not
+// in typ is null.
+func shredRecord(n *node, typ *zng.TypeRecord, zv zcode.Bytes, rep, def int) error {
+	n.visit(def)
+	vals := make([]zcode.Bytes, len(typ.Columns))
+	it := zv.Iter()
+	for k := range vals {
+		val, _, err := it.Next()
+		if err != nil {
+			return err
+		}
+		vals[k] = val
+	}
+	for _, child := range n.children {
+		k, ok := typ.ColumnOfField(child.name)
+		if !ok {
+			child.null(rep, def)
+			continue
+		}
+		if err := shred(child, typ.Columns[k].Type, vals[k], rep, def); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shred adds the value zv of type typ to the leaves under n.  The
+// definition level of the parent of n is def.
+func shred(n *node, typ zng.Type, zv zcode.Bytes, rep, def int) error {
+	if zv == nil {
+		n.null(rep, def)
+		return nil
+	}
+	switch n.kind {
+	case kindGroup:
+		return shredRecord(n, zng.AliasedType(typ).(*zng.TypeRecord), zv, rep, n.maxDef)
+	case kindList:
+		list := n.children[0]
+		elem := list.children[0]
+		inner := zng.InnerType(zng.AliasedType(typ))
+		if len(zv) == 0 {
+			list.null(rep, n.maxDef)
+			return nil
+		}
+		r := rep
+		for it := zv.Iter(); !it.Done(); {
+			val, _, err := it.Next()
+			if err != nil {
+				return err
+			}
+			if err := shred(elem, inner, val, r, list.maxDef); err != nil {
+				return err
+			}
+			r = list.maxRep
+		}
+		return nil
+	}
+	c := n.column
+	c.reps = append(c.reps, rep)
+	c.defs = append(c.defs, n.maxDef)
+	return c.append(zng.AliasedType(typ), zv)
+}
+
+// null adds a null to each leaf under n where def is the definition level
+// of the nearest ancestor of n that is present.
+func (n *node) null(rep, def int) {
+	if n.kind == kindLeaf {
+		n.column.reps = append(n.column.reps, rep)
+		n.column.defs = append(n.column.defs, def)
+		return
+	}
+	if n.kind == kindGroup {
+		n.visit(def)
+	}
+	for _, child := range n.children {
+		child.null(rep, def)
+	}
+}
+
+// append adds the plain encoding of zv to the values of c.
+func (c *column) append(typ zng.Type, zv zcode.Bytes) error {
+	switch typ {
+	case zng.TypeBool:
+		b, err := zng.DecodeBool(zv)
+		c.bools = append(c.bools, b)
+		return err
+	case zng.TypeByte:
+		b, err := zng.DecodeByte(zv)
+		c.values = appendUint32(c.values, uint32(b))
+		return err
+	case zng.TypeInt16, zng.TypeInt32:
+		i, err := zng.DecodeInt(zv)
+		c.values = appendUint32(c.values, uint32(i))
+		return err
+	case zng.TypeUint16, zng.TypeUint32:
+		u, err := zng.DecodeUint(zv)
+		c.values = appendUint32(c.values, uint32(u))
+		return err
+	case zng.TypePort:
+		p, err := zng.DecodePort(zv)
+		c.values = appendUint32(c.values, p)
+		return err
+	case zng.TypeInt64:
+		i, err := zng.DecodeInt(zv)
+		c.values = appendUint64(c.values, uint64(i))
+		return err
+	case zng.TypeUint64:
+		u, err := zng.DecodeUint(zv)
+		c.values = appendUint64(c.values, u)
+		return err
+	case zng.TypeDuration:
+		d, err := zng.DecodeDuration(zv)
+		c.values = appendUint64(c.values, uint64(d))
+		return err
+	case zng.TypeTime:
+		ts, err := zng.DecodeTime(zv)
+		c.values = appendUint64(c.values, uint64(ts))
+		return err
+	case zng.TypeFloat64:
+		f, err := zng.DecodeFloat64(zv)
+		c.values = appendUint64(c.values, math.Float64bits(f))
+		return err
+	case zng.TypeIP:
+		ip, err := zng.DecodeIP(zv)
+		if err != nil {
+			return err
+		}
+		c.values = appendByteArray(c.values, []byte(ip.String()))
+	case zng.TypeNet:
+		net, err := zng.DecodeNet(zv)
+		if err != nil {
+			return err
+		}
+		c.values = appendByteArray(c.values, []byte(net.String()))
+	default:
+		c.values = appendByteArray(c.values, zv)
+	}
+	return nil
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func appendByteArray(b, v []byte) []byte {
+	b = appendUint32(b, uint32(len(v)))
+	return append(b, v...)
+}
+
+// appendLevels appends the levels, encoded as runs of the RLE/bit-packed
+// hybrid encoding and preceded by their length, to b.
+func appendLevels(b []byte, levels []int, width int) []byte {
+	var runs []byte
+	for len(levels) > 0 {
+		n := 1
+		for n < len(levels) && levels[n] == levels[0] {
+			n++
+		}
+		var buf [binary.MaxVarintLen64]byte
+		runs = append(runs, buf[:binary.PutUvarint(buf[:], uint64(n)<<1)]...)
+		for k := 0; k < (width+7)/8; k++ {
+			runs = append(runs, byte(levels[0]>>(8*k)))
+		}
+		levels = levels[n:]
+	}
+	b = appendUint32(b, uint32(len(runs)))
+	return append(b, runs...)
+}
+
+// encodePage returns the header and body of a data page holding the
+// values and levels of c.
+func encodePage(c *column) ([]byte, []byte) {
+	var body []byte
+	if c.repWidth > 0 {
+		body = appendLevels(body, c.reps, c.repWidth)
+	}
+	if c.defWidth > 0 {
+		body = appendLevels(body, c.defs, c.defWidth)
+	}
+	if c.bools != nil {
+		packed := make([]byte, (len(c.bools)+7)/8)
+		for k, b := range c.bools {
+			if b {
+				packed[k/8] |= 1 << (k % 8)
+			}
+		}
+		body = append(body, packed...)
+	} else {
+		body = append(body, c.values...)
+	}
+	t := newThriftWriter()
+	t.i32Field(1, pageData)
+	t.i32Field(2, int32(len(body)))
+	t.i32Field(3, int32(len(body)))
+	t.beginStruct(5)
+	t.i32Field(1, int32(len(c.defs)))
+	t.i32Field(2, encodingPlain)
+	t.i32Field(3, encodingRLE)
+	t.i32Field(4, encodingRLE)
+	t.endStruct()
+	return t.bytes(), body
+}
+
+// encodeFooter returns the file metadata of a file holding the row groups.
+func encodeFooter(root *node, groups []rowGroup, numRows int64) []byte {
+	t := newThriftWriter()
+	t.i32Field(1, 1)
+	t.listField(2, ctStruct, root.count())
+	root.encode(t, true)
+	t.i64Field(3, numRows)
+	t.listField(4, ctStruct, len(groups))
+	for _, group := range groups {
+		t.beginElem()
+		t.listField(1, ctStruct, len(group.chunks))
+		for _, ch := range group.chunks {
+			t.beginElem()
+			t.i64Field(2, ch.offset)
+			t.beginStruct(3)
+			t.i32Field(1, ch.physical)
+			t.listField(2, ctI32, 2)
+			t.i32(encodingPlain)
+			t.i32(encodingRLE)
+			t.listField(3, ctBinary, len(ch.column.path))
+			for _, name := range ch.column.path {
+				t.string(name)
+			}
+			t.i32Field(4, 0) // uncompressed
+			t.i64Field(5, ch.numValues)
+			t.i64Field(6, ch.size)
+			t.i64Field(7, ch.size)
+			t.i64Field(9, ch.offset)
+			t.endStruct()
+			t.endStruct()
+		}
+		t.i64Field(2, group.size)
+		t.i64Field(3, group.numRows)
+		t.endStruct()
+	}
+	t.stringField(6, "zq")
+	return t.bytes()
+}
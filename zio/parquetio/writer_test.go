@@ -0,0 +1,281 @@
+package parquetio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// thriftReader decodes a Thrift struct encoded with the compact protocol
+// into a map from field ID to value, where a struct is a map and a list is
+// a slice.
+type thriftReader struct {
+	buf []byte
+}
+
+func (t *thriftReader) uvarint() uint64 {
+	v, n := binary.Uvarint(t.buf)
+	t.buf = t.buf[n:]
+	return v
+}
+
+func (t *thriftReader) varint() int64 {
+	v := t.uvarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (t *thriftReader) value(typ byte) interface{} {
+	switch typ {
+	case ctBoolTrue:
+		return true
+	case ctBoolFalse:
+		return false
+	case ctByte:
+		b := t.buf[0]
+		t.buf = t.buf[1:]
+		return int64(int8(b))
+	case ctI32, ctI64:
+		return t.varint()
+	case ctBinary:
+		n := t.uvarint()
+		s := string(t.buf[:n])
+		t.buf = t.buf[n:]
+		return s
+	case ctList:
+		hdr := t.buf[0]
+		t.buf = t.buf[1:]
+		n := uint64(hdr >> 4)
+		if n == 15 {
+			n = t.uvarint()
+		}
+		var list []interface{}
+		for k := uint64(0); k < n; k++ {
+			elemType := hdr & 0xf
+			if elemType == ctBoolTrue {
+				// Booleans in lists are encoded as single bytes.
+				elemType = ctByte
+			}
+			list = append(list, t.value(elemType))
+		}
+		return list
+	case ctStruct:
+		s := make(map[int16]interface{})
+		var id int16
+		for {
+			hdr := t.buf[0]
+			t.buf = t.buf[1:]
+			if hdr == 0 {
+				return s
+			}
+			if delta := int16(hdr >> 4); delta != 0 {
+				id += delta
+			} else {
+				id = int16(t.varint())
+			}
+			s[id] = t.value(hdr & 0xf)
+		}
+	}
+	panic("unknown thrift type")
+}
+
+func field(v interface{}, ids ...int16) interface{} {
+	for _, id := range ids {
+		v = v.(map[int16]interface{})[id]
+	}
+	return v
+}
+
+func write(t *testing.T, input string) []byte {
+	r := zngio.NewReader(strings.NewReader(input), resolver.NewContext())
+	var out bytes.Buffer
+	w := NewWriter(&out)
+	require.NoError(t, zbuf.Copy(w, r))
+	return out.Bytes()
+}
+
+func footer(t *testing.T, b []byte) map[int16]interface{} {
+	require.True(t, len(b) > 12)
+	assert.Equal(t, magic, string(b[:4]))
+	assert.Equal(t, magic, string(b[len(b)-4:]))
+	n := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	r := &thriftReader{b[len(b)-8-n : len(b)-8]}
+	md := r.value(ctStruct).(map[int16]interface{})
+	assert.Len(t, r.buf, 0)
+	return md
+}
+
+var physicalNames = map[int64]string{
+	typeBoolean:   "BOOLEAN",
+	typeInt32:     "INT32",
+	typeInt64:     "INT64",
+	typeDouble:    "DOUBLE",
+	typeByteArray: "BYTE_ARRAY",
+}
+
+// schema returns the names, physical types, and repetitions of the schema
+// elements of md.
+func schema(md map[int16]interface{}) []string {
+	var elems []string
+	for _, e := range md[2].([]interface{}) {
+		s := e.(map[int16]interface{})[4].(string)
+		if typ, ok := e.(map[int16]interface{})[1]; ok {
+			s += ":" + physicalNames[typ.(int64)]
+		}
+		if rep, ok := e.(map[int16]interface{})[3]; ok {
+			s += ":" + []string{"REQUIRED", "OPTIONAL", "REPEATED"}[rep.(int64)]
+		}
+		elems = append(elems, s)
+	}
+	return elems
+}
+
+func TestParquetSchema(t *testing.T) {
+	const input = `
+#port=uint16
+#0:record[ts:time,id:record[orig_h:ip,orig_p:port],names:set[string],ok:bool,n:int64,f:float64,s:string]
+0:[1.5;[10.0.0.1;80;][a;b;]T;-1;2.5;hello;]
+0:[2;[10.0.0.2;-;]-;F;3;-;-;]
+`
+	b := write(t, input)
+	md := footer(t, b)
+	expected := []string{
+		"schema",
+		"ts:INT64:OPTIONAL",
+		"id:OPTIONAL",
+		"orig_h:BYTE_ARRAY:OPTIONAL",
+		"orig_p:INT32:OPTIONAL",
+		"names:OPTIONAL",
+		"list:REPEATED",
+		"element:BYTE_ARRAY:OPTIONAL",
+		"ok:BOOLEAN:OPTIONAL",
+		"n:INT64:OPTIONAL",
+		"f:DOUBLE:OPTIONAL",
+		"s:BYTE_ARRAY:OPTIONAL",
+	}
+	assert.Equal(t, expected, schema(md))
+	assert.EqualValues(t, 2, md[3])
+	assert.Len(t, md[4], 1)
+	// The timestamp is annotated as nanoseconds in UTC.
+	ts := md[2].([]interface{})[1]
+	assert.Equal(t, true, field(ts, 10, 8, 1))
+	assert.NotNil(t, field(ts, 10, 8, 2, 3))
+	// The port is an unsigned 16-bit integer.
+	port := md[2].([]interface{})[4]
+	assert.EqualValues(t, 16, field(port, 10, 10, 1))
+	assert.Equal(t, false, field(port, 10, 10, 2))
+	assert.EqualValues(t, convertedUint16, field(port, 6))
+	// Check the page of the set, which holds two elements in the first
+	// record and is unset in the second.
+	chunk := md[4].([]interface{})[0].(map[int16]interface{})[1].([]interface{})[3]
+	assert.Equal(t, []interface{}{"names", "list", "element"}, field(chunk, 3, 3))
+	r := &thriftReader{b[field(chunk, 3, 9).(int64):]}
+	hdr := r.value(ctStruct)
+	assert.EqualValues(t, 3, field(hdr, 5, 1))
+	levels := []byte{
+		6, 0, 0, 0, 1 << 1, 0, 1 << 1, 1, 1 << 1, 0, // repetition levels
+		4, 0, 0, 0, 2 << 1, 3, 1 << 1, 0, // definition levels
+	}
+	assert.Equal(t, levels, r.buf[:len(levels)])
+	assert.Equal(t, "\x01\x00\x00\x00a\x01\x00\x00\x00b", string(r.buf[len(levels):field(hdr, 2).(int64)]))
+}
+
+func TestParquetRowGroups(t *testing.T) {
+	const input = `
+#0:record[a:int64]
+0:[1;]
+0:[2;]
+#1:record[a:int64,b:string]
+1:[3;x;]
+0:[4;]
+`
+	b := write(t, input)
+	md := footer(t, b)
+	assert.Equal(t, []string{"schema", "a:INT64:OPTIONAL", "b:BYTE_ARRAY:OPTIONAL"}, schema(md))
+	assert.EqualValues(t, 4, md[3])
+	groups := md[4].([]interface{})
+	require.Len(t, groups, 3)
+	for k, rows := range []int64{2, 1, 1} {
+		assert.EqualValues(t, rows, field(groups[k], 3))
+	}
+	// Check the page of column b in the first row group, where it is null.
+	chunk := groups[0].(map[int16]interface{})[1].([]interface{})[1]
+	assert.Equal(t, []interface{}{"b"}, field(chunk, 3, 3))
+	assert.EqualValues(t, 2, field(chunk, 3, 5))
+	r := &thriftReader{b[field(chunk, 3, 9).(int64):]}
+	hdr := r.value(ctStruct)
+	assert.EqualValues(t, 2, field(hdr, 5, 1))
+	// The definition levels are a run of two zeros.
+	assert.Equal(t, []byte{2, 0, 0, 0, 2 << 1, 0}, r.buf[:field(hdr, 2).(int64)])
+	// Check the page of column a in the second row group.
+	chunk = groups[1].(map[int16]interface{})[1].([]interface{})[0]
+	r = &thriftReader{b[field(chunk, 3, 9).(int64):]}
+	hdr = r.value(ctStruct)
+	page := r.buf[:field(hdr, 2).(int64)]
+	assert.Equal(t, []byte{2, 0, 0, 0, 1 << 1, 1, 3, 0, 0, 0, 0, 0, 0, 0}, page)
+}
+
+func TestParquetConflict(t *testing.T) {
+	const input = `
+#0:record[a:int64]
+0:[1;]
+#1:record[a:string]
+1:[x;]
+`
+	r := zngio.NewReader(strings.NewReader(input), resolver.NewContext())
+	w := NewWriter(&bytes.Buffer{})
+	assert.EqualError(t, zbuf.Copy(w, r), "parquet: field a has conflicting types")
+}
+
+func TestParquetRowGroupSize(t *testing.T) {
+	const input = `
+#0:record[a:int64]
+0:[1;]
+0:[2;]
+0:[3;]
+`
+	r := zngio.NewReader(strings.NewReader(input), resolver.NewContext())
+	var out bytes.Buffer
+	w := NewWriter(&out)
+	// Each record is 2 bytes, so each row group holds two records.
+	w.rowGroupSize = 4
+	require.NoError(t, zbuf.Copy(w, r))
+	md := footer(t, out.Bytes())
+	assert.EqualValues(t, 3, md[3])
+	groups := md[4].([]interface{})
+	require.Len(t, groups, 2)
+	for k, rows := range []int64{2, 1} {
+		assert.EqualValues(t, rows, field(groups[k], 3))
+	}
+}
+
+func TestParquetNestedColumnAdded(t *testing.T) {
+	const input = `
+#0:record[id:record[a:int64]]
+0:[[1;]]
+0:[-;]
+#1:record[id:record[a:int64,b:int64]]
+1:[[2;3;]]
+`
+	b := write(t, input)
+	md := footer(t, b)
+	assert.Equal(t, []string{"schema", "id:OPTIONAL", "a:INT64:OPTIONAL", "b:INT64:OPTIONAL"}, schema(md))
+	groups := md[4].([]interface{})
+	require.Len(t, groups, 2)
+	// Column b of the first row group, which was written before b was
+	// seen, is null where id is present in the first record and where id
+	// is null in the second.
+	chunk := groups[0].(map[int16]interface{})[1].([]interface{})[1]
+	assert.Equal(t, []interface{}{"id", "b"}, field(chunk, 3, 3))
+	assert.EqualValues(t, 2, field(chunk, 3, 5))
+	r := &thriftReader{b[field(chunk, 3, 9).(int64):]}
+	hdr := r.value(ctStruct)
+	assert.EqualValues(t, 2, field(hdr, 5, 1))
+	assert.Equal(t, []byte{4, 0, 0, 0, 1 << 1, 1, 1 << 1, 0}, r.buf[:field(hdr, 2).(int64)])
+}
@@ -87,3 +87,9 @@ func (t *Table) Write(r *zng.Record) error {
 func (t *Table) Flush() error {
 	return t.table.Flush()
 }
+
+// FlushOutput writes the rows buffered to align the table's columns so
+// that they appear before the table is complete.
+func (t *Table) FlushOutput() error {
+	return t.table.Flush()
+}
@@ -29,10 +29,7 @@ func NewFlattener(zctx *resolver.Context) *Flattener {
 
 func recode(dst zcode.Bytes, typ *zng.TypeRecord, in zcode.Bytes) (zcode.Bytes, error) {
 	if in == nil {
-		for k := 0; k < len(typ.Columns); k++ {
-			dst = zcode.AppendPrimitive(dst, nil)
-		}
-		return dst, nil
+		return appendUnset(dst, typ), nil
 	}
 	it := in.Iter()
 	colno := 0
@@ -59,6 +56,21 @@ func recode(dst zcode.Bytes, typ *zng.TypeRecord, in zcode.Bytes) (zcode.Bytes,
 	return dst, nil
 }
 
+// appendUnset appends an unset value for each column that flattening
+// typ produces, i.e., one for each leaf of a nested record.
+func appendUnset(dst zcode.Bytes, typ *zng.TypeRecord) zcode.Bytes {
+	for _, col := range typ.Columns {
+		if childType, ok := col.Type.(*zng.TypeRecord); ok {
+			dst = appendUnset(dst, childType)
+		} else if zng.IsContainerType(zng.AliasedType(col.Type)) {
+			dst = zcode.AppendContainer(dst, nil)
+		} else {
+			dst = zcode.AppendPrimitive(dst, nil)
+		}
+	}
+	return dst
+}
+
 func (f *Flattener) Flatten(r *zng.Record) (*zng.Record, error) {
 	id := r.Type.ID()
 	flatType := f.mapper.Map(id)
@@ -82,13 +94,13 @@ func (f *Flattener) Flatten(r *zng.Record) (*zng.Record, error) {
 }
 
 // FlattenColumns turns nested records into a series of columns of
-// the form "outer.inner".  XXX It only works for one level of nesting.
+// the form "outer.inner".
 func FlattenColumns(cols []zng.Column) []zng.Column {
 	ret := make([]zng.Column, 0)
 	for _, c := range cols {
 		recType, isRecord := c.Type.(*zng.TypeRecord)
 		if isRecord {
-			for _, inner := range recType.Columns {
+			for _, inner := range FlattenColumns(recType.Columns) {
 				name := fmt.Sprintf("%s.%s", c.Name, inner.Name)
 				ret = append(ret, zng.NewColumn(name, inner.Type))
 			}
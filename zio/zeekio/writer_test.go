@@ -44,6 +44,16 @@ func TestWriter(t *testing.T) {
 		)
 		runcase(t, zng, zeek)
 	})
+	t.Run("unset-nested-record", func(t *testing.T) {
+		zng := `#1:record[a:string,id:record[h:ip,p:record[x:port,y:array[int64]]],b:string]
+1:[foo;-;bar;]`
+		zeek := zeekfile(
+			[]string{"a", "id.h", "id.p.x", "id.p.y", "b"},
+			[]string{"string", "addr", "port", "vector[int]", "string"},
+			[]string{"foo", "-", "-", "-", "bar"},
+		)
+		runcase(t, zng, zeek)
+	})
 }
 
 func runcase(t *testing.T, zng, expected string) {
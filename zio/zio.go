@@ -15,6 +15,8 @@ type Flags struct {
 	ShowFields       bool
 	EpochDates       bool
 	StreamRecordsMax int
+	BzngCompress     bool
+	Compression      string
 }
 
 func (f *Flags) SetFlags(fs *flag.FlagSet) {
@@ -23,6 +25,8 @@ func (f *Flags) SetFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&f.EpochDates, "E", false, "display epoch timestamps in text output")
 	fs.BoolVar(&f.UTF8, "U", false, "display zeek strings as UTF-8")
 	fs.IntVar(&f.StreamRecordsMax, "b", 0, "limit for number of records in each BZNG stream(0 for no limit)")
+	fs.BoolVar(&f.BzngCompress, "bc", false, "compress the messages of each BZNG stream")
+	fs.StringVar(&f.Compression, "z", "", "compress output files with gzip or zstd")
 }
 
 type Writer struct {
@@ -46,6 +50,29 @@ func (w *Writer) Close() error {
 	return err
 }
 
+// An OutputFlusher is a format writer that buffers output, e.g., to align
+// the columns of a table, and can write it out without completing the
+// format's output.
+type OutputFlusher interface {
+	FlushOutput() error
+}
+
+// FlushOutput flushes any output buffered by the format writer, if it is an
+// OutputFlusher, and then by the underlying io.WriteCloser.  Unlike Flush,
+// it does not otherwise flush the format writer, since flushing some
+// formats, e.g., parquet, completes the output.
+func (w *Writer) FlushOutput() error {
+	if f, ok := w.WriteFlusher.(OutputFlusher); ok {
+		if err := f.FlushOutput(); err != nil {
+			return err
+		}
+	}
+	if f, ok := w.Closer.(interface{ Flush() error }); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
 func Extension(format string) string {
 	switch format {
 	case "zng":
@@ -62,6 +89,12 @@ func Extension(format string) string {
 		return ".tbl"
 	case "bzng":
 		return ".bzng"
+	case "csv":
+		return ".csv"
+	case "tsv":
+		return ".tsv"
+	case "parquet":
+		return ".parquet"
 	default:
 		return ""
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio"
 	"github.com/brimsec/zq/zio/bzngio"
+	"github.com/brimsec/zq/zio/detector"
 	"github.com/brimsec/zq/zio/zjsonio"
 	"github.com/brimsec/zq/zio/zngio"
 	"github.com/brimsec/zq/zng/resolver"
@@ -36,12 +37,19 @@ func identity(t *testing.T, logs string) {
 	}
 }
 
-// Send logs to zng reader -> bzng writer -> bzng reader -> zng writer
+// Send logs to zng reader -> bzng writer -> bzng reader -> zng writer,
+// with and without compression.
 func boomerang(t *testing.T, logs string) {
+	boomerangWithFlags(t, logs, zio.Flags{})
+	boomerangWithFlags(t, logs, zio.Flags{BzngCompress: true})
+	boomerangWithFlags(t, logs, zio.Flags{BzngCompress: true, StreamRecordsMax: 2})
+}
+
+func boomerangWithFlags(t *testing.T, logs string, flags zio.Flags) {
 	in := []byte(strings.TrimSpace(logs) + "\n")
 	zngSrc := zngio.NewReader(bytes.NewReader(in), resolver.NewContext())
 	var rawzng Output
-	rawDst := zbuf.NopFlusher(bzngio.NewWriter(&rawzng, zio.Flags{}))
+	rawDst := bzngio.NewWriter(&rawzng, flags)
 	err := zbuf.Copy(rawDst, zngSrc)
 	require.NoError(t, err)
 
@@ -307,3 +315,19 @@ func TestEOS(t *testing.T) {
 	require.NotNil(t, rec)
 	assert.Equal(t, off, seekPoint)
 }
+
+func TestFlushOutput(t *testing.T) {
+	const zng = `#0:record[a:string]
+0:[hello;]
+`
+	for _, format := range []string{"table", "csv"} {
+		r := zngio.NewReader(strings.NewReader(zng), resolver.NewContext())
+		rec, err := r.Read()
+		require.NoError(t, err)
+		var out Output
+		w := detector.LookupWriter(format, &out, nil)
+		require.NoError(t, w.Write(rec))
+		require.NoError(t, w.FlushOutput())
+		assert.Contains(t, out.String(), "hello", format)
+	}
+}
@@ -20,7 +20,10 @@ func NewEncoder() *Encoder {
 	}
 }
 
+// Reset forgets the types encoded so far, as at the start of a stream,
+// whose type IDs are allocated anew.
 func (e *Encoder) Reset() {
+	e.zctx.Reset()
 	e.table = e.table[:0]
 	e.encoded = make(map[int]struct{})
 }
@@ -21,6 +21,12 @@ func (m *Mapper) Map(td int) *zng.TypeRecord {
 	return m.lookup(td)
 }
 
+// Reset forgets the mappings entered so far, as when the input side
+// begins a new stream that reuses its descriptor IDs.
+func (m *Mapper) Reset() {
+	m.table = m.table[:0]
+}
+
 //XXX Enter should allocate the td as it creates the new type in the output context
 func (m *Mapper) Enter(id int, ext *zng.TypeRecord) *zng.TypeRecord {
 	if typ := m.outputCtx.TranslateTypeRecord(ext); typ != nil {
@@ -116,12 +116,13 @@ const (
 )
 
 const (
-	TypeDefRecord = 0x80
-	TypeDefArray  = 0x81
-	TypeDefSet    = 0x82
-	TypeDefUnion  = 0x83
-	TypeDefAlias  = 0x84
-	CtrlEOS       = 0x85
+	TypeDefRecord  = 0x80
+	TypeDefArray   = 0x81
+	TypeDefSet     = 0x82
+	TypeDefUnion   = 0x83
+	TypeDefAlias   = 0x84
+	CtrlEOS        = 0x85
+	CtrlCompressed = 0xfe
 )
 
 func LookupPrimitive(name string) Type {
@@ -198,6 +199,8 @@ func LookupPrimitiveById(id int) Type {
 		return TypeTime
 	case IdDuration:
 		return TypeDuration
+	case IdNull:
+		return TypeNull
 	}
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zio/ndjsonio"
@@ -34,10 +35,61 @@ type TaskEnd struct {
 }
 
 type SearchRequest struct {
-	Space string          `json:"space" validate:"required"`
-	Proc  json.RawMessage `json:"proc" validate:"required"`
-	Span  nano.Span       `json:"span"`
-	Dir   int             `json:"dir" validate:"required"`
+	Space   string          `json:"space" validate:"required"`
+	Proc    json.RawMessage `json:"proc" validate:"required"`
+	Span    nano.Span       `json:"span"`
+	Dir     int             `json:"dir" validate:"required"`
+	Explain bool            `json:"explain,omitempty"`
+}
+
+// A SearchPlan describes the flowgraph compiled for a search.  It is
+// returned in place of the search results when SearchRequest.Explain is
+// set.  Each proc is listed after the procs it reads from, and Outputs
+// holds the ID of the proc whose output is sent on each channel.
+type SearchPlan struct {
+	Procs   []PlanProc `json:"procs"`
+	Outputs []int      `json:"outputs"`
+}
+
+// A PlanProc is a proc of a SearchPlan.  Proc describes it, for most procs
+// as the ZQL that it was compiled from, and Parents holds the IDs of the
+// procs it reads from.
+type PlanProc struct {
+	ID      int    `json:"id"`
+	Proc    string `json:"proc"`
+	Parents []int  `json:"parents,omitempty"`
+}
+
+// String returns the plan with one line per proc followed by a line per
+// output channel, e.g.,
+//
+//	0: scanner filter _path="conn"
+//	1: count() by id.orig_h -limit 1000000 <- 0
+//	output 0 <- 1
+func (p *SearchPlan) String() string {
+	var b strings.Builder
+	for _, proc := range p.Procs {
+		fmt.Fprintf(&b, "%d: %s", proc.ID, proc.Proc)
+		writeParents(&b, proc.Parents)
+		b.WriteByte('\n')
+	}
+	for ch, id := range p.Outputs {
+		fmt.Fprintf(&b, "output %d", ch)
+		writeParents(&b, []int{id})
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func writeParents(b *strings.Builder, parents []int) {
+	for k, id := range parents {
+		if k == 0 {
+			b.WriteString(" <- ")
+		} else {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.Itoa(id))
+	}
 }
 
 type SearchRecords struct {
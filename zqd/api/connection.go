@@ -195,6 +195,19 @@ func (c *Connection) Search(ctx context.Context, search SearchRequest) (Search,
 	return NewBzngSearch(r), nil
 }
 
+// SearchExplain returns the flowgraph that the search would run.
+func (c *Connection) SearchExplain(ctx context.Context, search SearchRequest) (*SearchPlan, error) {
+	search.Explain = true
+	resp, err := c.Request(ctx).
+		SetBody(search).
+		SetResult(&SearchPlan{}).
+		Post("/search")
+	if err != nil {
+		return nil, err
+	}
+	return resp.Result().(*SearchPlan), nil
+}
+
 func (c *Connection) PacketPost(ctx context.Context, space string, payload PacketPostRequest) (*Stream, error) {
 	req := c.Request(ctx).
 		SetBody(payload)
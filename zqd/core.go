@@ -15,11 +15,14 @@ type Config struct {
 	Root string
 	// ZeekLauncher is the interface for launching zeek processes.
 	ZeekLauncher zeek.Launcher
-	// SortLimit specifies the limit of logs in posted pcap to sort. Its
-	// existence is only as a hook for testing.  Eventually zqd will sort an
-	// unlimited amount of logs and this can be taken out.
+	// SortLimit specifies the number of logs in a posted pcap that are
+	// sorted in memory before sorted runs are spilled to disk. Its
+	// existence is only as a hook for testing.
 	SortLimit int
-	Logger    *zap.Logger
+	// Workers is the number of goroutines that decode and filter the
+	// bzng file of a space for each search.
+	Workers int
+	Logger  *zap.Logger
 }
 
 type VersionMessage struct {
@@ -33,10 +36,13 @@ var Version VersionMessage
 type Core struct {
 	Root         string
 	ZeekLauncher zeek.Launcher
-	// SortLimit specifies the limit of logs in posted pcap to sort. Its
-	// existence is only as a hook for testing.  Eventually zqd will sort an
-	// unlimited amount of logs and this can be taken out.
+	// SortLimit specifies the number of logs in a posted pcap that are
+	// sorted in memory before sorted runs are spilled to disk. Its
+	// existence is only as a hook for testing.
 	SortLimit int
+	// Workers is the number of goroutines that decode and filter the
+	// bzng file of a space for each search.
+	Workers   int
 	taskCount int64
 	logger    *zap.Logger
 
@@ -64,6 +70,7 @@ func NewCore(conf Config) *Core {
 		Root:         conf.Root,
 		ZeekLauncher: conf.ZeekLauncher,
 		SortLimit:    conf.SortLimit,
+		Workers:      conf.Workers,
 		logger:       logger,
 		spaceOps:     make(map[string]*spaceOpsState),
 	}
@@ -79,6 +79,16 @@ func handleSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if req.Explain {
+		plan, err := search.Explain(req, c.Workers)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		respond(c, w, r, http.StatusOK, plan)
+		return
+	}
+
 	ctx, cancel, err := c.startSpaceOp(r.Context(), s.Name())
 	if err != nil {
 		respondError(c, w, r, err)
@@ -86,7 +96,7 @@ func handleSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 	}
 	defer cancel()
 
-	srch, err := search.NewSearch(ctx, s, req)
+	srch, err := search.NewSearch(ctx, s, req, c.Workers)
 	if err != nil {
 		// XXX This always returns bad request but should return status codes
 		// that reflect the nature of the returned error.
@@ -45,6 +45,28 @@ func TestSearch(t *testing.T) {
 	require.Equal(t, test.Trim(src), res)
 }
 
+func TestSearchWorkers(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+0:[conn;1521911720.600725;C3Nj4A1Hal2Fp2GNp8;]
+`
+	matches := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`
+	c, client, done := newCore(t)
+	defer done()
+	c.Workers = 4
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	_ = postSpaceLogs(t, client, sp.Name, nil, false, src)
+	res := zngSearch(t, client, sp.Name, "uid=C8Tful1TvM3Zf5x8fl or uid=CBrzd94qfowOqJwCHa")
+	require.Equal(t, test.Trim(matches), res)
+}
+
 func TestGroupByReverse(t *testing.T) {
 	src := `
 #0:record[_path:string,ts:time,uid:bstring]
@@ -103,6 +125,39 @@ func TestSearchInvalidRequest(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSearchExplain(t *testing.T) {
+	c, client, done := newCore(t)
+	defer done()
+	c.Workers = 4
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+
+	parsed, err := zql.ParseProc("_path=conn | head 5")
+	require.NoError(t, err)
+	proc, err := json.Marshal(parsed)
+	require.NoError(t, err)
+	req := api.SearchRequest{
+		Space: sp.Name,
+		Proc:  proc,
+		Span:  nano.MaxSpan,
+		Dir:   -1,
+	}
+	plan, err := client.SearchExplain(context.Background(), req)
+	require.NoError(t, err)
+	expected := &api.SearchPlan{
+		Procs: []api.PlanProc{
+			{ID: 0, Proc: `scanner filter _path="conn" (4 workers)`},
+			{ID: 1, Proc: "head 5", Parents: []int{0}},
+		},
+		Outputs: []int{1},
+	}
+	require.Equal(t, expected, plan)
+
+	req.Dir = 0
+	_, err = client.SearchExplain(context.Background(), req)
+	require.Error(t, err)
+}
+
 func TestSpaceList(t *testing.T) {
 	ctx := context.Background()
 	c, client, done := newCore(t)
@@ -148,11 +148,20 @@ func TestPacketPostSortLimit(t *testing.T) {
 	ln := testZeekLauncher(nil, fn)
 	p := packetPostWithConfig(t, zqd.Config{SortLimit: 1, ZeekLauncher: ln}, "./testdata/valid.pcap")
 	defer p.cleanup()
-	t.Run("TaskEndError", func(t *testing.T) {
+	t.Run("TaskEndSuccess", func(t *testing.T) {
 		taskEnd := p.payloads[len(p.payloads)-1].(*api.TaskEnd)
 		assert.Equal(t, "TaskEnd", taskEnd.Type)
-		assert.NotNil(t, taskEnd.Error)
-		assert.Regexp(t, "sort limit", taskEnd.Error.Message)
+		assert.Nil(t, taskEnd.Error)
+	})
+	t.Run("DataReverseSorted", func(t *testing.T) {
+		expected := `
+#0:record[ts:time]
+0:[1501770880.988247;]
+0:[1501770877.501001;]
+0:[1501770877.471635;]
+0:[1501770877.471635;]`
+		res := zngSearch(t, p.client, p.space, "cut ts")
+		assert.Equal(t, test.Trim(expected), res)
 	})
 }
 
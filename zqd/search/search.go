@@ -30,7 +30,9 @@ type Search struct {
 	io.Closer
 }
 
-func NewSearch(ctx context.Context, s *space.Space, req api.SearchRequest) (*Search, error) {
+// NewSearch launches the search of req over the data of s, which is
+// decoded and filtered by the given number of workers.
+func NewSearch(ctx context.Context, s *space.Space, req api.SearchRequest, workers int) (*Search, error) {
 	if req.Span.Ts < 0 {
 		return nil, errors.New("time span must have non-negative timestamp")
 	}
@@ -46,7 +48,7 @@ func NewSearch(ctx context.Context, s *space.Space, req api.SearchRequest) (*Sea
 		return nil, err
 	}
 
-	zngReader, err := s.OpenZng(query.Span)
+	zngReader, err := s.OpenZng(query.Span, workers)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +62,20 @@ func NewSearch(ctx context.Context, s *space.Space, req api.SearchRequest) (*Sea
 	return &Search{mux, zngReader}, nil
 }
 
+// Explain returns the flowgraph that NewSearch would compile for req and
+// workers without running it.
+func Explain(req api.SearchRequest, workers int) (*api.SearchPlan, error) {
+	if req.Dir != 1 && req.Dir != -1 {
+		return nil, errors.New("time direction must be 1 or -1")
+	}
+	query, err := UnpackQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	reverse := query.Dir < 0
+	return driver.Explain(query.Proc, reverse, query.span(), workers)
+}
+
 func (s *Search) Run(output Output) error {
 	d := &searchdriver{
 		output:    output,
@@ -103,6 +119,14 @@ func UnpackQuery(req api.SearchRequest) (*Query, error) {
 	}, nil
 }
 
+// span returns the span of the query, where the zero span means all time.
+func (q *Query) span() nano.Span {
+	if q.Span == (nano.Span{}) {
+		return nano.MaxSpan
+	}
+	return q.Span
+}
+
 // searchdriver implements driver.Driver.
 type searchdriver struct {
 	output    Output
@@ -157,10 +181,6 @@ func (d *searchdriver) ChannelEnd(cid int, stats api.ScannerStats) error {
 }
 
 func launch(ctx context.Context, query *Query, reader zbuf.Reader, zctx *resolver.Context) (*driver.MuxOutput, error) {
-	span := query.Span
-	if span == (nano.Span{}) {
-		span = nano.MaxSpan
-	}
 	reverse := query.Dir < 0
-	return driver.Compile(context.Background(), query.Proc, reader, reverse, span, zap.NewNop())
+	return driver.Compile(context.Background(), query.Proc, reader, reverse, query.span(), zap.NewNop())
 }
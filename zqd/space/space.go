@@ -14,6 +14,7 @@ import (
 	"github.com/brimsec/zq/pcap/pcapio"
 	"github.com/brimsec/zq/pkg/fs"
 	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/scanner"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio/bzngio"
 	"github.com/brimsec/zq/zng/resolver"
@@ -193,10 +194,22 @@ func (s Space) DataPath(elem ...string) string {
 	return filepath.Join(append([]string{s.conf.DataPath}, elem...)...)
 }
 
-func (s Space) OpenZng(span nano.Span) (zbuf.ReadCloser, error) {
+// OpenZng returns a reader of the bzng file of the space.  If workers is
+// greater than one, the file is read by a scanner.Parallel with that many
+// workers.
+func (s Space) OpenZng(span nano.Span, workers int) (zbuf.ReadCloser, error) {
 	zctx := resolver.NewContext()
-
-	f, err := os.Open(s.DataPath(AllBzngFile))
+	path := s.DataPath(AllBzngFile)
+	if workers > 1 {
+		p, err := scanner.OpenParallel(zctx, path, workers)
+		if err == nil {
+			return p, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	f, err := os.Open(path)
 	if err != nil {
 		if !os.IsNotExist(err) {
 			return nil, err
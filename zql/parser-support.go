@@ -98,7 +98,17 @@ func makeCompareField(comparatorIn, fieldIn, valueIn interface{}) *ast.CompareFi
 	comparator := comparatorIn.(string)
 	field := fieldIn.(ast.FieldExpr)
 	value := valueIn.(*ast.Literal)
-	return &ast.CompareField{ast.Node{"CompareField"}, comparator, field, *value}
+	return &ast.CompareField{ast.Node{"CompareField"}, comparator, field, *value, nil}
+}
+
+// makeCompareBareword returns a comparison of a field with a bare word,
+// which is given as the field it may name and its text.
+func makeCompareBareword(comparatorIn, fieldIn, wordIn interface{}) *ast.CompareField {
+	word := wordIn.([]interface{})
+	value := makeLiteral("string", word[1])
+	compare := makeCompareField(comparatorIn, fieldIn, value)
+	compare.ValueField = word[0].(ast.FieldExpr)
+	return compare
 }
 
 func makeCompareAny(comparatorIn, recurseIn, valueIn interface{}) *ast.CompareAny {
@@ -108,6 +118,10 @@ func makeCompareAny(comparatorIn, recurseIn, valueIn interface{}) *ast.CompareAn
 	return &ast.CompareAny{ast.Node{"CompareAny"}, comparator, recurse, *value}
 }
 
+func makeEvaluate(exprIn interface{}) *ast.Evaluate {
+	return &ast.Evaluate{ast.Node{"Evaluate"}, exprIn.(ast.Expression)}
+}
+
 func makeLogicalNot(exprIn interface{}) *ast.LogicalNot {
 	return &ast.LogicalNot{ast.Node{"LogicalNot"}, exprIn.(ast.BooleanExpr)}
 }
@@ -194,7 +208,7 @@ func makeSortProc(argsIn, fieldsIn interface{}) (*ast.SortProc, error) {
 		nullsfirst = true
 	}
 	fields := fieldExprArray(fieldsIn)
-	return &ast.SortProc{ast.Node{"SortProc"}, limit, fields, sortdir, nullsfirst}, nil
+	return &ast.SortProc{ast.Node{"SortProc"}, limit, fields, sortdir, nullsfirst, 0}, nil
 }
 
 func makeTopProc(fieldsIn, limitIn, flushIn interface{}) *ast.TopProc {
@@ -212,6 +226,24 @@ func makeCutProc(fieldsIn interface{}) *ast.CutProc {
 	return &ast.CutProc{ast.Node{"CutProc"}, fields}
 }
 
+func makeDropProc(fieldsIn interface{}) *ast.DropProc {
+	fields := fieldExprArray(fieldsIn)
+	return &ast.DropProc{ast.Node{"DropProc"}, fields}
+}
+
+func makeFieldAssignment(targetIn, sourceIn interface{}) ast.FieldAssignment {
+	return ast.FieldAssignment{Target: targetIn.(ast.FieldExpr), Source: sourceIn.(ast.FieldExpr)}
+}
+
+func makeRenameProc(fieldsIn interface{}) *ast.RenameProc {
+	arr := fieldsIn.([]interface{})
+	fields := make([]ast.FieldAssignment, len(arr))
+	for i, f := range arr {
+		fields[i] = f.(ast.FieldAssignment)
+	}
+	return &ast.RenameProc{ast.Node{"RenameProc"}, fields}
+}
+
 func makeHeadProc(countIn interface{}) *ast.HeadProc {
 	count := countIn.(int)
 	return &ast.HeadProc{ast.Node{"HeadProc"}, count}
@@ -234,12 +266,50 @@ func makePutProc(target, expr interface{}) *ast.PutProc {
 	return &ast.PutProc{ast.Node{"PutProc"}, target.(string), expr.(ast.Expression)}
 }
 
+func makeExpressionAssignment(targetIn, exprIn interface{}) ast.ExpressionAssignment {
+	var target string
+	if targetIn != nil {
+		target = targetIn.(string)
+	}
+	return ast.ExpressionAssignment{Target: target, Expr: exprIn.(ast.Expression)}
+}
+
+func expressionAssignmentArray(assignmentsIn interface{}) []ast.ExpressionAssignment {
+	arr := assignmentsIn.([]interface{})
+	ret := make([]ast.ExpressionAssignment, len(arr))
+	for i, a := range arr {
+		ret[i] = a.(ast.ExpressionAssignment)
+	}
+	return ret
+}
+
+func makeJoinProc(kindIn, leftKeyIn, rightKeyIn, fieldsIn interface{}) *ast.JoinProc {
+	kind := "inner"
+	if kindIn != nil {
+		kind = kindIn.(string)
+	}
+	leftKey := leftKeyIn.(ast.FieldExpr)
+	rightKey := rightKeyIn.(ast.FieldExpr)
+	fields := fieldExprArray(fieldsIn)
+	return &ast.JoinProc{ast.Node{"JoinProc"}, kind, leftKey, rightKey, fields}
+}
+
 func makeReducer(opIn, varIn, fieldIn interface{}) *ast.Reducer {
-	var field ast.FieldExpr
-	if fieldIn != nil {
-		field = fieldIn.(ast.FieldExpr)
+	reducer := &ast.Reducer{Node: ast.Node{opIn.(string)}, Var: varIn.(string)}
+	switch arg := fieldIn.(type) {
+	case nil:
+	case *ast.FieldRead, *ast.FieldCall:
+		reducer.Field = arg
+	default:
+		reducer.Expr = arg.(ast.Expression)
 	}
-	return &ast.Reducer{ast.Node{opIn.(string)}, varIn.(string), field}
+	return reducer
+}
+
+func makeParamReducer(opIn, varIn, fieldIn, paramIn interface{}) *ast.Reducer {
+	reducer := makeReducer(opIn, varIn, fieldIn)
+	reducer.Param = paramIn.(float64)
+	return reducer
 }
 
 func overrideReducerVar(reducerIn, varIn interface{}) *ast.Reducer {
@@ -279,7 +349,7 @@ func makeGroupByProc(durationIn, limitIn, keysIn, reducersIn interface{}) *ast.G
 		limit = limitIn.(int)
 	}
 
-	keys := fieldExprArray(keysIn)
+	keys := expressionAssignmentArray(keysIn)
 	reducers := reducersArray(reducersIn)
 
 	return &ast.GroupByProc{
@@ -381,7 +451,7 @@ func parseInt(v interface{}) interface{} {
 
 func parseFloat(v interface{}) interface{} {
 	num := v.(string)
-	if f, err := strconv.ParseFloat(num, 10); err != nil {
+	if f, err := strconv.ParseFloat(num, 64); err == nil {
 		return f
 	}
 
@@ -38,10 +38,18 @@ function makeCompareField(comparator, field, value) {
   return { op: "CompareField", comparator, field, value };
 }
 
+function makeCompareBareword(comparator, field, word) {
+  let [value_field, text] = word;
+  let value = makeLiteral("string", text);
+  return { op: "CompareField", comparator, field, value, value_field };
+}
+
 function makeCompareAny(comparator, recursive, value) {
   return { op: "CompareAny", comparator, recursive, value };
 }
 
+function makeEvaluate(expr) { return { op: "Evaluate", expr }; }
+
 function makeLogicalNot(expr) { return { op: "LogicalNot", expr }; }
 
 function makeChain(first, rest, op) {
@@ -89,14 +97,36 @@ function makeTopProc(fields, limit, flush) {
 }
 
 function makeCutProc(fields) { return { op: "CutProc", fields }; }
+function makeDropProc(fields) { return { op: "DropProc", fields }; }
+function makeFieldAssignment(target, source) { return { target, source }; }
+function makeRenameProc(fields) { return { op: "RenameProc", fields }; }
 function makeHeadProc(count) { return { op: "HeadProc", count }; }
 function makeTailProc(count) { return { op: "TailProc", count }; }
 function makeUniqProc(cflag) { return { op: "TailProc", cflag }; }
 function makeFilterProc(filter) { return { op: "FilterProc", filter }; }
 function makePutProc(target, expression) { return { op: "PutProc", target, expression }; }
+function makeJoinProc(kind, left_key, right_key, fields) {
+  if (kind === null) { kind = "inner"; }
+  if (fields === null) { fields = undefined; }
+  return { op: "JoinProc", kind, left_key, right_key, fields };
+}
+function makeExpressionAssignment(target, expression) {
+  if (target === null) { target = undefined; }
+  return { target, expression };
+}
 function makeReducer(op, var_, field) {
-  if (field === null) { field = undefined; }
-  return { op, var: var_, field };
+  if (field === null) {
+    return { op, var: var_ };
+  }
+  if (field.op === "FieldRead" || field.op === "FieldCall") {
+    return { op, var: var_, field };
+  }
+  return { op, var: var_, expr: field };
+}
+function makeParamReducer(op, var_, field, param) {
+  let reducer = makeReducer(op, var_, field);
+  reducer.param = param;
+  return reducer;
 }
 function overrideReducerVar(reducer, v) {
   reducer.var = v;
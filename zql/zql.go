@@ -601,21 +601,68 @@ var g = &grammar{
 									label: "fieldComparator",
 									expr: &ruleRefExpr{
 										pos:  position{line: 74, col: 36, offset: 1773},
+										name: "RelativeOperator",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 74, col: 53, offset: 1790},
+									expr: &ruleRefExpr{
+										pos:  position{line: 74, col: 53, offset: 1790},
+										name: "_",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 74, col: 56, offset: 1793},
+									label: "v",
+									expr: &ruleRefExpr{
+										pos:  position{line: 74, col: 58, offset: 1795},
+										name: "barewordField",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 77, col: 5, offset: 1882},
+						run: (*parser).callonsearchPred36,
+						expr: &seqExpr{
+							pos: position{line: 77, col: 5, offset: 1882},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 77, col: 5, offset: 1882},
+									label: "f",
+									expr: &ruleRefExpr{
+										pos:  position{line: 77, col: 7, offset: 1884},
+										name: "fieldExpr",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 77, col: 17, offset: 1894},
+									expr: &ruleRefExpr{
+										pos:  position{line: 77, col: 17, offset: 1894},
+										name: "_",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 77, col: 20, offset: 1897},
+									label: "fieldComparator",
+									expr: &ruleRefExpr{
+										pos:  position{line: 77, col: 36, offset: 1913},
 										name: "equalityToken",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 74, col: 50, offset: 1787},
+									pos: position{line: 77, col: 50, offset: 1927},
 									expr: &ruleRefExpr{
-										pos:  position{line: 74, col: 50, offset: 1787},
+										pos:  position{line: 77, col: 50, offset: 1927},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 74, col: 53, offset: 1790},
+									pos:   position{line: 77, col: 53, offset: 1930},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 74, col: 55, offset: 1792},
+										pos:  position{line: 77, col: 55, offset: 1932},
 										name: "searchValue",
 									},
 								},
@@ -623,39 +670,115 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 77, col: 5, offset: 1874},
-						run: (*parser).callonsearchPred36,
+						pos: position{line: 80, col: 5, offset: 2014},
+						run: (*parser).callonsearchPred48,
+						expr: &seqExpr{
+							pos: position{line: 80, col: 5, offset: 2014},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 80, col: 5, offset: 2014},
+									label: "lhs",
+									expr: &ruleRefExpr{
+										pos:  position{line: 80, col: 9, offset: 2018},
+										name: "AdditiveExpression",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 80, col: 28, offset: 2037},
+									label: "rest",
+									expr: &seqExpr{
+										pos: position{line: 80, col: 34, offset: 2043},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 80, col: 34, offset: 2043},
+												name: "__",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 80, col: 37, offset: 2046},
+												name: "equalityToken",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 80, col: 51, offset: 2060},
+												name: "__",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 80, col: 54, offset: 2063},
+												name: "AdditiveExpression",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 83, col: 5, offset: 2173},
+						run: (*parser).callonsearchPred58,
+						expr: &seqExpr{
+							pos: position{line: 83, col: 5, offset: 2173},
+							exprs: []interface{}{
+								&andExpr{
+									pos: position{line: 83, col: 5, offset: 2173},
+									expr: &seqExpr{
+										pos: position{line: 83, col: 7, offset: 2175},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 83, col: 7, offset: 2175},
+												name: "FunctionName",
+											},
+											&litMatcher{
+												pos:        position{line: 83, col: 20, offset: 2188},
+												val:        "(",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 83, col: 25, offset: 2193},
+									label: "f",
+									expr: &ruleRefExpr{
+										pos:  position{line: 83, col: 27, offset: 2195},
+										name: "FunctionCall",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 86, col: 5, offset: 2254},
+						run: (*parser).callonsearchPred66,
 						expr: &seqExpr{
-							pos: position{line: 77, col: 5, offset: 1874},
+							pos: position{line: 86, col: 5, offset: 2254},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 77, col: 5, offset: 1874},
+									pos:   position{line: 86, col: 5, offset: 2254},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 77, col: 7, offset: 1876},
+										pos:  position{line: 86, col: 7, offset: 2256},
 										name: "searchValue",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 77, col: 19, offset: 1888},
+									pos: position{line: 86, col: 19, offset: 2268},
 									expr: &ruleRefExpr{
-										pos:  position{line: 77, col: 19, offset: 1888},
+										pos:  position{line: 86, col: 19, offset: 2268},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 77, col: 22, offset: 1891},
+									pos:  position{line: 86, col: 22, offset: 2271},
 									name: "inToken",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 77, col: 30, offset: 1899},
+									pos: position{line: 86, col: 30, offset: 2279},
 									expr: &ruleRefExpr{
-										pos:  position{line: 77, col: 30, offset: 1899},
+										pos:  position{line: 86, col: 30, offset: 2279},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 77, col: 33, offset: 1902},
+									pos:        position{line: 86, col: 33, offset: 2282},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -663,42 +786,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 80, col: 5, offset: 1967},
-						run: (*parser).callonsearchPred46,
+						pos: position{line: 89, col: 5, offset: 2347},
+						run: (*parser).callonsearchPred76,
 						expr: &seqExpr{
-							pos: position{line: 80, col: 5, offset: 1967},
+							pos: position{line: 89, col: 5, offset: 2347},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 80, col: 5, offset: 1967},
+									pos:   position{line: 89, col: 5, offset: 2347},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 80, col: 7, offset: 1969},
+										pos:  position{line: 89, col: 7, offset: 2349},
 										name: "searchValue",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 80, col: 19, offset: 1981},
+									pos: position{line: 89, col: 19, offset: 2361},
 									expr: &ruleRefExpr{
-										pos:  position{line: 80, col: 19, offset: 1981},
+										pos:  position{line: 89, col: 19, offset: 2361},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 80, col: 22, offset: 1984},
+									pos:  position{line: 89, col: 22, offset: 2364},
 									name: "inToken",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 80, col: 30, offset: 1992},
+									pos: position{line: 89, col: 30, offset: 2372},
 									expr: &ruleRefExpr{
-										pos:  position{line: 80, col: 30, offset: 1992},
+										pos:  position{line: 89, col: 30, offset: 2372},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 80, col: 33, offset: 1995},
+									pos:   position{line: 89, col: 33, offset: 2375},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 80, col: 35, offset: 1997},
+										pos:  position{line: 89, col: 35, offset: 2377},
 										name: "fieldReference",
 									},
 								},
@@ -706,13 +829,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 83, col: 5, offset: 2071},
-						run: (*parser).callonsearchPred57,
+						pos: position{line: 92, col: 5, offset: 2451},
+						run: (*parser).callonsearchPred87,
 						expr: &labeledExpr{
-							pos:   position{line: 83, col: 5, offset: 2071},
+							pos:   position{line: 92, col: 5, offset: 2451},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 83, col: 7, offset: 2073},
+								pos:  position{line: 92, col: 7, offset: 2453},
 								name: "searchValue",
 							},
 						},
@@ -722,52 +845,52 @@ var g = &grammar{
 		},
 		{
 			name: "searchValue",
-			pos:  position{line: 87, col: 1, offset: 2142},
+			pos:  position{line: 96, col: 1, offset: 2522},
 			expr: &choiceExpr{
-				pos: position{line: 88, col: 5, offset: 2158},
+				pos: position{line: 97, col: 5, offset: 2538},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 88, col: 5, offset: 2158},
+						pos:  position{line: 97, col: 5, offset: 2538},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 89, col: 5, offset: 2176},
+						pos:  position{line: 98, col: 5, offset: 2556},
 						name: "RegexpLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 90, col: 5, offset: 2194},
+						pos:  position{line: 99, col: 5, offset: 2574},
 						name: "PortLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 91, col: 5, offset: 2210},
+						pos:  position{line: 100, col: 5, offset: 2590},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 92, col: 5, offset: 2228},
+						pos:  position{line: 101, col: 5, offset: 2608},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 93, col: 5, offset: 2247},
+						pos:  position{line: 102, col: 5, offset: 2627},
 						name: "FloatLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 97, col: 5, offset: 2414},
+						pos: position{line: 106, col: 5, offset: 2794},
 						run: (*parser).callonsearchValue8,
 						expr: &seqExpr{
-							pos: position{line: 97, col: 5, offset: 2414},
+							pos: position{line: 106, col: 5, offset: 2794},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 97, col: 5, offset: 2414},
+									pos:   position{line: 106, col: 5, offset: 2794},
 									label: "i",
 									expr: &ruleRefExpr{
-										pos:  position{line: 97, col: 7, offset: 2416},
+										pos:  position{line: 106, col: 7, offset: 2796},
 										name: "IntegerLiteral",
 									},
 								},
 								&notExpr{
-									pos: position{line: 97, col: 22, offset: 2431},
+									pos: position{line: 106, col: 22, offset: 2811},
 									expr: &ruleRefExpr{
-										pos:  position{line: 97, col: 23, offset: 2432},
+										pos:  position{line: 106, col: 23, offset: 2812},
 										name: "searchWord",
 									},
 								},
@@ -775,32 +898,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 99, col: 5, offset: 2466},
+						pos: position{line: 108, col: 5, offset: 2846},
 						run: (*parser).callonsearchValue14,
 						expr: &seqExpr{
-							pos: position{line: 99, col: 5, offset: 2466},
+							pos: position{line: 108, col: 5, offset: 2846},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 99, col: 5, offset: 2466},
+									pos: position{line: 108, col: 5, offset: 2846},
 									expr: &seqExpr{
-										pos: position{line: 99, col: 7, offset: 2468},
+										pos: position{line: 108, col: 7, offset: 2848},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 99, col: 7, offset: 2468},
+												pos:  position{line: 108, col: 7, offset: 2848},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 99, col: 22, offset: 2483},
+												pos:  position{line: 108, col: 22, offset: 2863},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 99, col: 25, offset: 2486},
+									pos:   position{line: 108, col: 25, offset: 2866},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 99, col: 27, offset: 2488},
+										pos:  position{line: 108, col: 27, offset: 2868},
 										name: "BooleanLiteral",
 									},
 								},
@@ -808,32 +931,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 100, col: 5, offset: 2525},
+						pos: position{line: 109, col: 5, offset: 2905},
 						run: (*parser).callonsearchValue22,
 						expr: &seqExpr{
-							pos: position{line: 100, col: 5, offset: 2525},
+							pos: position{line: 109, col: 5, offset: 2905},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 100, col: 5, offset: 2525},
+									pos: position{line: 109, col: 5, offset: 2905},
 									expr: &seqExpr{
-										pos: position{line: 100, col: 7, offset: 2527},
+										pos: position{line: 109, col: 7, offset: 2907},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 100, col: 7, offset: 2527},
+												pos:  position{line: 109, col: 7, offset: 2907},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 100, col: 22, offset: 2542},
+												pos:  position{line: 109, col: 22, offset: 2922},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 100, col: 25, offset: 2545},
+									pos:   position{line: 109, col: 25, offset: 2925},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 100, col: 27, offset: 2547},
+										pos:  position{line: 109, col: 27, offset: 2927},
 										name: "NullLiteral",
 									},
 								},
@@ -841,32 +964,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 101, col: 5, offset: 2581},
+						pos: position{line: 110, col: 5, offset: 2961},
 						run: (*parser).callonsearchValue30,
 						expr: &seqExpr{
-							pos: position{line: 101, col: 5, offset: 2581},
+							pos: position{line: 110, col: 5, offset: 2961},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 101, col: 5, offset: 2581},
+									pos: position{line: 110, col: 5, offset: 2961},
 									expr: &seqExpr{
-										pos: position{line: 101, col: 7, offset: 2583},
+										pos: position{line: 110, col: 7, offset: 2963},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 101, col: 8, offset: 2584},
+												pos:  position{line: 110, col: 8, offset: 2964},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 101, col: 24, offset: 2600},
+												pos:  position{line: 110, col: 24, offset: 2980},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 101, col: 27, offset: 2603},
+									pos:   position{line: 110, col: 27, offset: 2983},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 101, col: 29, offset: 2605},
+										pos:  position{line: 110, col: 29, offset: 2985},
 										name: "searchWord",
 									},
 								},
@@ -876,17 +999,73 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "barewordField",
+			pos:  position{line: 120, col: 1, offset: 3317},
+			expr: &actionExpr{
+				pos: position{line: 121, col: 5, offset: 3335},
+				run: (*parser).callonbarewordField1,
+				expr: &seqExpr{
+					pos: position{line: 121, col: 5, offset: 3335},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 121, col: 5, offset: 3335},
+							expr: &seqExpr{
+								pos: position{line: 121, col: 7, offset: 3337},
+								exprs: []interface{}{
+									&choiceExpr{
+										pos: position{line: 121, col: 8, offset: 3338},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 121, col: 8, offset: 3338},
+												name: "BooleanLiteral",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 121, col: 25, offset: 3355},
+												name: "NullLiteral",
+											},
+										},
+									},
+									&notExpr{
+										pos: position{line: 121, col: 38, offset: 3368},
+										expr: &ruleRefExpr{
+											pos:  position{line: 121, col: 39, offset: 3369},
+											name: "searchWordPart",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 121, col: 55, offset: 3385},
+							label: "f",
+							expr: &ruleRefExpr{
+								pos:  position{line: 121, col: 57, offset: 3387},
+								name: "fieldReference",
+							},
+						},
+						&notExpr{
+							pos: position{line: 121, col: 72, offset: 3402},
+							expr: &ruleRefExpr{
+								pos:  position{line: 121, col: 73, offset: 3403},
+								name: "searchWordPart",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 109, col: 1, offset: 2804},
+			pos:  position{line: 125, col: 1, offset: 3478},
 			expr: &actionExpr{
-				pos: position{line: 110, col: 5, offset: 2822},
+				pos: position{line: 126, col: 5, offset: 3496},
 				run: (*parser).callonStringLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 110, col: 5, offset: 2822},
+					pos:   position{line: 126, col: 5, offset: 3496},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 110, col: 7, offset: 2824},
+						pos:  position{line: 126, col: 7, offset: 3498},
 						name: "quotedString",
 					},
 				},
@@ -894,15 +1073,15 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpLiteral",
-			pos:  position{line: 114, col: 1, offset: 2889},
+			pos:  position{line: 130, col: 1, offset: 3563},
 			expr: &actionExpr{
-				pos: position{line: 115, col: 5, offset: 2907},
+				pos: position{line: 131, col: 5, offset: 3581},
 				run: (*parser).callonRegexpLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 115, col: 5, offset: 2907},
+					pos:   position{line: 131, col: 5, offset: 3581},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 115, col: 7, offset: 2909},
+						pos:  position{line: 131, col: 7, offset: 3583},
 						name: "reString",
 					},
 				},
@@ -910,15 +1089,15 @@ var g = &grammar{
 		},
 		{
 			name: "PortLiteral",
-			pos:  position{line: 119, col: 1, offset: 2970},
+			pos:  position{line: 135, col: 1, offset: 3644},
 			expr: &actionExpr{
-				pos: position{line: 120, col: 5, offset: 2986},
+				pos: position{line: 136, col: 5, offset: 3660},
 				run: (*parser).callonPortLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 120, col: 5, offset: 2986},
+					pos:   position{line: 136, col: 5, offset: 3660},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 120, col: 7, offset: 2988},
+						pos:  position{line: 136, col: 7, offset: 3662},
 						name: "port",
 					},
 				},
@@ -926,30 +1105,30 @@ var g = &grammar{
 		},
 		{
 			name: "SubnetLiteral",
-			pos:  position{line: 124, col: 1, offset: 3043},
+			pos:  position{line: 140, col: 1, offset: 3717},
 			expr: &choiceExpr{
-				pos: position{line: 125, col: 5, offset: 3061},
+				pos: position{line: 141, col: 5, offset: 3735},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 125, col: 5, offset: 3061},
+						pos: position{line: 141, col: 5, offset: 3735},
 						run: (*parser).callonSubnetLiteral2,
 						expr: &labeledExpr{
-							pos:   position{line: 125, col: 5, offset: 3061},
+							pos:   position{line: 141, col: 5, offset: 3735},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 125, col: 7, offset: 3063},
+								pos:  position{line: 141, col: 7, offset: 3737},
 								name: "ip6subnet",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 128, col: 5, offset: 3125},
+						pos: position{line: 144, col: 5, offset: 3799},
 						run: (*parser).callonSubnetLiteral5,
 						expr: &labeledExpr{
-							pos:   position{line: 128, col: 5, offset: 3125},
+							pos:   position{line: 144, col: 5, offset: 3799},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 128, col: 7, offset: 3127},
+								pos:  position{line: 144, col: 7, offset: 3801},
 								name: "subnet",
 							},
 						},
@@ -959,30 +1138,30 @@ var g = &grammar{
 		},
 		{
 			name: "AddressLiteral",
-			pos:  position{line: 132, col: 1, offset: 3183},
+			pos:  position{line: 148, col: 1, offset: 3857},
 			expr: &choiceExpr{
-				pos: position{line: 133, col: 5, offset: 3202},
+				pos: position{line: 149, col: 5, offset: 3876},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 133, col: 5, offset: 3202},
+						pos: position{line: 149, col: 5, offset: 3876},
 						run: (*parser).callonAddressLiteral2,
 						expr: &labeledExpr{
-							pos:   position{line: 133, col: 5, offset: 3202},
+							pos:   position{line: 149, col: 5, offset: 3876},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 133, col: 7, offset: 3204},
+								pos:  position{line: 149, col: 7, offset: 3878},
 								name: "ip6addr",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 136, col: 5, offset: 3263},
+						pos: position{line: 152, col: 5, offset: 3937},
 						run: (*parser).callonAddressLiteral5,
 						expr: &labeledExpr{
-							pos:   position{line: 136, col: 5, offset: 3263},
+							pos:   position{line: 152, col: 5, offset: 3937},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 136, col: 7, offset: 3265},
+								pos:  position{line: 152, col: 7, offset: 3939},
 								name: "addr",
 							},
 						},
@@ -992,15 +1171,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 140, col: 1, offset: 3318},
+			pos:  position{line: 156, col: 1, offset: 3992},
 			expr: &actionExpr{
-				pos: position{line: 141, col: 5, offset: 3335},
+				pos: position{line: 157, col: 5, offset: 4009},
 				run: (*parser).callonFloatLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 141, col: 5, offset: 3335},
+					pos:   position{line: 157, col: 5, offset: 4009},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 141, col: 7, offset: 3337},
+						pos:  position{line: 157, col: 7, offset: 4011},
 						name: "sdouble",
 					},
 				},
@@ -1008,15 +1187,15 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 145, col: 1, offset: 3398},
+			pos:  position{line: 161, col: 1, offset: 4072},
 			expr: &actionExpr{
-				pos: position{line: 146, col: 5, offset: 3417},
+				pos: position{line: 162, col: 5, offset: 4091},
 				run: (*parser).callonIntegerLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 146, col: 5, offset: 3417},
+					pos:   position{line: 162, col: 5, offset: 4091},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 146, col: 7, offset: 3419},
+						pos:  position{line: 162, col: 7, offset: 4093},
 						name: "sinteger",
 					},
 				},
@@ -1024,24 +1203,24 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 150, col: 1, offset: 3479},
+			pos:  position{line: 166, col: 1, offset: 4153},
 			expr: &choiceExpr{
-				pos: position{line: 151, col: 5, offset: 3498},
+				pos: position{line: 167, col: 5, offset: 4172},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 151, col: 5, offset: 3498},
+						pos: position{line: 167, col: 5, offset: 4172},
 						run: (*parser).callonBooleanLiteral2,
 						expr: &litMatcher{
-							pos:        position{line: 151, col: 5, offset: 3498},
+							pos:        position{line: 167, col: 5, offset: 4172},
 							val:        "true",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 152, col: 5, offset: 3563},
+						pos: position{line: 168, col: 5, offset: 4237},
 						run: (*parser).callonBooleanLiteral4,
 						expr: &litMatcher{
-							pos:        position{line: 152, col: 5, offset: 3563},
+							pos:        position{line: 168, col: 5, offset: 4237},
 							val:        "false",
 							ignoreCase: false,
 						},
@@ -1051,12 +1230,12 @@ var g = &grammar{
 		},
 		{
 			name: "NullLiteral",
-			pos:  position{line: 154, col: 1, offset: 3626},
+			pos:  position{line: 170, col: 1, offset: 4300},
 			expr: &actionExpr{
-				pos: position{line: 155, col: 5, offset: 3642},
+				pos: position{line: 171, col: 5, offset: 4316},
 				run: (*parser).callonNullLiteral1,
 				expr: &litMatcher{
-					pos:        position{line: 155, col: 5, offset: 3642},
+					pos:        position{line: 171, col: 5, offset: 4316},
 					val:        "null",
 					ignoreCase: false,
 				},
@@ -1064,20 +1243,20 @@ var g = &grammar{
 		},
 		{
 			name: "searchKeywords",
-			pos:  position{line: 157, col: 1, offset: 3700},
+			pos:  position{line: 173, col: 1, offset: 4374},
 			expr: &choiceExpr{
-				pos: position{line: 158, col: 5, offset: 3719},
+				pos: position{line: 174, col: 5, offset: 4393},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 158, col: 5, offset: 3719},
+						pos:  position{line: 174, col: 5, offset: 4393},
 						name: "andToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 159, col: 5, offset: 3732},
+						pos:  position{line: 175, col: 5, offset: 4406},
 						name: "orToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 160, col: 5, offset: 3744},
+						pos:  position{line: 176, col: 5, offset: 4418},
 						name: "inToken",
 					},
 				},
@@ -1085,28 +1264,28 @@ var g = &grammar{
 		},
 		{
 			name: "procList",
-			pos:  position{line: 162, col: 1, offset: 3753},
+			pos:  position{line: 178, col: 1, offset: 4427},
 			expr: &actionExpr{
-				pos: position{line: 163, col: 5, offset: 3766},
+				pos: position{line: 179, col: 5, offset: 4440},
 				run: (*parser).callonprocList1,
 				expr: &seqExpr{
-					pos: position{line: 163, col: 5, offset: 3766},
+					pos: position{line: 179, col: 5, offset: 4440},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 163, col: 5, offset: 3766},
+							pos:   position{line: 179, col: 5, offset: 4440},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 163, col: 11, offset: 3772},
+								pos:  position{line: 179, col: 11, offset: 4446},
 								name: "procChain",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 163, col: 21, offset: 3782},
+							pos:   position{line: 179, col: 21, offset: 4456},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 163, col: 26, offset: 3787},
+								pos: position{line: 179, col: 26, offset: 4461},
 								expr: &ruleRefExpr{
-									pos:  position{line: 163, col: 26, offset: 3787},
+									pos:  position{line: 179, col: 26, offset: 4461},
 									name: "parallelChain",
 								},
 							},
@@ -1117,37 +1296,37 @@ var g = &grammar{
 		},
 		{
 			name: "parallelChain",
-			pos:  position{line: 172, col: 1, offset: 4011},
+			pos:  position{line: 188, col: 1, offset: 4685},
 			expr: &actionExpr{
-				pos: position{line: 173, col: 5, offset: 4029},
+				pos: position{line: 189, col: 5, offset: 4703},
 				run: (*parser).callonparallelChain1,
 				expr: &seqExpr{
-					pos: position{line: 173, col: 5, offset: 4029},
+					pos: position{line: 189, col: 5, offset: 4703},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 173, col: 5, offset: 4029},
+							pos: position{line: 189, col: 5, offset: 4703},
 							expr: &ruleRefExpr{
-								pos:  position{line: 173, col: 5, offset: 4029},
+								pos:  position{line: 189, col: 5, offset: 4703},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 173, col: 8, offset: 4032},
+							pos:        position{line: 189, col: 8, offset: 4706},
 							val:        ";",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 173, col: 12, offset: 4036},
+							pos: position{line: 189, col: 12, offset: 4710},
 							expr: &ruleRefExpr{
-								pos:  position{line: 173, col: 12, offset: 4036},
+								pos:  position{line: 189, col: 12, offset: 4710},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 173, col: 15, offset: 4039},
+							pos:   position{line: 189, col: 15, offset: 4713},
 							label: "ch",
 							expr: &ruleRefExpr{
-								pos:  position{line: 173, col: 18, offset: 4042},
+								pos:  position{line: 189, col: 18, offset: 4716},
 								name: "procChain",
 							},
 						},
@@ -1157,53 +1336,53 @@ var g = &grammar{
 		},
 		{
 			name: "proc",
-			pos:  position{line: 175, col: 1, offset: 4092},
+			pos:  position{line: 191, col: 1, offset: 4766},
 			expr: &choiceExpr{
-				pos: position{line: 176, col: 5, offset: 4101},
+				pos: position{line: 192, col: 5, offset: 4775},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 176, col: 5, offset: 4101},
+						pos:  position{line: 192, col: 5, offset: 4775},
 						name: "simpleProc",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 177, col: 5, offset: 4116},
+						pos:  position{line: 193, col: 5, offset: 4790},
 						name: "reducerProc",
 					},
 					&actionExpr{
-						pos: position{line: 178, col: 5, offset: 4132},
+						pos: position{line: 194, col: 5, offset: 4806},
 						run: (*parser).callonproc4,
 						expr: &seqExpr{
-							pos: position{line: 178, col: 5, offset: 4132},
+							pos: position{line: 194, col: 5, offset: 4806},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 178, col: 5, offset: 4132},
+									pos:        position{line: 194, col: 5, offset: 4806},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 178, col: 9, offset: 4136},
+									pos: position{line: 194, col: 9, offset: 4810},
 									expr: &ruleRefExpr{
-										pos:  position{line: 178, col: 9, offset: 4136},
+										pos:  position{line: 194, col: 9, offset: 4810},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 178, col: 12, offset: 4139},
+									pos:   position{line: 194, col: 12, offset: 4813},
 									label: "proc",
 									expr: &ruleRefExpr{
-										pos:  position{line: 178, col: 17, offset: 4144},
+										pos:  position{line: 194, col: 17, offset: 4818},
 										name: "procList",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 178, col: 26, offset: 4153},
+									pos: position{line: 194, col: 26, offset: 4827},
 									expr: &ruleRefExpr{
-										pos:  position{line: 178, col: 26, offset: 4153},
+										pos:  position{line: 194, col: 26, offset: 4827},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 178, col: 29, offset: 4156},
+									pos:        position{line: 194, col: 29, offset: 4830},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1215,28 +1394,151 @@ var g = &grammar{
 		},
 		{
 			name: "groupBy",
-			pos:  position{line: 182, col: 1, offset: 4192},
+			pos:  position{line: 198, col: 1, offset: 4866},
 			expr: &actionExpr{
-				pos: position{line: 183, col: 5, offset: 4204},
+				pos: position{line: 199, col: 5, offset: 4878},
 				run: (*parser).callongroupBy1,
 				expr: &seqExpr{
-					pos: position{line: 183, col: 5, offset: 4204},
+					pos: position{line: 199, col: 5, offset: 4878},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 183, col: 5, offset: 4204},
+							pos:        position{line: 199, col: 5, offset: 4878},
 							val:        "by",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 183, col: 11, offset: 4210},
+							pos:  position{line: 199, col: 11, offset: 4884},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 183, col: 13, offset: 4212},
+							pos:   position{line: 199, col: 13, offset: 4886},
 							label: "list",
 							expr: &ruleRefExpr{
-								pos:  position{line: 183, col: 18, offset: 4217},
-								name: "fieldExprList",
+								pos:  position{line: 199, col: 18, offset: 4891},
+								name: "groupByKeyList",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "groupByKey",
+			pos:  position{line: 201, col: 1, offset: 4928},
+			expr: &choiceExpr{
+				pos: position{line: 202, col: 5, offset: 4943},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 202, col: 5, offset: 4943},
+						run: (*parser).callongroupByKey2,
+						expr: &seqExpr{
+							pos: position{line: 202, col: 5, offset: 4943},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 202, col: 5, offset: 4943},
+									label: "target",
+									expr: &ruleRefExpr{
+										pos:  position{line: 202, col: 12, offset: 4950},
+										name: "fieldName",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 202, col: 22, offset: 4960},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 202, col: 25, offset: 4963},
+									val:        "=",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 202, col: 29, offset: 4967},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 202, col: 32, offset: 4970},
+									label: "expr",
+									expr: &ruleRefExpr{
+										pos:  position{line: 202, col: 37, offset: 4975},
+										name: "Expression",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 205, col: 5, offset: 5055},
+						run: (*parser).callongroupByKey11,
+						expr: &labeledExpr{
+							pos:   position{line: 205, col: 5, offset: 5055},
+							label: "field",
+							expr: &ruleRefExpr{
+								pos:  position{line: 205, col: 11, offset: 5061},
+								name: "fieldExpr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "groupByKeyList",
+			pos:  position{line: 207, col: 1, offset: 5125},
+			expr: &actionExpr{
+				pos: position{line: 208, col: 5, offset: 5144},
+				run: (*parser).callongroupByKeyList1,
+				expr: &seqExpr{
+					pos: position{line: 208, col: 5, offset: 5144},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 208, col: 5, offset: 5144},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 208, col: 11, offset: 5150},
+								name: "groupByKey",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 208, col: 22, offset: 5161},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 208, col: 27, offset: 5166},
+								expr: &actionExpr{
+									pos: position{line: 208, col: 28, offset: 5167},
+									run: (*parser).callongroupByKeyList7,
+									expr: &seqExpr{
+										pos: position{line: 208, col: 28, offset: 5167},
+										exprs: []interface{}{
+											&zeroOrOneExpr{
+												pos: position{line: 208, col: 28, offset: 5167},
+												expr: &ruleRefExpr{
+													pos:  position{line: 208, col: 28, offset: 5167},
+													name: "_",
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 208, col: 31, offset: 5170},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 208, col: 35, offset: 5174},
+												expr: &ruleRefExpr{
+													pos:  position{line: 208, col: 35, offset: 5174},
+													name: "_",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 208, col: 38, offset: 5177},
+												label: "key",
+												expr: &ruleRefExpr{
+													pos:  position{line: 208, col: 42, offset: 5181},
+													name: "groupByKey",
+												},
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -1245,27 +1547,27 @@ var g = &grammar{
 		},
 		{
 			name: "everyDur",
-			pos:  position{line: 185, col: 1, offset: 4253},
+			pos:  position{line: 212, col: 1, offset: 5296},
 			expr: &actionExpr{
-				pos: position{line: 186, col: 5, offset: 4266},
+				pos: position{line: 213, col: 5, offset: 5309},
 				run: (*parser).calloneveryDur1,
 				expr: &seqExpr{
-					pos: position{line: 186, col: 5, offset: 4266},
+					pos: position{line: 213, col: 5, offset: 5309},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 186, col: 5, offset: 4266},
+							pos:        position{line: 213, col: 5, offset: 5309},
 							val:        "every",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 186, col: 14, offset: 4275},
+							pos:  position{line: 213, col: 14, offset: 5318},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 186, col: 16, offset: 4277},
+							pos:   position{line: 213, col: 16, offset: 5320},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 186, col: 20, offset: 4281},
+								pos:  position{line: 213, col: 20, offset: 5324},
 								name: "duration",
 							},
 						},
@@ -1275,16 +1577,16 @@ var g = &grammar{
 		},
 		{
 			name: "equalityToken",
-			pos:  position{line: 188, col: 1, offset: 4311},
+			pos:  position{line: 215, col: 1, offset: 5354},
 			expr: &choiceExpr{
-				pos: position{line: 189, col: 5, offset: 4329},
+				pos: position{line: 216, col: 5, offset: 5372},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 189, col: 5, offset: 4329},
+						pos:  position{line: 216, col: 5, offset: 5372},
 						name: "EqualityOperator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 189, col: 24, offset: 4348},
+						pos:  position{line: 216, col: 24, offset: 5391},
 						name: "RelativeOperator",
 					},
 				},
@@ -1292,12 +1594,12 @@ var g = &grammar{
 		},
 		{
 			name: "andToken",
-			pos:  position{line: 191, col: 1, offset: 4366},
+			pos:  position{line: 218, col: 1, offset: 5409},
 			expr: &actionExpr{
-				pos: position{line: 191, col: 12, offset: 4377},
+				pos: position{line: 218, col: 12, offset: 5420},
 				run: (*parser).callonandToken1,
 				expr: &litMatcher{
-					pos:        position{line: 191, col: 12, offset: 4377},
+					pos:        position{line: 218, col: 12, offset: 5420},
 					val:        "and",
 					ignoreCase: true,
 				},
@@ -1305,12 +1607,12 @@ var g = &grammar{
 		},
 		{
 			name: "orToken",
-			pos:  position{line: 192, col: 1, offset: 4415},
+			pos:  position{line: 219, col: 1, offset: 5458},
 			expr: &actionExpr{
-				pos: position{line: 192, col: 11, offset: 4425},
+				pos: position{line: 219, col: 11, offset: 5468},
 				run: (*parser).callonorToken1,
 				expr: &litMatcher{
-					pos:        position{line: 192, col: 11, offset: 4425},
+					pos:        position{line: 219, col: 11, offset: 5468},
 					val:        "or",
 					ignoreCase: true,
 				},
@@ -1318,12 +1620,12 @@ var g = &grammar{
 		},
 		{
 			name: "inToken",
-			pos:  position{line: 193, col: 1, offset: 4462},
+			pos:  position{line: 220, col: 1, offset: 5505},
 			expr: &actionExpr{
-				pos: position{line: 193, col: 11, offset: 4472},
+				pos: position{line: 220, col: 11, offset: 5515},
 				run: (*parser).calloninToken1,
 				expr: &litMatcher{
-					pos:        position{line: 193, col: 11, offset: 4472},
+					pos:        position{line: 220, col: 11, offset: 5515},
 					val:        "in",
 					ignoreCase: true,
 				},
@@ -1331,12 +1633,12 @@ var g = &grammar{
 		},
 		{
 			name: "notToken",
-			pos:  position{line: 194, col: 1, offset: 4509},
+			pos:  position{line: 221, col: 1, offset: 5552},
 			expr: &actionExpr{
-				pos: position{line: 194, col: 12, offset: 4520},
+				pos: position{line: 221, col: 12, offset: 5563},
 				run: (*parser).callonnotToken1,
 				expr: &litMatcher{
-					pos:        position{line: 194, col: 12, offset: 4520},
+					pos:        position{line: 221, col: 12, offset: 5563},
 					val:        "not",
 					ignoreCase: true,
 				},
@@ -1344,21 +1646,21 @@ var g = &grammar{
 		},
 		{
 			name: "fieldName",
-			pos:  position{line: 196, col: 1, offset: 4559},
+			pos:  position{line: 223, col: 1, offset: 5602},
 			expr: &actionExpr{
-				pos: position{line: 196, col: 13, offset: 4571},
+				pos: position{line: 223, col: 13, offset: 5614},
 				run: (*parser).callonfieldName1,
 				expr: &seqExpr{
-					pos: position{line: 196, col: 13, offset: 4571},
+					pos: position{line: 223, col: 13, offset: 5614},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 196, col: 13, offset: 4571},
+							pos:  position{line: 223, col: 13, offset: 5614},
 							name: "fieldNameStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 196, col: 28, offset: 4586},
+							pos: position{line: 223, col: 28, offset: 5629},
 							expr: &ruleRefExpr{
-								pos:  position{line: 196, col: 28, offset: 4586},
+								pos:  position{line: 223, col: 28, offset: 5629},
 								name: "fieldNameRest",
 							},
 						},
@@ -1368,9 +1670,9 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameStart",
-			pos:  position{line: 198, col: 1, offset: 4633},
+			pos:  position{line: 225, col: 1, offset: 5676},
 			expr: &charClassMatcher{
-				pos:        position{line: 198, col: 18, offset: 4650},
+				pos:        position{line: 225, col: 18, offset: 5693},
 				val:        "[A-Za-z_$]",
 				chars:      []rune{'_', '$'},
 				ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -1380,16 +1682,16 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameRest",
-			pos:  position{line: 199, col: 1, offset: 4661},
+			pos:  position{line: 226, col: 1, offset: 5704},
 			expr: &choiceExpr{
-				pos: position{line: 199, col: 17, offset: 4677},
+				pos: position{line: 226, col: 17, offset: 5720},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 199, col: 17, offset: 4677},
+						pos:  position{line: 226, col: 17, offset: 5720},
 						name: "fieldNameStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 199, col: 34, offset: 4694},
+						pos:        position{line: 226, col: 34, offset: 5737},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -1400,45 +1702,45 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReference",
-			pos:  position{line: 201, col: 1, offset: 4701},
+			pos:  position{line: 228, col: 1, offset: 5744},
 			expr: &actionExpr{
-				pos: position{line: 202, col: 4, offset: 4719},
+				pos: position{line: 229, col: 4, offset: 5762},
 				run: (*parser).callonfieldReference1,
 				expr: &seqExpr{
-					pos: position{line: 202, col: 4, offset: 4719},
+					pos: position{line: 229, col: 4, offset: 5762},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 202, col: 4, offset: 4719},
+							pos:   position{line: 229, col: 4, offset: 5762},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 202, col: 9, offset: 4724},
+								pos:  position{line: 229, col: 9, offset: 5767},
 								name: "fieldName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 202, col: 19, offset: 4734},
+							pos:   position{line: 229, col: 19, offset: 5777},
 							label: "derefs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 202, col: 26, offset: 4741},
+								pos: position{line: 229, col: 26, offset: 5784},
 								expr: &choiceExpr{
-									pos: position{line: 203, col: 8, offset: 4750},
+									pos: position{line: 230, col: 8, offset: 5793},
 									alternatives: []interface{}{
 										&actionExpr{
-											pos: position{line: 203, col: 8, offset: 4750},
+											pos: position{line: 230, col: 8, offset: 5793},
 											run: (*parser).callonfieldReference8,
 											expr: &seqExpr{
-												pos: position{line: 203, col: 8, offset: 4750},
+												pos: position{line: 230, col: 8, offset: 5793},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 203, col: 8, offset: 4750},
+														pos:        position{line: 230, col: 8, offset: 5793},
 														val:        ".",
 														ignoreCase: false,
 													},
 													&labeledExpr{
-														pos:   position{line: 203, col: 12, offset: 4754},
+														pos:   position{line: 230, col: 12, offset: 5797},
 														label: "field",
 														expr: &ruleRefExpr{
-															pos:  position{line: 203, col: 18, offset: 4760},
+															pos:  position{line: 230, col: 18, offset: 5803},
 															name: "fieldName",
 														},
 													},
@@ -1446,26 +1748,26 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 204, col: 8, offset: 4841},
+											pos: position{line: 231, col: 8, offset: 5884},
 											run: (*parser).callonfieldReference13,
 											expr: &seqExpr{
-												pos: position{line: 204, col: 8, offset: 4841},
+												pos: position{line: 231, col: 8, offset: 5884},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 204, col: 8, offset: 4841},
+														pos:        position{line: 231, col: 8, offset: 5884},
 														val:        "[",
 														ignoreCase: false,
 													},
 													&labeledExpr{
-														pos:   position{line: 204, col: 12, offset: 4845},
+														pos:   position{line: 231, col: 12, offset: 5888},
 														label: "index",
 														expr: &ruleRefExpr{
-															pos:  position{line: 204, col: 18, offset: 4851},
+															pos:  position{line: 231, col: 18, offset: 5894},
 															name: "suint",
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 204, col: 24, offset: 4857},
+														pos:        position{line: 231, col: 24, offset: 5900},
 														val:        "]",
 														ignoreCase: false,
 													},
@@ -1482,60 +1784,60 @@ var g = &grammar{
 		},
 		{
 			name: "fieldExpr",
-			pos:  position{line: 209, col: 1, offset: 4973},
+			pos:  position{line: 236, col: 1, offset: 6016},
 			expr: &choiceExpr{
-				pos: position{line: 210, col: 5, offset: 4987},
+				pos: position{line: 237, col: 5, offset: 6030},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 210, col: 5, offset: 4987},
+						pos: position{line: 237, col: 5, offset: 6030},
 						run: (*parser).callonfieldExpr2,
 						expr: &seqExpr{
-							pos: position{line: 210, col: 5, offset: 4987},
+							pos: position{line: 237, col: 5, offset: 6030},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 210, col: 5, offset: 4987},
+									pos:   position{line: 237, col: 5, offset: 6030},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 210, col: 8, offset: 4990},
+										pos:  position{line: 237, col: 8, offset: 6033},
 										name: "fieldOp",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 210, col: 16, offset: 4998},
+									pos: position{line: 237, col: 16, offset: 6041},
 									expr: &ruleRefExpr{
-										pos:  position{line: 210, col: 16, offset: 4998},
+										pos:  position{line: 237, col: 16, offset: 6041},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 210, col: 19, offset: 5001},
+									pos:        position{line: 237, col: 19, offset: 6044},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 210, col: 23, offset: 5005},
+									pos: position{line: 237, col: 23, offset: 6048},
 									expr: &ruleRefExpr{
-										pos:  position{line: 210, col: 23, offset: 5005},
+										pos:  position{line: 237, col: 23, offset: 6048},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 210, col: 26, offset: 5008},
+									pos:   position{line: 237, col: 26, offset: 6051},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 210, col: 32, offset: 5014},
+										pos:  position{line: 237, col: 32, offset: 6057},
 										name: "fieldReference",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 210, col: 47, offset: 5029},
+									pos: position{line: 237, col: 47, offset: 6072},
 									expr: &ruleRefExpr{
-										pos:  position{line: 210, col: 47, offset: 5029},
+										pos:  position{line: 237, col: 47, offset: 6072},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 210, col: 50, offset: 5032},
+									pos:        position{line: 237, col: 50, offset: 6075},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1543,7 +1845,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 213, col: 5, offset: 5096},
+						pos:  position{line: 240, col: 5, offset: 6139},
 						name: "fieldReference",
 					},
 				},
@@ -1551,12 +1853,12 @@ var g = &grammar{
 		},
 		{
 			name: "fieldOp",
-			pos:  position{line: 215, col: 1, offset: 5112},
+			pos:  position{line: 242, col: 1, offset: 6155},
 			expr: &actionExpr{
-				pos: position{line: 216, col: 5, offset: 5124},
+				pos: position{line: 243, col: 5, offset: 6167},
 				run: (*parser).callonfieldOp1,
 				expr: &litMatcher{
-					pos:        position{line: 216, col: 5, offset: 5124},
+					pos:        position{line: 243, col: 5, offset: 6167},
 					val:        "len",
 					ignoreCase: true,
 				},
@@ -1564,50 +1866,50 @@ var g = &grammar{
 		},
 		{
 			name: "fieldExprList",
-			pos:  position{line: 218, col: 1, offset: 5154},
+			pos:  position{line: 245, col: 1, offset: 6197},
 			expr: &actionExpr{
-				pos: position{line: 219, col: 5, offset: 5172},
+				pos: position{line: 246, col: 5, offset: 6215},
 				run: (*parser).callonfieldExprList1,
 				expr: &seqExpr{
-					pos: position{line: 219, col: 5, offset: 5172},
+					pos: position{line: 246, col: 5, offset: 6215},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 219, col: 5, offset: 5172},
+							pos:   position{line: 246, col: 5, offset: 6215},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 219, col: 11, offset: 5178},
+								pos:  position{line: 246, col: 11, offset: 6221},
 								name: "fieldExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 219, col: 21, offset: 5188},
+							pos:   position{line: 246, col: 21, offset: 6231},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 219, col: 26, offset: 5193},
+								pos: position{line: 246, col: 26, offset: 6236},
 								expr: &seqExpr{
-									pos: position{line: 219, col: 27, offset: 5194},
+									pos: position{line: 246, col: 27, offset: 6237},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 219, col: 27, offset: 5194},
+											pos: position{line: 246, col: 27, offset: 6237},
 											expr: &ruleRefExpr{
-												pos:  position{line: 219, col: 27, offset: 5194},
+												pos:  position{line: 246, col: 27, offset: 6237},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 219, col: 30, offset: 5197},
+											pos:        position{line: 246, col: 30, offset: 6240},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 219, col: 34, offset: 5201},
+											pos: position{line: 246, col: 34, offset: 6244},
 											expr: &ruleRefExpr{
-												pos:  position{line: 219, col: 34, offset: 5201},
+												pos:  position{line: 246, col: 34, offset: 6244},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 219, col: 37, offset: 5204},
+											pos:  position{line: 246, col: 37, offset: 6247},
 											name: "fieldExpr",
 										},
 									},
@@ -1620,42 +1922,42 @@ var g = &grammar{
 		},
 		{
 			name: "fieldRefDotOnly",
-			pos:  position{line: 229, col: 1, offset: 5399},
+			pos:  position{line: 256, col: 1, offset: 6442},
 			expr: &actionExpr{
-				pos: position{line: 230, col: 5, offset: 5419},
+				pos: position{line: 257, col: 5, offset: 6462},
 				run: (*parser).callonfieldRefDotOnly1,
 				expr: &seqExpr{
-					pos: position{line: 230, col: 5, offset: 5419},
+					pos: position{line: 257, col: 5, offset: 6462},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 230, col: 5, offset: 5419},
+							pos:   position{line: 257, col: 5, offset: 6462},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 230, col: 10, offset: 5424},
+								pos:  position{line: 257, col: 10, offset: 6467},
 								name: "fieldName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 230, col: 20, offset: 5434},
+							pos:   position{line: 257, col: 20, offset: 6477},
 							label: "refs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 230, col: 25, offset: 5439},
+								pos: position{line: 257, col: 25, offset: 6482},
 								expr: &actionExpr{
-									pos: position{line: 230, col: 26, offset: 5440},
+									pos: position{line: 257, col: 26, offset: 6483},
 									run: (*parser).callonfieldRefDotOnly7,
 									expr: &seqExpr{
-										pos: position{line: 230, col: 26, offset: 5440},
+										pos: position{line: 257, col: 26, offset: 6483},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 230, col: 26, offset: 5440},
+												pos:        position{line: 257, col: 26, offset: 6483},
 												val:        ".",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 230, col: 30, offset: 5444},
+												pos:   position{line: 257, col: 30, offset: 6487},
 												label: "field",
 												expr: &ruleRefExpr{
-													pos:  position{line: 230, col: 36, offset: 5450},
+													pos:  position{line: 257, col: 36, offset: 6493},
 													name: "fieldName",
 												},
 											},
@@ -1670,56 +1972,56 @@ var g = &grammar{
 		},
 		{
 			name: "fieldRefDotOnlyList",
-			pos:  position{line: 234, col: 1, offset: 5575},
+			pos:  position{line: 261, col: 1, offset: 6618},
 			expr: &actionExpr{
-				pos: position{line: 235, col: 5, offset: 5599},
+				pos: position{line: 262, col: 5, offset: 6642},
 				run: (*parser).callonfieldRefDotOnlyList1,
 				expr: &seqExpr{
-					pos: position{line: 235, col: 5, offset: 5599},
+					pos: position{line: 262, col: 5, offset: 6642},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 235, col: 5, offset: 5599},
+							pos:   position{line: 262, col: 5, offset: 6642},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 235, col: 11, offset: 5605},
+								pos:  position{line: 262, col: 11, offset: 6648},
 								name: "fieldRefDotOnly",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 235, col: 27, offset: 5621},
+							pos:   position{line: 262, col: 27, offset: 6664},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 235, col: 32, offset: 5626},
+								pos: position{line: 262, col: 32, offset: 6669},
 								expr: &actionExpr{
-									pos: position{line: 235, col: 33, offset: 5627},
+									pos: position{line: 262, col: 33, offset: 6670},
 									run: (*parser).callonfieldRefDotOnlyList7,
 									expr: &seqExpr{
-										pos: position{line: 235, col: 33, offset: 5627},
+										pos: position{line: 262, col: 33, offset: 6670},
 										exprs: []interface{}{
 											&zeroOrOneExpr{
-												pos: position{line: 235, col: 33, offset: 5627},
+												pos: position{line: 262, col: 33, offset: 6670},
 												expr: &ruleRefExpr{
-													pos:  position{line: 235, col: 33, offset: 5627},
+													pos:  position{line: 262, col: 33, offset: 6670},
 													name: "_",
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 235, col: 36, offset: 5630},
+												pos:        position{line: 262, col: 36, offset: 6673},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 235, col: 40, offset: 5634},
+												pos: position{line: 262, col: 40, offset: 6677},
 												expr: &ruleRefExpr{
-													pos:  position{line: 235, col: 40, offset: 5634},
+													pos:  position{line: 262, col: 40, offset: 6677},
 													name: "_",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 235, col: 43, offset: 5637},
+												pos:   position{line: 262, col: 43, offset: 6680},
 												label: "ref",
 												expr: &ruleRefExpr{
-													pos:  position{line: 235, col: 47, offset: 5641},
+													pos:  position{line: 262, col: 47, offset: 6684},
 													name: "fieldRefDotOnly",
 												},
 											},
@@ -1732,52 +2034,210 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "fieldPatternName",
+			pos:  position{line: 270, col: 1, offset: 6864},
+			expr: &actionExpr{
+				pos: position{line: 270, col: 20, offset: 6883},
+				run: (*parser).callonfieldPatternName1,
+				expr: &seqExpr{
+					pos: position{line: 270, col: 20, offset: 6883},
+					exprs: []interface{}{
+						&choiceExpr{
+							pos: position{line: 270, col: 21, offset: 6884},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 270, col: 21, offset: 6884},
+									name: "fieldNameStart",
+								},
+								&litMatcher{
+									pos:        position{line: 270, col: 38, offset: 6901},
+									val:        "*",
+									ignoreCase: false,
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 270, col: 43, offset: 6906},
+							expr: &choiceExpr{
+								pos: position{line: 270, col: 44, offset: 6907},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 270, col: 44, offset: 6907},
+										name: "fieldNameRest",
+									},
+									&litMatcher{
+										pos:        position{line: 270, col: 60, offset: 6923},
+										val:        "*",
+										ignoreCase: false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "fieldPattern",
+			pos:  position{line: 272, col: 1, offset: 6961},
+			expr: &actionExpr{
+				pos: position{line: 273, col: 5, offset: 6978},
+				run: (*parser).callonfieldPattern1,
+				expr: &seqExpr{
+					pos: position{line: 273, col: 5, offset: 6978},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 273, col: 5, offset: 6978},
+							label: "base",
+							expr: &ruleRefExpr{
+								pos:  position{line: 273, col: 10, offset: 6983},
+								name: "fieldPatternName",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 273, col: 27, offset: 7000},
+							label: "refs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 273, col: 32, offset: 7005},
+								expr: &actionExpr{
+									pos: position{line: 273, col: 33, offset: 7006},
+									run: (*parser).callonfieldPattern7,
+									expr: &seqExpr{
+										pos: position{line: 273, col: 33, offset: 7006},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 273, col: 33, offset: 7006},
+												val:        ".",
+												ignoreCase: false,
+											},
+											&labeledExpr{
+												pos:   position{line: 273, col: 37, offset: 7010},
+												label: "field",
+												expr: &ruleRefExpr{
+													pos:  position{line: 273, col: 43, offset: 7016},
+													name: "fieldPatternName",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "fieldPatternList",
+			pos:  position{line: 277, col: 1, offset: 7148},
+			expr: &actionExpr{
+				pos: position{line: 278, col: 5, offset: 7169},
+				run: (*parser).callonfieldPatternList1,
+				expr: &seqExpr{
+					pos: position{line: 278, col: 5, offset: 7169},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 278, col: 5, offset: 7169},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 278, col: 11, offset: 7175},
+								name: "fieldPattern",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 278, col: 24, offset: 7188},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 278, col: 29, offset: 7193},
+								expr: &actionExpr{
+									pos: position{line: 278, col: 30, offset: 7194},
+									run: (*parser).callonfieldPatternList7,
+									expr: &seqExpr{
+										pos: position{line: 278, col: 30, offset: 7194},
+										exprs: []interface{}{
+											&zeroOrOneExpr{
+												pos: position{line: 278, col: 30, offset: 7194},
+												expr: &ruleRefExpr{
+													pos:  position{line: 278, col: 30, offset: 7194},
+													name: "_",
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 278, col: 33, offset: 7197},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 278, col: 37, offset: 7201},
+												expr: &ruleRefExpr{
+													pos:  position{line: 278, col: 37, offset: 7201},
+													name: "_",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 278, col: 40, offset: 7204},
+												label: "ref",
+												expr: &ruleRefExpr{
+													pos:  position{line: 278, col: 44, offset: 7208},
+													name: "fieldPattern",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "fieldNameList",
-			pos:  position{line: 243, col: 1, offset: 5821},
+			pos:  position{line: 282, col: 1, offset: 7325},
 			expr: &actionExpr{
-				pos: position{line: 244, col: 5, offset: 5839},
+				pos: position{line: 283, col: 5, offset: 7343},
 				run: (*parser).callonfieldNameList1,
 				expr: &seqExpr{
-					pos: position{line: 244, col: 5, offset: 5839},
+					pos: position{line: 283, col: 5, offset: 7343},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 244, col: 5, offset: 5839},
+							pos:   position{line: 283, col: 5, offset: 7343},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 244, col: 11, offset: 5845},
+								pos:  position{line: 283, col: 11, offset: 7349},
 								name: "fieldName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 244, col: 21, offset: 5855},
+							pos:   position{line: 283, col: 21, offset: 7359},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 244, col: 26, offset: 5860},
+								pos: position{line: 283, col: 26, offset: 7364},
 								expr: &seqExpr{
-									pos: position{line: 244, col: 27, offset: 5861},
+									pos: position{line: 283, col: 27, offset: 7365},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 244, col: 27, offset: 5861},
+											pos: position{line: 283, col: 27, offset: 7365},
 											expr: &ruleRefExpr{
-												pos:  position{line: 244, col: 27, offset: 5861},
+												pos:  position{line: 283, col: 27, offset: 7365},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 244, col: 30, offset: 5864},
+											pos:        position{line: 283, col: 30, offset: 7368},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 244, col: 34, offset: 5868},
+											pos: position{line: 283, col: 34, offset: 7372},
 											expr: &ruleRefExpr{
-												pos:  position{line: 244, col: 34, offset: 5868},
+												pos:  position{line: 283, col: 34, offset: 7372},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 244, col: 37, offset: 5871},
+											pos:  position{line: 283, col: 37, offset: 7375},
 											name: "fieldName",
 										},
 									},
@@ -1790,12 +2250,12 @@ var g = &grammar{
 		},
 		{
 			name: "countOp",
-			pos:  position{line: 252, col: 1, offset: 6064},
+			pos:  position{line: 291, col: 1, offset: 7568},
 			expr: &actionExpr{
-				pos: position{line: 253, col: 5, offset: 6076},
+				pos: position{line: 292, col: 5, offset: 7580},
 				run: (*parser).calloncountOp1,
 				expr: &litMatcher{
-					pos:        position{line: 253, col: 5, offset: 6076},
+					pos:        position{line: 292, col: 5, offset: 7580},
 					val:        "count",
 					ignoreCase: true,
 				},
@@ -1803,140 +2263,290 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReducerOp",
-			pos:  position{line: 255, col: 1, offset: 6110},
+			pos:  position{line: 294, col: 1, offset: 7614},
 			expr: &choiceExpr{
-				pos: position{line: 256, col: 5, offset: 6129},
+				pos: position{line: 295, col: 5, offset: 7633},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 256, col: 5, offset: 6129},
+						pos: position{line: 295, col: 5, offset: 7633},
 						run: (*parser).callonfieldReducerOp2,
 						expr: &litMatcher{
-							pos:        position{line: 256, col: 5, offset: 6129},
+							pos:        position{line: 295, col: 5, offset: 7633},
 							val:        "sum",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 257, col: 5, offset: 6163},
+						pos: position{line: 296, col: 5, offset: 7667},
 						run: (*parser).callonfieldReducerOp4,
 						expr: &litMatcher{
-							pos:        position{line: 257, col: 5, offset: 6163},
+							pos:        position{line: 296, col: 5, offset: 7667},
 							val:        "avg",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 258, col: 5, offset: 6197},
+						pos: position{line: 297, col: 5, offset: 7701},
 						run: (*parser).callonfieldReducerOp6,
 						expr: &litMatcher{
-							pos:        position{line: 258, col: 5, offset: 6197},
-							val:        "stdev",
+							pos:        position{line: 297, col: 5, offset: 7701},
+							val:        "stddev",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 259, col: 5, offset: 6234},
+						pos: position{line: 298, col: 5, offset: 7740},
 						run: (*parser).callonfieldReducerOp8,
 						expr: &litMatcher{
-							pos:        position{line: 259, col: 5, offset: 6234},
-							val:        "sd",
+							pos:        position{line: 298, col: 5, offset: 7740},
+							val:        "stdev",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 260, col: 5, offset: 6270},
+						pos: position{line: 299, col: 5, offset: 7777},
 						run: (*parser).callonfieldReducerOp10,
 						expr: &litMatcher{
-							pos:        position{line: 260, col: 5, offset: 6270},
-							val:        "var",
+							pos:        position{line: 299, col: 5, offset: 7777},
+							val:        "sd",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 261, col: 5, offset: 6304},
+						pos: position{line: 300, col: 5, offset: 7813},
 						run: (*parser).callonfieldReducerOp12,
 						expr: &litMatcher{
-							pos:        position{line: 261, col: 5, offset: 6304},
-							val:        "entropy",
+							pos:        position{line: 300, col: 5, offset: 7813},
+							val:        "var",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 262, col: 5, offset: 6345},
+						pos: position{line: 301, col: 5, offset: 7847},
 						run: (*parser).callonfieldReducerOp14,
 						expr: &litMatcher{
-							pos:        position{line: 262, col: 5, offset: 6345},
-							val:        "min",
+							pos:        position{line: 301, col: 5, offset: 7847},
+							val:        "entropy",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 263, col: 5, offset: 6379},
+						pos: position{line: 302, col: 5, offset: 7888},
 						run: (*parser).callonfieldReducerOp16,
 						expr: &litMatcher{
-							pos:        position{line: 263, col: 5, offset: 6379},
-							val:        "max",
+							pos:        position{line: 302, col: 5, offset: 7888},
+							val:        "min",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 264, col: 5, offset: 6413},
+						pos: position{line: 303, col: 5, offset: 7922},
 						run: (*parser).callonfieldReducerOp18,
 						expr: &litMatcher{
-							pos:        position{line: 264, col: 5, offset: 6413},
-							val:        "first",
+							pos:        position{line: 303, col: 5, offset: 7922},
+							val:        "max",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 265, col: 5, offset: 6451},
+						pos: position{line: 304, col: 5, offset: 7956},
 						run: (*parser).callonfieldReducerOp20,
 						expr: &litMatcher{
-							pos:        position{line: 265, col: 5, offset: 6451},
-							val:        "last",
+							pos:        position{line: 304, col: 5, offset: 7956},
+							val:        "first",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 266, col: 5, offset: 6487},
+						pos: position{line: 305, col: 5, offset: 7994},
 						run: (*parser).callonfieldReducerOp22,
 						expr: &litMatcher{
-							pos:        position{line: 266, col: 5, offset: 6487},
+							pos:        position{line: 305, col: 5, offset: 7994},
+							val:        "last",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 306, col: 5, offset: 8030},
+						run: (*parser).callonfieldReducerOp24,
+						expr: &litMatcher{
+							pos:        position{line: 306, col: 5, offset: 8030},
 							val:        "countdistinct",
 							ignoreCase: true,
 						},
 					},
+					&actionExpr{
+						pos: position{line: 307, col: 5, offset: 8083},
+						run: (*parser).callonfieldReducerOp26,
+						expr: &litMatcher{
+							pos:        position{line: 307, col: 5, offset: 8083},
+							val:        "median",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 308, col: 5, offset: 8122},
+						run: (*parser).callonfieldReducerOp28,
+						expr: &litMatcher{
+							pos:        position{line: 308, col: 5, offset: 8122},
+							val:        "collect",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 309, col: 5, offset: 8163},
+						run: (*parser).callonfieldReducerOp30,
+						expr: &litMatcher{
+							pos:        position{line: 309, col: 5, offset: 8163},
+							val:        "union",
+							ignoreCase: true,
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "paddedFieldExpr",
-			pos:  position{line: 268, col: 1, offset: 6537},
+			name: "paramReducerOp",
+			pos:  position{line: 311, col: 1, offset: 8197},
+			expr: &choiceExpr{
+				pos: position{line: 312, col: 5, offset: 8216},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 312, col: 5, offset: 8216},
+						run: (*parser).callonparamReducerOp2,
+						expr: &litMatcher{
+							pos:        position{line: 312, col: 5, offset: 8216},
+							val:        "quantile",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 313, col: 5, offset: 8259},
+						run: (*parser).callonparamReducerOp4,
+						expr: &litMatcher{
+							pos:        position{line: 313, col: 5, offset: 8259},
+							val:        "percentile",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 314, col: 5, offset: 8306},
+						run: (*parser).callonparamReducerOp6,
+						expr: &litMatcher{
+							pos:        position{line: 314, col: 5, offset: 8306},
+							val:        "histogram",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 315, col: 5, offset: 8351},
+						run: (*parser).callonparamReducerOp8,
+						expr: &litMatcher{
+							pos:        position{line: 315, col: 5, offset: 8351},
+							val:        "collect",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 316, col: 5, offset: 8392},
+						run: (*parser).callonparamReducerOp10,
+						expr: &litMatcher{
+							pos:        position{line: 316, col: 5, offset: 8392},
+							val:        "union",
+							ignoreCase: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducerArg",
+			pos:  position{line: 320, col: 1, offset: 8542},
+			expr: &choiceExpr{
+				pos: position{line: 321, col: 5, offset: 8557},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 321, col: 5, offset: 8557},
+						run: (*parser).callonreducerArg2,
+						expr: &seqExpr{
+							pos: position{line: 321, col: 5, offset: 8557},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 321, col: 5, offset: 8557},
+									label: "field",
+									expr: &ruleRefExpr{
+										pos:  position{line: 321, col: 11, offset: 8563},
+										name: "fieldExpr",
+									},
+								},
+								&andExpr{
+									pos: position{line: 321, col: 21, offset: 8573},
+									expr: &seqExpr{
+										pos: position{line: 321, col: 23, offset: 8575},
+										exprs: []interface{}{
+											&zeroOrOneExpr{
+												pos: position{line: 321, col: 23, offset: 8575},
+												expr: &ruleRefExpr{
+													pos:  position{line: 321, col: 23, offset: 8575},
+													name: "_",
+												},
+											},
+											&choiceExpr{
+												pos: position{line: 321, col: 27, offset: 8579},
+												alternatives: []interface{}{
+													&litMatcher{
+														pos:        position{line: 321, col: 27, offset: 8579},
+														val:        ")",
+														ignoreCase: false,
+													},
+													&litMatcher{
+														pos:        position{line: 321, col: 33, offset: 8585},
+														val:        ",",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 322, col: 5, offset: 8617},
+						name: "Expression",
+					},
+				},
+			},
+		},
+		{
+			name: "paddedReducerArg",
+			pos:  position{line: 324, col: 1, offset: 8629},
 			expr: &actionExpr{
-				pos: position{line: 268, col: 19, offset: 6555},
-				run: (*parser).callonpaddedFieldExpr1,
+				pos: position{line: 324, col: 20, offset: 8648},
+				run: (*parser).callonpaddedReducerArg1,
 				expr: &seqExpr{
-					pos: position{line: 268, col: 19, offset: 6555},
+					pos: position{line: 324, col: 20, offset: 8648},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 268, col: 19, offset: 6555},
+							pos: position{line: 324, col: 20, offset: 8648},
 							expr: &ruleRefExpr{
-								pos:  position{line: 268, col: 19, offset: 6555},
+								pos:  position{line: 324, col: 20, offset: 8648},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 268, col: 22, offset: 6558},
-							label: "field",
+							pos:   position{line: 324, col: 23, offset: 8651},
+							label: "arg",
 							expr: &ruleRefExpr{
-								pos:  position{line: 268, col: 28, offset: 6564},
-								name: "fieldExpr",
+								pos:  position{line: 324, col: 27, offset: 8655},
+								name: "reducerArg",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 268, col: 38, offset: 6574},
+							pos: position{line: 324, col: 38, offset: 8666},
 							expr: &ruleRefExpr{
-								pos:  position{line: 268, col: 38, offset: 6574},
+								pos:  position{line: 324, col: 38, offset: 8666},
 								name: "_",
 							},
 						},
@@ -1946,53 +2556,53 @@ var g = &grammar{
 		},
 		{
 			name: "countReducer",
-			pos:  position{line: 270, col: 1, offset: 6600},
+			pos:  position{line: 326, col: 1, offset: 8690},
 			expr: &actionExpr{
-				pos: position{line: 271, col: 5, offset: 6617},
+				pos: position{line: 327, col: 5, offset: 8707},
 				run: (*parser).calloncountReducer1,
 				expr: &seqExpr{
-					pos: position{line: 271, col: 5, offset: 6617},
+					pos: position{line: 327, col: 5, offset: 8707},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 271, col: 5, offset: 6617},
+							pos:   position{line: 327, col: 5, offset: 8707},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 271, col: 8, offset: 6620},
+								pos:  position{line: 327, col: 8, offset: 8710},
 								name: "countOp",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 271, col: 16, offset: 6628},
+							pos: position{line: 327, col: 16, offset: 8718},
 							expr: &ruleRefExpr{
-								pos:  position{line: 271, col: 16, offset: 6628},
+								pos:  position{line: 327, col: 16, offset: 8718},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 271, col: 19, offset: 6631},
+							pos:        position{line: 327, col: 19, offset: 8721},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 271, col: 23, offset: 6635},
+							pos:   position{line: 327, col: 23, offset: 8725},
 							label: "field",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 271, col: 29, offset: 6641},
+								pos: position{line: 327, col: 29, offset: 8731},
 								expr: &ruleRefExpr{
-									pos:  position{line: 271, col: 29, offset: 6641},
-									name: "paddedFieldExpr",
+									pos:  position{line: 327, col: 29, offset: 8731},
+									name: "paddedReducerArg",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 271, col: 47, offset: 6659},
+							pos: position{line: 327, col: 48, offset: 8750},
 							expr: &ruleRefExpr{
-								pos:  position{line: 271, col: 47, offset: 6659},
+								pos:  position{line: 327, col: 48, offset: 8750},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 271, col: 50, offset: 6662},
+							pos:        position{line: 327, col: 51, offset: 8753},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -2002,87 +2612,199 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReducer",
-			pos:  position{line: 275, col: 1, offset: 6721},
+			pos:  position{line: 331, col: 1, offset: 8812},
 			expr: &actionExpr{
-				pos: position{line: 276, col: 5, offset: 6738},
+				pos: position{line: 332, col: 5, offset: 8829},
 				run: (*parser).callonfieldReducer1,
 				expr: &seqExpr{
-					pos: position{line: 276, col: 5, offset: 6738},
+					pos: position{line: 332, col: 5, offset: 8829},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 276, col: 5, offset: 6738},
+							pos:   position{line: 332, col: 5, offset: 8829},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 8, offset: 6741},
+								pos:  position{line: 332, col: 8, offset: 8832},
 								name: "fieldReducerOp",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 276, col: 23, offset: 6756},
+							pos: position{line: 332, col: 23, offset: 8847},
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 23, offset: 6756},
+								pos:  position{line: 332, col: 23, offset: 8847},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 276, col: 26, offset: 6759},
+							pos:        position{line: 332, col: 26, offset: 8850},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 276, col: 30, offset: 6763},
+							pos: position{line: 332, col: 30, offset: 8854},
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 30, offset: 6763},
+								pos:  position{line: 332, col: 30, offset: 8854},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 276, col: 33, offset: 6766},
+							pos:   position{line: 332, col: 33, offset: 8857},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 39, offset: 6772},
-								name: "fieldExpr",
+								pos:  position{line: 332, col: 39, offset: 8863},
+								name: "reducerArg",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 332, col: 51, offset: 8875},
+							expr: &ruleRefExpr{
+								pos:  position{line: 332, col: 51, offset: 8875},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 332, col: 54, offset: 8878},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "paramReducer",
+			pos:  position{line: 336, col: 1, offset: 8945},
+			expr: &actionExpr{
+				pos: position{line: 337, col: 5, offset: 8962},
+				run: (*parser).callonparamReducer1,
+				expr: &seqExpr{
+					pos: position{line: 337, col: 5, offset: 8962},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 337, col: 5, offset: 8962},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 337, col: 8, offset: 8965},
+								name: "paramReducerOp",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 337, col: 23, offset: 8980},
+							expr: &ruleRefExpr{
+								pos:  position{line: 337, col: 23, offset: 8980},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 337, col: 26, offset: 8983},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 337, col: 30, offset: 8987},
+							expr: &ruleRefExpr{
+								pos:  position{line: 337, col: 30, offset: 8987},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 337, col: 33, offset: 8990},
+							label: "field",
+							expr: &ruleRefExpr{
+								pos:  position{line: 337, col: 39, offset: 8996},
+								name: "reducerArg",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 337, col: 50, offset: 9007},
+							expr: &ruleRefExpr{
+								pos:  position{line: 337, col: 50, offset: 9007},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 337, col: 53, offset: 9010},
+							val:        ",",
+							ignoreCase: false,
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 337, col: 57, offset: 9014},
+							expr: &ruleRefExpr{
+								pos:  position{line: 337, col: 57, offset: 9014},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 337, col: 60, offset: 9017},
+							label: "param",
+							expr: &ruleRefExpr{
+								pos:  position{line: 337, col: 66, offset: 9023},
+								name: "reducerParam",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 337, col: 79, offset: 9036},
+							expr: &ruleRefExpr{
+								pos:  position{line: 337, col: 79, offset: 9036},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 337, col: 82, offset: 9039},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducerParam",
+			pos:  position{line: 341, col: 1, offset: 9118},
+			expr: &actionExpr{
+				pos: position{line: 342, col: 5, offset: 9135},
+				run: (*parser).callonreducerParam1,
+				expr: &labeledExpr{
+					pos:   position{line: 342, col: 5, offset: 9135},
+					label: "s",
+					expr: &choiceExpr{
+						pos: position{line: 342, col: 8, offset: 9138},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 342, col: 8, offset: 9138},
+								name: "sdouble",
 							},
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 276, col: 50, offset: 6783},
-							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 50, offset: 6783},
-								name: "_",
+							&ruleRefExpr{
+								pos:  position{line: 342, col: 18, offset: 9148},
+								name: "sinteger",
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 276, col: 53, offset: 6786},
-							val:        ")",
-							ignoreCase: false,
-						},
 					},
 				},
 			},
 		},
 		{
 			name: "reducerProc",
-			pos:  position{line: 280, col: 1, offset: 6853},
+			pos:  position{line: 344, col: 1, offset: 9189},
 			expr: &actionExpr{
-				pos: position{line: 281, col: 5, offset: 6869},
+				pos: position{line: 345, col: 5, offset: 9205},
 				run: (*parser).callonreducerProc1,
 				expr: &seqExpr{
-					pos: position{line: 281, col: 5, offset: 6869},
+					pos: position{line: 345, col: 5, offset: 9205},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 281, col: 5, offset: 6869},
+							pos:   position{line: 345, col: 5, offset: 9205},
 							label: "every",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 281, col: 11, offset: 6875},
+								pos: position{line: 345, col: 11, offset: 9211},
 								expr: &seqExpr{
-									pos: position{line: 281, col: 12, offset: 6876},
+									pos: position{line: 345, col: 12, offset: 9212},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 281, col: 12, offset: 6876},
+											pos:  position{line: 345, col: 12, offset: 9212},
 											name: "everyDur",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 281, col: 21, offset: 6885},
+											pos:  position{line: 345, col: 21, offset: 9221},
 											name: "_",
 										},
 									},
@@ -2090,27 +2812,27 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 281, col: 25, offset: 6889},
+							pos:   position{line: 345, col: 25, offset: 9225},
 							label: "reducers",
 							expr: &ruleRefExpr{
-								pos:  position{line: 281, col: 34, offset: 6898},
+								pos:  position{line: 345, col: 34, offset: 9234},
 								name: "reducerList",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 281, col: 46, offset: 6910},
+							pos:   position{line: 345, col: 46, offset: 9246},
 							label: "keys",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 281, col: 51, offset: 6915},
+								pos: position{line: 345, col: 51, offset: 9251},
 								expr: &seqExpr{
-									pos: position{line: 281, col: 52, offset: 6916},
+									pos: position{line: 345, col: 52, offset: 9252},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 281, col: 52, offset: 6916},
+											pos:  position{line: 345, col: 52, offset: 9252},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 281, col: 54, offset: 6918},
+											pos:  position{line: 345, col: 54, offset: 9254},
 											name: "groupBy",
 										},
 									},
@@ -2118,12 +2840,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 281, col: 64, offset: 6928},
+							pos:   position{line: 345, col: 64, offset: 9264},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 281, col: 70, offset: 6934},
+								pos: position{line: 345, col: 70, offset: 9270},
 								expr: &ruleRefExpr{
-									pos:  position{line: 281, col: 70, offset: 6934},
+									pos:  position{line: 345, col: 70, offset: 9270},
 									name: "procLimitArg",
 								},
 							},
@@ -2134,27 +2856,27 @@ var g = &grammar{
 		},
 		{
 			name: "asClause",
-			pos:  position{line: 299, col: 1, offset: 7291},
+			pos:  position{line: 363, col: 1, offset: 9627},
 			expr: &actionExpr{
-				pos: position{line: 300, col: 5, offset: 7304},
+				pos: position{line: 364, col: 5, offset: 9640},
 				run: (*parser).callonasClause1,
 				expr: &seqExpr{
-					pos: position{line: 300, col: 5, offset: 7304},
+					pos: position{line: 364, col: 5, offset: 9640},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 300, col: 5, offset: 7304},
+							pos:        position{line: 364, col: 5, offset: 9640},
 							val:        "as",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 300, col: 11, offset: 7310},
+							pos:  position{line: 364, col: 11, offset: 9646},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 300, col: 13, offset: 7312},
+							pos:   position{line: 364, col: 13, offset: 9648},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 300, col: 15, offset: 7314},
+								pos:  position{line: 364, col: 15, offset: 9650},
 								name: "fieldName",
 							},
 						},
@@ -2164,48 +2886,48 @@ var g = &grammar{
 		},
 		{
 			name: "reducerExpr",
-			pos:  position{line: 302, col: 1, offset: 7343},
+			pos:  position{line: 366, col: 1, offset: 9679},
 			expr: &choiceExpr{
-				pos: position{line: 303, col: 5, offset: 7359},
+				pos: position{line: 367, col: 5, offset: 9695},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 303, col: 5, offset: 7359},
+						pos: position{line: 367, col: 5, offset: 9695},
 						run: (*parser).callonreducerExpr2,
 						expr: &seqExpr{
-							pos: position{line: 303, col: 5, offset: 7359},
+							pos: position{line: 367, col: 5, offset: 9695},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 303, col: 5, offset: 7359},
+									pos:   position{line: 367, col: 5, offset: 9695},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 303, col: 11, offset: 7365},
-										name: "fieldExpr",
+										pos:  position{line: 367, col: 11, offset: 9701},
+										name: "fieldName",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 303, col: 21, offset: 7375},
+									pos: position{line: 367, col: 21, offset: 9711},
 									expr: &ruleRefExpr{
-										pos:  position{line: 303, col: 21, offset: 7375},
+										pos:  position{line: 367, col: 21, offset: 9711},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 303, col: 24, offset: 7378},
+									pos:        position{line: 367, col: 24, offset: 9714},
 									val:        "=",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 303, col: 28, offset: 7382},
+									pos: position{line: 367, col: 28, offset: 9718},
 									expr: &ruleRefExpr{
-										pos:  position{line: 303, col: 28, offset: 7382},
+										pos:  position{line: 367, col: 28, offset: 9718},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 303, col: 31, offset: 7385},
+									pos:   position{line: 367, col: 31, offset: 9721},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 303, col: 33, offset: 7387},
+										pos:  position{line: 367, col: 33, offset: 9723},
 										name: "reducer",
 									},
 								},
@@ -2213,28 +2935,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 306, col: 5, offset: 7450},
+						pos: position{line: 370, col: 5, offset: 9786},
 						run: (*parser).callonreducerExpr13,
 						expr: &seqExpr{
-							pos: position{line: 306, col: 5, offset: 7450},
+							pos: position{line: 370, col: 5, offset: 9786},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 306, col: 5, offset: 7450},
+									pos:   position{line: 370, col: 5, offset: 9786},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 306, col: 7, offset: 7452},
+										pos:  position{line: 370, col: 7, offset: 9788},
 										name: "reducer",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 306, col: 15, offset: 7460},
+									pos:  position{line: 370, col: 15, offset: 9796},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 306, col: 17, offset: 7462},
+									pos:   position{line: 370, col: 17, offset: 9798},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 306, col: 23, offset: 7468},
+										pos:  position{line: 370, col: 23, offset: 9804},
 										name: "asClause",
 									},
 								},
@@ -2242,7 +2964,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 309, col: 5, offset: 7532},
+						pos:  position{line: 373, col: 5, offset: 9868},
 						name: "reducer",
 					},
 				},
@@ -2250,67 +2972,71 @@ var g = &grammar{
 		},
 		{
 			name: "reducer",
-			pos:  position{line: 311, col: 1, offset: 7541},
+			pos:  position{line: 375, col: 1, offset: 9877},
 			expr: &choiceExpr{
-				pos: position{line: 312, col: 5, offset: 7553},
+				pos: position{line: 376, col: 5, offset: 9889},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 312, col: 5, offset: 7553},
+						pos:  position{line: 376, col: 5, offset: 9889},
 						name: "countReducer",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 313, col: 5, offset: 7570},
+						pos:  position{line: 377, col: 5, offset: 9906},
 						name: "fieldReducer",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 378, col: 5, offset: 9923},
+						name: "paramReducer",
+					},
 				},
 			},
 		},
 		{
 			name: "reducerList",
-			pos:  position{line: 315, col: 1, offset: 7584},
+			pos:  position{line: 380, col: 1, offset: 9937},
 			expr: &actionExpr{
-				pos: position{line: 316, col: 5, offset: 7600},
+				pos: position{line: 381, col: 5, offset: 9953},
 				run: (*parser).callonreducerList1,
 				expr: &seqExpr{
-					pos: position{line: 316, col: 5, offset: 7600},
+					pos: position{line: 381, col: 5, offset: 9953},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 316, col: 5, offset: 7600},
+							pos:   position{line: 381, col: 5, offset: 9953},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 316, col: 11, offset: 7606},
+								pos:  position{line: 381, col: 11, offset: 9959},
 								name: "reducerExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 316, col: 23, offset: 7618},
+							pos:   position{line: 381, col: 23, offset: 9971},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 316, col: 28, offset: 7623},
+								pos: position{line: 381, col: 28, offset: 9976},
 								expr: &seqExpr{
-									pos: position{line: 316, col: 29, offset: 7624},
+									pos: position{line: 381, col: 29, offset: 9977},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 316, col: 29, offset: 7624},
+											pos: position{line: 381, col: 29, offset: 9977},
 											expr: &ruleRefExpr{
-												pos:  position{line: 316, col: 29, offset: 7624},
+												pos:  position{line: 381, col: 29, offset: 9977},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 316, col: 32, offset: 7627},
+											pos:        position{line: 381, col: 32, offset: 9980},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 316, col: 36, offset: 7631},
+											pos: position{line: 381, col: 36, offset: 9984},
 											expr: &ruleRefExpr{
-												pos:  position{line: 316, col: 36, offset: 7631},
+												pos:  position{line: 381, col: 36, offset: 9984},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 316, col: 39, offset: 7634},
+											pos:  position{line: 381, col: 39, offset: 9987},
 											name: "reducerExpr",
 										},
 									},
@@ -2323,87 +3049,99 @@ var g = &grammar{
 		},
 		{
 			name: "simpleProc",
-			pos:  position{line: 324, col: 1, offset: 7831},
+			pos:  position{line: 389, col: 1, offset: 10184},
 			expr: &choiceExpr{
-				pos: position{line: 325, col: 5, offset: 7846},
+				pos: position{line: 390, col: 5, offset: 10199},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 325, col: 5, offset: 7846},
+						pos:  position{line: 390, col: 5, offset: 10199},
 						name: "sort",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 326, col: 5, offset: 7855},
+						pos:  position{line: 391, col: 5, offset: 10208},
 						name: "top",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 327, col: 5, offset: 7863},
+						pos:  position{line: 392, col: 5, offset: 10216},
 						name: "cut",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 328, col: 5, offset: 7871},
+						pos:  position{line: 393, col: 5, offset: 10224},
+						name: "drop",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 394, col: 5, offset: 10233},
+						name: "rename",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 395, col: 5, offset: 10244},
 						name: "head",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 329, col: 5, offset: 7880},
+						pos:  position{line: 396, col: 5, offset: 10253},
 						name: "tail",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 330, col: 5, offset: 7889},
+						pos:  position{line: 397, col: 5, offset: 10262},
 						name: "filter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 5, offset: 7900},
+						pos:  position{line: 398, col: 5, offset: 10273},
 						name: "uniq",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 5, offset: 7909},
+						pos:  position{line: 399, col: 5, offset: 10282},
 						name: "put",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 400, col: 5, offset: 10290},
+						name: "join",
+					},
 				},
 			},
 		},
 		{
 			name: "sort",
-			pos:  position{line: 334, col: 1, offset: 7914},
+			pos:  position{line: 402, col: 1, offset: 10296},
 			expr: &actionExpr{
-				pos: position{line: 335, col: 5, offset: 7923},
+				pos: position{line: 403, col: 5, offset: 10305},
 				run: (*parser).callonsort1,
 				expr: &seqExpr{
-					pos: position{line: 335, col: 5, offset: 7923},
+					pos: position{line: 403, col: 5, offset: 10305},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 335, col: 5, offset: 7923},
+							pos:        position{line: 403, col: 5, offset: 10305},
 							val:        "sort",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 335, col: 13, offset: 7931},
+							pos:   position{line: 403, col: 13, offset: 10313},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 335, col: 18, offset: 7936},
+								pos:  position{line: 403, col: 18, offset: 10318},
 								name: "sortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 335, col: 27, offset: 7945},
+							pos:   position{line: 403, col: 27, offset: 10327},
 							label: "list",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 335, col: 32, offset: 7950},
+								pos: position{line: 403, col: 32, offset: 10332},
 								expr: &actionExpr{
-									pos: position{line: 335, col: 33, offset: 7951},
+									pos: position{line: 403, col: 33, offset: 10333},
 									run: (*parser).callonsort8,
 									expr: &seqExpr{
-										pos: position{line: 335, col: 33, offset: 7951},
+										pos: position{line: 403, col: 33, offset: 10333},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 335, col: 33, offset: 7951},
+												pos:  position{line: 403, col: 33, offset: 10333},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 335, col: 35, offset: 7953},
+												pos:   position{line: 403, col: 35, offset: 10335},
 												label: "l",
 												expr: &ruleRefExpr{
-													pos:  position{line: 335, col: 37, offset: 7955},
+													pos:  position{line: 403, col: 37, offset: 10337},
 													name: "fieldExprList",
 												},
 											},
@@ -2418,24 +3156,24 @@ var g = &grammar{
 		},
 		{
 			name: "sortArgs",
-			pos:  position{line: 339, col: 1, offset: 8032},
+			pos:  position{line: 407, col: 1, offset: 10414},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 339, col: 12, offset: 8043},
+				pos: position{line: 407, col: 12, offset: 10425},
 				expr: &actionExpr{
-					pos: position{line: 339, col: 13, offset: 8044},
+					pos: position{line: 407, col: 13, offset: 10426},
 					run: (*parser).callonsortArgs2,
 					expr: &seqExpr{
-						pos: position{line: 339, col: 13, offset: 8044},
+						pos: position{line: 407, col: 13, offset: 10426},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 339, col: 13, offset: 8044},
+								pos:  position{line: 407, col: 13, offset: 10426},
 								name: "_",
 							},
 							&labeledExpr{
-								pos:   position{line: 339, col: 15, offset: 8046},
+								pos:   position{line: 407, col: 15, offset: 10428},
 								label: "a",
 								expr: &ruleRefExpr{
-									pos:  position{line: 339, col: 17, offset: 8048},
+									pos:  position{line: 407, col: 17, offset: 10430},
 									name: "sortArg",
 								},
 							},
@@ -2446,30 +3184,30 @@ var g = &grammar{
 		},
 		{
 			name: "sortArg",
-			pos:  position{line: 341, col: 1, offset: 8077},
+			pos:  position{line: 409, col: 1, offset: 10459},
 			expr: &choiceExpr{
-				pos: position{line: 342, col: 5, offset: 8089},
+				pos: position{line: 410, col: 5, offset: 10471},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 342, col: 5, offset: 8089},
+						pos: position{line: 410, col: 5, offset: 10471},
 						run: (*parser).callonsortArg2,
 						expr: &seqExpr{
-							pos: position{line: 342, col: 5, offset: 8089},
+							pos: position{line: 410, col: 5, offset: 10471},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 342, col: 5, offset: 8089},
+									pos:        position{line: 410, col: 5, offset: 10471},
 									val:        "-limit",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 342, col: 14, offset: 8098},
+									pos:  position{line: 410, col: 14, offset: 10480},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 342, col: 16, offset: 8100},
+									pos:   position{line: 410, col: 16, offset: 10482},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 342, col: 22, offset: 8106},
+										pos:  position{line: 410, col: 22, offset: 10488},
 										name: "suint",
 									},
 								},
@@ -2477,45 +3215,45 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 343, col: 5, offset: 8156},
+						pos: position{line: 411, col: 5, offset: 10538},
 						run: (*parser).callonsortArg8,
 						expr: &litMatcher{
-							pos:        position{line: 343, col: 5, offset: 8156},
+							pos:        position{line: 411, col: 5, offset: 10538},
 							val:        "-r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 344, col: 5, offset: 8199},
+						pos: position{line: 412, col: 5, offset: 10581},
 						run: (*parser).callonsortArg10,
 						expr: &seqExpr{
-							pos: position{line: 344, col: 5, offset: 8199},
+							pos: position{line: 412, col: 5, offset: 10581},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 344, col: 5, offset: 8199},
+									pos:        position{line: 412, col: 5, offset: 10581},
 									val:        "-nulls",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 344, col: 14, offset: 8208},
+									pos:  position{line: 412, col: 14, offset: 10590},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 344, col: 16, offset: 8210},
+									pos:   position{line: 412, col: 16, offset: 10592},
 									label: "where",
 									expr: &actionExpr{
-										pos: position{line: 344, col: 23, offset: 8217},
+										pos: position{line: 412, col: 23, offset: 10599},
 										run: (*parser).callonsortArg15,
 										expr: &choiceExpr{
-											pos: position{line: 344, col: 24, offset: 8218},
+											pos: position{line: 412, col: 24, offset: 10600},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 344, col: 24, offset: 8218},
+													pos:        position{line: 412, col: 24, offset: 10600},
 													val:        "first",
 													ignoreCase: false,
 												},
 												&litMatcher{
-													pos:        position{line: 344, col: 34, offset: 8228},
+													pos:        position{line: 412, col: 34, offset: 10610},
 													val:        "last",
 													ignoreCase: false,
 												},
@@ -2531,38 +3269,38 @@ var g = &grammar{
 		},
 		{
 			name: "top",
-			pos:  position{line: 346, col: 1, offset: 8310},
+			pos:  position{line: 414, col: 1, offset: 10692},
 			expr: &actionExpr{
-				pos: position{line: 347, col: 5, offset: 8318},
+				pos: position{line: 415, col: 5, offset: 10700},
 				run: (*parser).callontop1,
 				expr: &seqExpr{
-					pos: position{line: 347, col: 5, offset: 8318},
+					pos: position{line: 415, col: 5, offset: 10700},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 347, col: 5, offset: 8318},
+							pos:        position{line: 415, col: 5, offset: 10700},
 							val:        "top",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 347, col: 12, offset: 8325},
+							pos:   position{line: 415, col: 12, offset: 10707},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 347, col: 18, offset: 8331},
+								pos: position{line: 415, col: 18, offset: 10713},
 								expr: &actionExpr{
-									pos: position{line: 347, col: 19, offset: 8332},
+									pos: position{line: 415, col: 19, offset: 10714},
 									run: (*parser).callontop6,
 									expr: &seqExpr{
-										pos: position{line: 347, col: 19, offset: 8332},
+										pos: position{line: 415, col: 19, offset: 10714},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 347, col: 19, offset: 8332},
+												pos:  position{line: 415, col: 19, offset: 10714},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 347, col: 21, offset: 8334},
+												pos:   position{line: 415, col: 21, offset: 10716},
 												label: "n",
 												expr: &ruleRefExpr{
-													pos:  position{line: 347, col: 23, offset: 8336},
+													pos:  position{line: 415, col: 23, offset: 10718},
 													name: "unsignedInteger",
 												},
 											},
@@ -2572,19 +3310,19 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 347, col: 58, offset: 8371},
+							pos:   position{line: 415, col: 58, offset: 10753},
 							label: "flush",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 347, col: 64, offset: 8377},
+								pos: position{line: 415, col: 64, offset: 10759},
 								expr: &seqExpr{
-									pos: position{line: 347, col: 65, offset: 8378},
+									pos: position{line: 415, col: 65, offset: 10760},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 347, col: 65, offset: 8378},
+											pos:  position{line: 415, col: 65, offset: 10760},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 347, col: 67, offset: 8380},
+											pos:        position{line: 415, col: 67, offset: 10762},
 											val:        "-flush",
 											ignoreCase: false,
 										},
@@ -2593,25 +3331,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 347, col: 78, offset: 8391},
+							pos:   position{line: 415, col: 78, offset: 10773},
 							label: "list",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 347, col: 83, offset: 8396},
+								pos: position{line: 415, col: 83, offset: 10778},
 								expr: &actionExpr{
-									pos: position{line: 347, col: 84, offset: 8397},
+									pos: position{line: 415, col: 84, offset: 10779},
 									run: (*parser).callontop18,
 									expr: &seqExpr{
-										pos: position{line: 347, col: 84, offset: 8397},
+										pos: position{line: 415, col: 84, offset: 10779},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 347, col: 84, offset: 8397},
+												pos:  position{line: 415, col: 84, offset: 10779},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 347, col: 86, offset: 8399},
+												pos:   position{line: 415, col: 86, offset: 10781},
 												label: "f",
 												expr: &ruleRefExpr{
-													pos:  position{line: 347, col: 88, offset: 8401},
+													pos:  position{line: 415, col: 88, offset: 10783},
 													name: "fieldExprList",
 												},
 											},
@@ -2626,31 +3364,31 @@ var g = &grammar{
 		},
 		{
 			name: "procLimitArg",
-			pos:  position{line: 351, col: 1, offset: 8490},
+			pos:  position{line: 419, col: 1, offset: 10872},
 			expr: &actionExpr{
-				pos: position{line: 352, col: 5, offset: 8507},
+				pos: position{line: 420, col: 5, offset: 10889},
 				run: (*parser).callonprocLimitArg1,
 				expr: &seqExpr{
-					pos: position{line: 352, col: 5, offset: 8507},
+					pos: position{line: 420, col: 5, offset: 10889},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 352, col: 5, offset: 8507},
+							pos:  position{line: 420, col: 5, offset: 10889},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 352, col: 7, offset: 8509},
+							pos:        position{line: 420, col: 7, offset: 10891},
 							val:        "-limit",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 352, col: 16, offset: 8518},
+							pos:  position{line: 420, col: 16, offset: 10900},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 352, col: 18, offset: 8520},
+							pos:   position{line: 420, col: 18, offset: 10902},
 							label: "limit",
 							expr: &ruleRefExpr{
-								pos:  position{line: 352, col: 24, offset: 8526},
+								pos:  position{line: 420, col: 24, offset: 10908},
 								name: "unsignedInteger",
 							},
 						},
@@ -2660,28 +3398,179 @@ var g = &grammar{
 		},
 		{
 			name: "cut",
-			pos:  position{line: 354, col: 1, offset: 8565},
+			pos:  position{line: 422, col: 1, offset: 10947},
 			expr: &actionExpr{
-				pos: position{line: 355, col: 5, offset: 8573},
+				pos: position{line: 423, col: 5, offset: 10955},
 				run: (*parser).calloncut1,
 				expr: &seqExpr{
-					pos: position{line: 355, col: 5, offset: 8573},
+					pos: position{line: 423, col: 5, offset: 10955},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 355, col: 5, offset: 8573},
+							pos:        position{line: 423, col: 5, offset: 10955},
 							val:        "cut",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 355, col: 12, offset: 8580},
+							pos:  position{line: 423, col: 12, offset: 10962},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 423, col: 14, offset: 10964},
+							label: "list",
+							expr: &ruleRefExpr{
+								pos:  position{line: 423, col: 19, offset: 10969},
+								name: "fieldPatternList",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "drop",
+			pos:  position{line: 424, col: 1, offset: 11020},
+			expr: &actionExpr{
+				pos: position{line: 425, col: 5, offset: 11029},
+				run: (*parser).callondrop1,
+				expr: &seqExpr{
+					pos: position{line: 425, col: 5, offset: 11029},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 425, col: 5, offset: 11029},
+							val:        "drop",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 425, col: 13, offset: 11037},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 355, col: 14, offset: 8582},
+							pos:   position{line: 425, col: 15, offset: 11039},
 							label: "list",
 							expr: &ruleRefExpr{
-								pos:  position{line: 355, col: 19, offset: 8587},
-								name: "fieldRefDotOnlyList",
+								pos:  position{line: 425, col: 20, offset: 11044},
+								name: "fieldPatternList",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "rename",
+			pos:  position{line: 426, col: 1, offset: 11096},
+			expr: &actionExpr{
+				pos: position{line: 427, col: 5, offset: 11107},
+				run: (*parser).callonrename1,
+				expr: &seqExpr{
+					pos: position{line: 427, col: 5, offset: 11107},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 427, col: 5, offset: 11107},
+							val:        "rename",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 427, col: 15, offset: 11117},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 427, col: 17, offset: 11119},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 427, col: 23, offset: 11125},
+								name: "fieldAssignment",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 427, col: 39, offset: 11141},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 427, col: 44, offset: 11146},
+								expr: &actionExpr{
+									pos: position{line: 427, col: 45, offset: 11147},
+									run: (*parser).callonrename9,
+									expr: &seqExpr{
+										pos: position{line: 427, col: 45, offset: 11147},
+										exprs: []interface{}{
+											&zeroOrOneExpr{
+												pos: position{line: 427, col: 45, offset: 11147},
+												expr: &ruleRefExpr{
+													pos:  position{line: 427, col: 45, offset: 11147},
+													name: "_",
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 427, col: 48, offset: 11150},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 427, col: 52, offset: 11154},
+												expr: &ruleRefExpr{
+													pos:  position{line: 427, col: 52, offset: 11154},
+													name: "_",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 427, col: 55, offset: 11157},
+												label: "fa",
+												expr: &ruleRefExpr{
+													pos:  position{line: 427, col: 58, offset: 11160},
+													name: "fieldAssignment",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "fieldAssignment",
+			pos:  position{line: 431, col: 1, offset: 11297},
+			expr: &actionExpr{
+				pos: position{line: 432, col: 5, offset: 11317},
+				run: (*parser).callonfieldAssignment1,
+				expr: &seqExpr{
+					pos: position{line: 432, col: 5, offset: 11317},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 432, col: 5, offset: 11317},
+							label: "target",
+							expr: &ruleRefExpr{
+								pos:  position{line: 432, col: 12, offset: 11324},
+								name: "fieldRefDotOnly",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 432, col: 28, offset: 11340},
+							expr: &ruleRefExpr{
+								pos:  position{line: 432, col: 28, offset: 11340},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 432, col: 31, offset: 11343},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 432, col: 35, offset: 11347},
+							expr: &ruleRefExpr{
+								pos:  position{line: 432, col: 35, offset: 11347},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 432, col: 38, offset: 11350},
+							label: "source",
+							expr: &ruleRefExpr{
+								pos:  position{line: 432, col: 45, offset: 11357},
+								name: "fieldRefDotOnly",
 							},
 						},
 					},
@@ -2690,30 +3579,30 @@ var g = &grammar{
 		},
 		{
 			name: "head",
-			pos:  position{line: 356, col: 1, offset: 8641},
+			pos:  position{line: 436, col: 1, offset: 11436},
 			expr: &choiceExpr{
-				pos: position{line: 357, col: 5, offset: 8650},
+				pos: position{line: 437, col: 5, offset: 11445},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 357, col: 5, offset: 8650},
+						pos: position{line: 437, col: 5, offset: 11445},
 						run: (*parser).callonhead2,
 						expr: &seqExpr{
-							pos: position{line: 357, col: 5, offset: 8650},
+							pos: position{line: 437, col: 5, offset: 11445},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 357, col: 5, offset: 8650},
+									pos:        position{line: 437, col: 5, offset: 11445},
 									val:        "head",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 357, col: 13, offset: 8658},
+									pos:  position{line: 437, col: 13, offset: 11453},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 357, col: 15, offset: 8660},
+									pos:   position{line: 437, col: 15, offset: 11455},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 357, col: 21, offset: 8666},
+										pos:  position{line: 437, col: 21, offset: 11461},
 										name: "unsignedInteger",
 									},
 								},
@@ -2721,10 +3610,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 358, col: 5, offset: 8722},
+						pos: position{line: 438, col: 5, offset: 11517},
 						run: (*parser).callonhead8,
 						expr: &litMatcher{
-							pos:        position{line: 358, col: 5, offset: 8722},
+							pos:        position{line: 438, col: 5, offset: 11517},
 							val:        "head",
 							ignoreCase: true,
 						},
@@ -2734,30 +3623,30 @@ var g = &grammar{
 		},
 		{
 			name: "tail",
-			pos:  position{line: 359, col: 1, offset: 8762},
+			pos:  position{line: 439, col: 1, offset: 11557},
 			expr: &choiceExpr{
-				pos: position{line: 360, col: 5, offset: 8771},
+				pos: position{line: 440, col: 5, offset: 11566},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 360, col: 5, offset: 8771},
+						pos: position{line: 440, col: 5, offset: 11566},
 						run: (*parser).callontail2,
 						expr: &seqExpr{
-							pos: position{line: 360, col: 5, offset: 8771},
+							pos: position{line: 440, col: 5, offset: 11566},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 360, col: 5, offset: 8771},
+									pos:        position{line: 440, col: 5, offset: 11566},
 									val:        "tail",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 360, col: 13, offset: 8779},
+									pos:  position{line: 440, col: 13, offset: 11574},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 360, col: 15, offset: 8781},
+									pos:   position{line: 440, col: 15, offset: 11576},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 360, col: 21, offset: 8787},
+										pos:  position{line: 440, col: 21, offset: 11582},
 										name: "unsignedInteger",
 									},
 								},
@@ -2765,10 +3654,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 361, col: 5, offset: 8843},
+						pos: position{line: 441, col: 5, offset: 11638},
 						run: (*parser).callontail8,
 						expr: &litMatcher{
-							pos:        position{line: 361, col: 5, offset: 8843},
+							pos:        position{line: 441, col: 5, offset: 11638},
 							val:        "tail",
 							ignoreCase: true,
 						},
@@ -2778,27 +3667,27 @@ var g = &grammar{
 		},
 		{
 			name: "filter",
-			pos:  position{line: 363, col: 1, offset: 8884},
+			pos:  position{line: 443, col: 1, offset: 11679},
 			expr: &actionExpr{
-				pos: position{line: 364, col: 5, offset: 8895},
+				pos: position{line: 444, col: 5, offset: 11690},
 				run: (*parser).callonfilter1,
 				expr: &seqExpr{
-					pos: position{line: 364, col: 5, offset: 8895},
+					pos: position{line: 444, col: 5, offset: 11690},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 364, col: 5, offset: 8895},
+							pos:        position{line: 444, col: 5, offset: 11690},
 							val:        "filter",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 364, col: 15, offset: 8905},
+							pos:  position{line: 444, col: 15, offset: 11700},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 364, col: 17, offset: 8907},
+							pos:   position{line: 444, col: 17, offset: 11702},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 364, col: 22, offset: 8912},
+								pos:  position{line: 444, col: 22, offset: 11707},
 								name: "searchExpr",
 							},
 						},
@@ -2808,27 +3697,27 @@ var g = &grammar{
 		},
 		{
 			name: "uniq",
-			pos:  position{line: 367, col: 1, offset: 8970},
+			pos:  position{line: 447, col: 1, offset: 11765},
 			expr: &choiceExpr{
-				pos: position{line: 368, col: 5, offset: 8979},
+				pos: position{line: 448, col: 5, offset: 11774},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 368, col: 5, offset: 8979},
+						pos: position{line: 448, col: 5, offset: 11774},
 						run: (*parser).callonuniq2,
 						expr: &seqExpr{
-							pos: position{line: 368, col: 5, offset: 8979},
+							pos: position{line: 448, col: 5, offset: 11774},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 368, col: 5, offset: 8979},
+									pos:        position{line: 448, col: 5, offset: 11774},
 									val:        "uniq",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 368, col: 13, offset: 8987},
+									pos:  position{line: 448, col: 13, offset: 11782},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 368, col: 15, offset: 8989},
+									pos:        position{line: 448, col: 15, offset: 11784},
 									val:        "-c",
 									ignoreCase: false,
 								},
@@ -2836,10 +3725,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 371, col: 5, offset: 9043},
+						pos: position{line: 451, col: 5, offset: 11838},
 						run: (*parser).callonuniq7,
 						expr: &litMatcher{
-							pos:        position{line: 371, col: 5, offset: 9043},
+							pos:        position{line: 451, col: 5, offset: 11838},
 							val:        "uniq",
 							ignoreCase: true,
 						},
@@ -2849,48 +3738,48 @@ var g = &grammar{
 		},
 		{
 			name: "put",
-			pos:  position{line: 375, col: 1, offset: 9098},
+			pos:  position{line: 455, col: 1, offset: 11893},
 			expr: &actionExpr{
-				pos: position{line: 376, col: 5, offset: 9106},
+				pos: position{line: 456, col: 5, offset: 11901},
 				run: (*parser).callonput1,
 				expr: &seqExpr{
-					pos: position{line: 376, col: 5, offset: 9106},
+					pos: position{line: 456, col: 5, offset: 11901},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 376, col: 5, offset: 9106},
+							pos:        position{line: 456, col: 5, offset: 11901},
 							val:        "put",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 376, col: 12, offset: 9113},
+							pos:  position{line: 456, col: 12, offset: 11908},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 376, col: 14, offset: 9115},
+							pos:   position{line: 456, col: 14, offset: 11910},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 376, col: 16, offset: 9117},
+								pos:  position{line: 456, col: 16, offset: 11912},
 								name: "fieldName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 376, col: 26, offset: 9127},
+							pos:  position{line: 456, col: 26, offset: 11922},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 376, col: 29, offset: 9130},
+							pos:        position{line: 456, col: 29, offset: 11925},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 376, col: 33, offset: 9134},
+							pos:  position{line: 456, col: 33, offset: 11929},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 376, col: 36, offset: 9137},
+							pos:   position{line: 456, col: 36, offset: 11932},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 376, col: 38, offset: 9139},
+								pos:  position{line: 456, col: 38, offset: 11934},
 								name: "Expression",
 							},
 						},
@@ -2898,81 +3787,221 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "join",
+			pos:  position{line: 460, col: 1, offset: 11990},
+			expr: &actionExpr{
+				pos: position{line: 461, col: 5, offset: 11999},
+				run: (*parser).callonjoin1,
+				expr: &seqExpr{
+					pos: position{line: 461, col: 5, offset: 11999},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 461, col: 5, offset: 11999},
+							val:        "join",
+							ignoreCase: true,
+						},
+						&labeledExpr{
+							pos:   position{line: 461, col: 13, offset: 12007},
+							label: "kind",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 461, col: 18, offset: 12012},
+								expr: &actionExpr{
+									pos: position{line: 461, col: 19, offset: 12013},
+									run: (*parser).callonjoin6,
+									expr: &seqExpr{
+										pos: position{line: 461, col: 19, offset: 12013},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 461, col: 19, offset: 12013},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 461, col: 21, offset: 12015},
+												label: "k",
+												expr: &ruleRefExpr{
+													pos:  position{line: 461, col: 23, offset: 12017},
+													name: "joinKind",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 461, col: 52, offset: 12046},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 461, col: 54, offset: 12048},
+							label: "leftKey",
+							expr: &ruleRefExpr{
+								pos:  position{line: 461, col: 62, offset: 12056},
+								name: "fieldExpr",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 461, col: 72, offset: 12066},
+							expr: &ruleRefExpr{
+								pos:  position{line: 461, col: 72, offset: 12066},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 461, col: 75, offset: 12069},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 461, col: 79, offset: 12073},
+							expr: &ruleRefExpr{
+								pos:  position{line: 461, col: 79, offset: 12073},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 461, col: 82, offset: 12076},
+							label: "rightKey",
+							expr: &ruleRefExpr{
+								pos:  position{line: 461, col: 91, offset: 12085},
+								name: "fieldExpr",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 461, col: 101, offset: 12095},
+							label: "list",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 461, col: 106, offset: 12100},
+								expr: &actionExpr{
+									pos: position{line: 461, col: 107, offset: 12101},
+									run: (*parser).callonjoin23,
+									expr: &seqExpr{
+										pos: position{line: 461, col: 107, offset: 12101},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 461, col: 107, offset: 12101},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 461, col: 109, offset: 12103},
+												label: "l",
+												expr: &ruleRefExpr{
+													pos:  position{line: 461, col: 111, offset: 12105},
+													name: "fieldRefDotOnlyList",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "joinKind",
+			pos:  position{line: 465, col: 1, offset: 12216},
+			expr: &choiceExpr{
+				pos: position{line: 466, col: 5, offset: 12229},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 466, col: 5, offset: 12229},
+						run: (*parser).callonjoinKind2,
+						expr: &litMatcher{
+							pos:        position{line: 466, col: 5, offset: 12229},
+							val:        "-inner",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 467, col: 5, offset: 12266},
+						run: (*parser).callonjoinKind4,
+						expr: &litMatcher{
+							pos:        position{line: 467, col: 5, offset: 12266},
+							val:        "-left",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "PrimaryExpression",
-			pos:  position{line: 380, col: 1, offset: 9195},
+			pos:  position{line: 469, col: 1, offset: 12298},
 			expr: &choiceExpr{
-				pos: position{line: 381, col: 5, offset: 9217},
+				pos: position{line: 470, col: 5, offset: 12320},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 381, col: 5, offset: 9217},
+						pos:  position{line: 470, col: 5, offset: 12320},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 382, col: 5, offset: 9235},
+						pos:  position{line: 471, col: 5, offset: 12338},
 						name: "RegexpLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 383, col: 5, offset: 9253},
+						pos:  position{line: 472, col: 5, offset: 12356},
 						name: "PortLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 384, col: 5, offset: 9269},
+						pos:  position{line: 473, col: 5, offset: 12372},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 385, col: 5, offset: 9287},
+						pos:  position{line: 474, col: 5, offset: 12390},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 386, col: 5, offset: 9306},
+						pos:  position{line: 475, col: 5, offset: 12409},
 						name: "FloatLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 387, col: 5, offset: 9323},
+						pos:  position{line: 476, col: 5, offset: 12426},
 						name: "IntegerLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 388, col: 5, offset: 9342},
+						pos:  position{line: 477, col: 5, offset: 12445},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 389, col: 5, offset: 9361},
+						pos:  position{line: 478, col: 5, offset: 12464},
 						name: "NullLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 390, col: 5, offset: 9377},
+						pos:  position{line: 479, col: 5, offset: 12480},
 						name: "FieldReference",
 					},
 					&actionExpr{
-						pos: position{line: 391, col: 5, offset: 9396},
+						pos: position{line: 480, col: 5, offset: 12499},
 						run: (*parser).callonPrimaryExpression12,
 						expr: &seqExpr{
-							pos: position{line: 391, col: 5, offset: 9396},
+							pos: position{line: 480, col: 5, offset: 12499},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 391, col: 5, offset: 9396},
+									pos:        position{line: 480, col: 5, offset: 12499},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 391, col: 9, offset: 9400},
+									pos:  position{line: 480, col: 9, offset: 12503},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 391, col: 12, offset: 9403},
+									pos:   position{line: 480, col: 12, offset: 12506},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 391, col: 17, offset: 9408},
+										pos:  position{line: 480, col: 17, offset: 12511},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 391, col: 28, offset: 9419},
+									pos:  position{line: 480, col: 28, offset: 12522},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 391, col: 31, offset: 9422},
+									pos:        position{line: 480, col: 31, offset: 12525},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2984,15 +4013,15 @@ var g = &grammar{
 		},
 		{
 			name: "FieldReference",
-			pos:  position{line: 393, col: 1, offset: 9448},
+			pos:  position{line: 482, col: 1, offset: 12551},
 			expr: &actionExpr{
-				pos: position{line: 394, col: 5, offset: 9467},
+				pos: position{line: 483, col: 5, offset: 12570},
 				run: (*parser).callonFieldReference1,
 				expr: &labeledExpr{
-					pos:   position{line: 394, col: 5, offset: 9467},
+					pos:   position{line: 483, col: 5, offset: 12570},
 					label: "f",
 					expr: &ruleRefExpr{
-						pos:  position{line: 394, col: 7, offset: 9469},
+						pos:  position{line: 483, col: 7, offset: 12572},
 						name: "fieldName",
 					},
 				},
@@ -3000,71 +4029,71 @@ var g = &grammar{
 		},
 		{
 			name: "Expression",
-			pos:  position{line: 404, col: 1, offset: 9718},
+			pos:  position{line: 493, col: 1, offset: 12821},
 			expr: &ruleRefExpr{
-				pos:  position{line: 404, col: 14, offset: 9731},
+				pos:  position{line: 493, col: 14, offset: 12834},
 				name: "ConditionalExpression",
 			},
 		},
 		{
 			name: "ConditionalExpression",
-			pos:  position{line: 406, col: 1, offset: 9754},
+			pos:  position{line: 495, col: 1, offset: 12857},
 			expr: &choiceExpr{
-				pos: position{line: 407, col: 5, offset: 9780},
+				pos: position{line: 496, col: 5, offset: 12883},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 407, col: 5, offset: 9780},
+						pos: position{line: 496, col: 5, offset: 12883},
 						run: (*parser).callonConditionalExpression2,
 						expr: &seqExpr{
-							pos: position{line: 407, col: 5, offset: 9780},
+							pos: position{line: 496, col: 5, offset: 12883},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 407, col: 5, offset: 9780},
+									pos:   position{line: 496, col: 5, offset: 12883},
 									label: "condition",
 									expr: &ruleRefExpr{
-										pos:  position{line: 407, col: 15, offset: 9790},
+										pos:  position{line: 496, col: 15, offset: 12893},
 										name: "LogicalORExpression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 407, col: 35, offset: 9810},
+									pos:  position{line: 496, col: 35, offset: 12913},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 407, col: 38, offset: 9813},
+									pos:        position{line: 496, col: 38, offset: 12916},
 									val:        "?",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 407, col: 42, offset: 9817},
+									pos:  position{line: 496, col: 42, offset: 12920},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 407, col: 45, offset: 9820},
+									pos:   position{line: 496, col: 45, offset: 12923},
 									label: "thenClause",
 									expr: &ruleRefExpr{
-										pos:  position{line: 407, col: 56, offset: 9831},
+										pos:  position{line: 496, col: 56, offset: 12934},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 407, col: 67, offset: 9842},
+									pos:  position{line: 496, col: 67, offset: 12945},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 407, col: 70, offset: 9845},
+									pos:        position{line: 496, col: 70, offset: 12948},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 407, col: 74, offset: 9849},
+									pos:  position{line: 496, col: 74, offset: 12952},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 407, col: 77, offset: 9852},
+									pos:   position{line: 496, col: 77, offset: 12955},
 									label: "elseClause",
 									expr: &ruleRefExpr{
-										pos:  position{line: 407, col: 88, offset: 9863},
+										pos:  position{line: 496, col: 88, offset: 12966},
 										name: "Expression",
 									},
 								},
@@ -3072,7 +4101,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 410, col: 5, offset: 9955},
+						pos:  position{line: 499, col: 5, offset: 13058},
 						name: "LogicalORExpression",
 					},
 				},
@@ -3080,43 +4109,43 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalORExpression",
-			pos:  position{line: 412, col: 1, offset: 9976},
+			pos:  position{line: 501, col: 1, offset: 13079},
 			expr: &actionExpr{
-				pos: position{line: 413, col: 5, offset: 10000},
+				pos: position{line: 502, col: 5, offset: 13103},
 				run: (*parser).callonLogicalORExpression1,
 				expr: &seqExpr{
-					pos: position{line: 413, col: 5, offset: 10000},
+					pos: position{line: 502, col: 5, offset: 13103},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 413, col: 5, offset: 10000},
+							pos:   position{line: 502, col: 5, offset: 13103},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 413, col: 11, offset: 10006},
+								pos:  position{line: 502, col: 11, offset: 13109},
 								name: "LogicalANDExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 414, col: 5, offset: 10031},
+							pos:   position{line: 503, col: 5, offset: 13134},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 414, col: 10, offset: 10036},
+								pos: position{line: 503, col: 10, offset: 13139},
 								expr: &seqExpr{
-									pos: position{line: 414, col: 11, offset: 10037},
+									pos: position{line: 503, col: 11, offset: 13140},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 414, col: 11, offset: 10037},
+											pos:  position{line: 503, col: 11, offset: 13140},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 414, col: 14, offset: 10040},
+											pos:  position{line: 503, col: 14, offset: 13143},
 											name: "orToken",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 414, col: 22, offset: 10048},
+											pos:  position{line: 503, col: 22, offset: 13151},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 414, col: 25, offset: 10051},
+											pos:  position{line: 503, col: 25, offset: 13154},
 											name: "LogicalANDExpression",
 										},
 									},
@@ -3129,43 +4158,43 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalANDExpression",
-			pos:  position{line: 418, col: 1, offset: 10136},
+			pos:  position{line: 507, col: 1, offset: 13239},
 			expr: &actionExpr{
-				pos: position{line: 419, col: 5, offset: 10161},
+				pos: position{line: 508, col: 5, offset: 13264},
 				run: (*parser).callonLogicalANDExpression1,
 				expr: &seqExpr{
-					pos: position{line: 419, col: 5, offset: 10161},
+					pos: position{line: 508, col: 5, offset: 13264},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 419, col: 5, offset: 10161},
+							pos:   position{line: 508, col: 5, offset: 13264},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 419, col: 11, offset: 10167},
+								pos:  position{line: 508, col: 11, offset: 13270},
 								name: "EqualityCompareExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 420, col: 5, offset: 10197},
+							pos:   position{line: 509, col: 5, offset: 13300},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 420, col: 10, offset: 10202},
+								pos: position{line: 509, col: 10, offset: 13305},
 								expr: &seqExpr{
-									pos: position{line: 420, col: 11, offset: 10203},
+									pos: position{line: 509, col: 11, offset: 13306},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 420, col: 11, offset: 10203},
+											pos:  position{line: 509, col: 11, offset: 13306},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 420, col: 14, offset: 10206},
+											pos:  position{line: 509, col: 14, offset: 13309},
 											name: "andToken",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 420, col: 23, offset: 10215},
+											pos:  position{line: 509, col: 23, offset: 13318},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 420, col: 26, offset: 10218},
+											pos:  position{line: 509, col: 26, offset: 13321},
 											name: "EqualityCompareExpression",
 										},
 									},
@@ -3178,43 +4207,43 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityCompareExpression",
-			pos:  position{line: 424, col: 1, offset: 10308},
+			pos:  position{line: 513, col: 1, offset: 13411},
 			expr: &actionExpr{
-				pos: position{line: 425, col: 5, offset: 10338},
+				pos: position{line: 514, col: 5, offset: 13441},
 				run: (*parser).callonEqualityCompareExpression1,
 				expr: &seqExpr{
-					pos: position{line: 425, col: 5, offset: 10338},
+					pos: position{line: 514, col: 5, offset: 13441},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 425, col: 5, offset: 10338},
+							pos:   position{line: 514, col: 5, offset: 13441},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 425, col: 11, offset: 10344},
+								pos:  position{line: 514, col: 11, offset: 13447},
 								name: "RelativeExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 426, col: 5, offset: 10367},
+							pos:   position{line: 515, col: 5, offset: 13470},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 426, col: 10, offset: 10372},
+								pos: position{line: 515, col: 10, offset: 13475},
 								expr: &seqExpr{
-									pos: position{line: 426, col: 11, offset: 10373},
+									pos: position{line: 515, col: 11, offset: 13476},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 426, col: 11, offset: 10373},
+											pos:  position{line: 515, col: 11, offset: 13476},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 426, col: 14, offset: 10376},
+											pos:  position{line: 515, col: 14, offset: 13479},
 											name: "EqualityComparator",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 426, col: 33, offset: 10395},
+											pos:  position{line: 515, col: 33, offset: 13498},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 426, col: 36, offset: 10398},
+											pos:  position{line: 515, col: 36, offset: 13501},
 											name: "RelativeExpression",
 										},
 									},
@@ -3227,20 +4256,20 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityOperator",
-			pos:  position{line: 430, col: 1, offset: 10481},
+			pos:  position{line: 519, col: 1, offset: 13584},
 			expr: &actionExpr{
-				pos: position{line: 430, col: 20, offset: 10500},
+				pos: position{line: 519, col: 20, offset: 13603},
 				run: (*parser).callonEqualityOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 430, col: 21, offset: 10501},
+					pos: position{line: 519, col: 21, offset: 13604},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 430, col: 21, offset: 10501},
+							pos:        position{line: 519, col: 21, offset: 13604},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 430, col: 27, offset: 10507},
+							pos:        position{line: 519, col: 27, offset: 13610},
 							val:        "!=",
 							ignoreCase: false,
 						},
@@ -3250,19 +4279,19 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityComparator",
-			pos:  position{line: 432, col: 1, offset: 10545},
+			pos:  position{line: 521, col: 1, offset: 13648},
 			expr: &choiceExpr{
-				pos: position{line: 433, col: 5, offset: 10568},
+				pos: position{line: 522, col: 5, offset: 13671},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 433, col: 5, offset: 10568},
+						pos:  position{line: 522, col: 5, offset: 13671},
 						name: "EqualityOperator",
 					},
 					&actionExpr{
-						pos: position{line: 434, col: 5, offset: 10589},
+						pos: position{line: 523, col: 5, offset: 13692},
 						run: (*parser).callonEqualityComparator3,
 						expr: &litMatcher{
-							pos:        position{line: 434, col: 5, offset: 10589},
+							pos:        position{line: 523, col: 5, offset: 13692},
 							val:        "in",
 							ignoreCase: false,
 						},
@@ -3272,43 +4301,43 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeExpression",
-			pos:  position{line: 436, col: 1, offset: 10626},
+			pos:  position{line: 525, col: 1, offset: 13729},
 			expr: &actionExpr{
-				pos: position{line: 437, col: 5, offset: 10649},
+				pos: position{line: 526, col: 5, offset: 13752},
 				run: (*parser).callonRelativeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 437, col: 5, offset: 10649},
+					pos: position{line: 526, col: 5, offset: 13752},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 437, col: 5, offset: 10649},
+							pos:   position{line: 526, col: 5, offset: 13752},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 437, col: 11, offset: 10655},
+								pos:  position{line: 526, col: 11, offset: 13758},
 								name: "AdditiveExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 438, col: 5, offset: 10678},
+							pos:   position{line: 527, col: 5, offset: 13781},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 438, col: 10, offset: 10683},
+								pos: position{line: 527, col: 10, offset: 13786},
 								expr: &seqExpr{
-									pos: position{line: 438, col: 11, offset: 10684},
+									pos: position{line: 527, col: 11, offset: 13787},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 438, col: 11, offset: 10684},
+											pos:  position{line: 527, col: 11, offset: 13787},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 438, col: 14, offset: 10687},
+											pos:  position{line: 527, col: 14, offset: 13790},
 											name: "RelativeOperator",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 438, col: 31, offset: 10704},
+											pos:  position{line: 527, col: 31, offset: 13807},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 438, col: 34, offset: 10707},
+											pos:  position{line: 527, col: 34, offset: 13810},
 											name: "AdditiveExpression",
 										},
 									},
@@ -3321,30 +4350,30 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeOperator",
-			pos:  position{line: 442, col: 1, offset: 10790},
+			pos:  position{line: 531, col: 1, offset: 13893},
 			expr: &actionExpr{
-				pos: position{line: 442, col: 20, offset: 10809},
+				pos: position{line: 531, col: 20, offset: 13912},
 				run: (*parser).callonRelativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 442, col: 21, offset: 10810},
+					pos: position{line: 531, col: 21, offset: 13913},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 442, col: 21, offset: 10810},
+							pos:        position{line: 531, col: 21, offset: 13913},
 							val:        "<=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 442, col: 28, offset: 10817},
+							pos:        position{line: 531, col: 28, offset: 13920},
 							val:        "<",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 442, col: 34, offset: 10823},
+							pos:        position{line: 531, col: 34, offset: 13926},
 							val:        ">=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 442, col: 41, offset: 10830},
+							pos:        position{line: 531, col: 41, offset: 13933},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -3354,43 +4383,43 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveExpression",
-			pos:  position{line: 444, col: 1, offset: 10867},
+			pos:  position{line: 533, col: 1, offset: 13970},
 			expr: &actionExpr{
-				pos: position{line: 445, col: 5, offset: 10890},
+				pos: position{line: 534, col: 5, offset: 13993},
 				run: (*parser).callonAdditiveExpression1,
 				expr: &seqExpr{
-					pos: position{line: 445, col: 5, offset: 10890},
+					pos: position{line: 534, col: 5, offset: 13993},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 445, col: 5, offset: 10890},
+							pos:   position{line: 534, col: 5, offset: 13993},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 445, col: 11, offset: 10896},
+								pos:  position{line: 534, col: 11, offset: 13999},
 								name: "MultiplicativeExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 446, col: 5, offset: 10925},
+							pos:   position{line: 535, col: 5, offset: 14028},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 446, col: 10, offset: 10930},
+								pos: position{line: 535, col: 10, offset: 14033},
 								expr: &seqExpr{
-									pos: position{line: 446, col: 11, offset: 10931},
+									pos: position{line: 535, col: 11, offset: 14034},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 446, col: 11, offset: 10931},
+											pos:  position{line: 535, col: 11, offset: 14034},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 446, col: 14, offset: 10934},
+											pos:  position{line: 535, col: 14, offset: 14037},
 											name: "AdditiveOperator",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 446, col: 31, offset: 10951},
+											pos:  position{line: 535, col: 31, offset: 14054},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 446, col: 34, offset: 10954},
+											pos:  position{line: 535, col: 34, offset: 14057},
 											name: "MultiplicativeExpression",
 										},
 									},
@@ -3403,20 +4432,20 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveOperator",
-			pos:  position{line: 450, col: 1, offset: 11043},
+			pos:  position{line: 539, col: 1, offset: 14146},
 			expr: &actionExpr{
-				pos: position{line: 450, col: 20, offset: 11062},
+				pos: position{line: 539, col: 20, offset: 14165},
 				run: (*parser).callonAdditiveOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 450, col: 21, offset: 11063},
+					pos: position{line: 539, col: 21, offset: 14166},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 450, col: 21, offset: 11063},
+							pos:        position{line: 539, col: 21, offset: 14166},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 450, col: 27, offset: 11069},
+							pos:        position{line: 539, col: 27, offset: 14172},
 							val:        "-",
 							ignoreCase: false,
 						},
@@ -3426,50 +4455,50 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeExpression",
-			pos:  position{line: 452, col: 1, offset: 11106},
+			pos:  position{line: 541, col: 1, offset: 14209},
 			expr: &actionExpr{
-				pos: position{line: 453, col: 5, offset: 11135},
+				pos: position{line: 542, col: 5, offset: 14238},
 				run: (*parser).callonMultiplicativeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 453, col: 5, offset: 11135},
+					pos: position{line: 542, col: 5, offset: 14238},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 453, col: 5, offset: 11135},
+							pos:   position{line: 542, col: 5, offset: 14238},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 453, col: 11, offset: 11141},
+								pos:  position{line: 542, col: 11, offset: 14244},
 								name: "NotExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 454, col: 5, offset: 11159},
+							pos:   position{line: 543, col: 5, offset: 14262},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 454, col: 10, offset: 11164},
+								pos: position{line: 543, col: 10, offset: 14267},
 								expr: &seqExpr{
-									pos: position{line: 454, col: 11, offset: 11165},
+									pos: position{line: 543, col: 11, offset: 14268},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 454, col: 11, offset: 11165},
+											pos:  position{line: 543, col: 11, offset: 14268},
 											name: "__",
 										},
 										&labeledExpr{
-											pos:   position{line: 454, col: 14, offset: 11168},
+											pos:   position{line: 543, col: 14, offset: 14271},
 											label: "op",
 											expr: &ruleRefExpr{
-												pos:  position{line: 454, col: 17, offset: 11171},
+												pos:  position{line: 543, col: 17, offset: 14274},
 												name: "MultiplicativeOperator",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 454, col: 40, offset: 11194},
+											pos:  position{line: 543, col: 40, offset: 14297},
 											name: "__",
 										},
 										&labeledExpr{
-											pos:   position{line: 454, col: 43, offset: 11197},
+											pos:   position{line: 543, col: 43, offset: 14300},
 											label: "operand",
 											expr: &ruleRefExpr{
-												pos:  position{line: 454, col: 51, offset: 11205},
+												pos:  position{line: 543, col: 51, offset: 14308},
 												name: "NotExpression",
 											},
 										},
@@ -3483,20 +4512,20 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeOperator",
-			pos:  position{line: 458, col: 1, offset: 11283},
+			pos:  position{line: 547, col: 1, offset: 14386},
 			expr: &actionExpr{
-				pos: position{line: 458, col: 26, offset: 11308},
+				pos: position{line: 547, col: 26, offset: 14411},
 				run: (*parser).callonMultiplicativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 458, col: 27, offset: 11309},
+					pos: position{line: 547, col: 27, offset: 14412},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 458, col: 27, offset: 11309},
+							pos:        position{line: 547, col: 27, offset: 14412},
 							val:        "*",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 458, col: 33, offset: 11315},
+							pos:        position{line: 547, col: 33, offset: 14418},
 							val:        "/",
 							ignoreCase: false,
 						},
@@ -3506,30 +4535,30 @@ var g = &grammar{
 		},
 		{
 			name: "NotExpression",
-			pos:  position{line: 460, col: 1, offset: 11352},
+			pos:  position{line: 549, col: 1, offset: 14455},
 			expr: &choiceExpr{
-				pos: position{line: 461, col: 5, offset: 11370},
+				pos: position{line: 550, col: 5, offset: 14473},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 461, col: 5, offset: 11370},
+						pos: position{line: 550, col: 5, offset: 14473},
 						run: (*parser).callonNotExpression2,
 						expr: &seqExpr{
-							pos: position{line: 461, col: 5, offset: 11370},
+							pos: position{line: 550, col: 5, offset: 14473},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 461, col: 5, offset: 11370},
+									pos:        position{line: 550, col: 5, offset: 14473},
 									val:        "!",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 461, col: 9, offset: 11374},
+									pos:  position{line: 550, col: 9, offset: 14477},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 461, col: 12, offset: 11377},
+									pos:   position{line: 550, col: 12, offset: 14480},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 461, col: 14, offset: 11379},
+										pos:  position{line: 550, col: 14, offset: 14482},
 										name: "NotExpression",
 									},
 								},
@@ -3537,81 +4566,72 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 464, col: 5, offset: 11447},
-						name: "CallExpression",
+						pos:  position{line: 553, col: 5, offset: 14550},
+						name: "DereferenceExpression",
 					},
 				},
 			},
 		},
 		{
-			name: "CallExpression",
-			pos:  position{line: 467, col: 1, offset: 11464},
-			expr: &choiceExpr{
-				pos: position{line: 468, col: 5, offset: 11483},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 468, col: 5, offset: 11483},
-						run: (*parser).callonCallExpression2,
-						expr: &seqExpr{
-							pos: position{line: 468, col: 5, offset: 11483},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 468, col: 5, offset: 11483},
-									label: "fn",
-									expr: &ruleRefExpr{
-										pos:  position{line: 468, col: 8, offset: 11486},
-										name: "FunctionName",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 468, col: 21, offset: 11499},
-									name: "__",
-								},
-								&litMatcher{
-									pos:        position{line: 468, col: 24, offset: 11502},
-									val:        "(",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 468, col: 28, offset: 11506},
-									label: "args",
-									expr: &ruleRefExpr{
-										pos:  position{line: 468, col: 33, offset: 11511},
-										name: "ArgumentList",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 468, col: 46, offset: 11524},
-									val:        ")",
-									ignoreCase: false,
-								},
+			name: "FunctionCall",
+			pos:  position{line: 555, col: 1, offset: 14573},
+			expr: &actionExpr{
+				pos: position{line: 556, col: 5, offset: 14590},
+				run: (*parser).callonFunctionCall1,
+				expr: &seqExpr{
+					pos: position{line: 556, col: 5, offset: 14590},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 556, col: 5, offset: 14590},
+							label: "fn",
+							expr: &ruleRefExpr{
+								pos:  position{line: 556, col: 8, offset: 14593},
+								name: "FunctionName",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 556, col: 21, offset: 14606},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 556, col: 24, offset: 14609},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 556, col: 28, offset: 14613},
+							label: "args",
+							expr: &ruleRefExpr{
+								pos:  position{line: 556, col: 33, offset: 14618},
+								name: "ArgumentList",
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 556, col: 46, offset: 14631},
+							val:        ")",
+							ignoreCase: false,
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 471, col: 5, offset: 11587},
-						name: "DereferenceExpression",
-					},
 				},
 			},
 		},
 		{
 			name: "FunctionName",
-			pos:  position{line: 473, col: 1, offset: 11610},
+			pos:  position{line: 560, col: 1, offset: 14691},
 			expr: &actionExpr{
-				pos: position{line: 474, col: 5, offset: 11627},
+				pos: position{line: 561, col: 5, offset: 14708},
 				run: (*parser).callonFunctionName1,
 				expr: &seqExpr{
-					pos: position{line: 474, col: 5, offset: 11627},
+					pos: position{line: 561, col: 5, offset: 14708},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 474, col: 5, offset: 11627},
+							pos:  position{line: 561, col: 5, offset: 14708},
 							name: "FunctionNameStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 474, col: 23, offset: 11645},
+							pos: position{line: 561, col: 23, offset: 14726},
 							expr: &ruleRefExpr{
-								pos:  position{line: 474, col: 23, offset: 11645},
+								pos:  position{line: 561, col: 23, offset: 14726},
 								name: "FunctionNameRest",
 							},
 						},
@@ -3621,9 +4641,9 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionNameStart",
-			pos:  position{line: 476, col: 1, offset: 11695},
+			pos:  position{line: 563, col: 1, offset: 14776},
 			expr: &charClassMatcher{
-				pos:        position{line: 476, col: 21, offset: 11715},
+				pos:        position{line: 563, col: 21, offset: 14796},
 				val:        "[A-Za-z]",
 				ranges:     []rune{'A', 'Z', 'a', 'z'},
 				ignoreCase: false,
@@ -3632,16 +4652,16 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionNameRest",
-			pos:  position{line: 477, col: 1, offset: 11724},
+			pos:  position{line: 564, col: 1, offset: 14805},
 			expr: &choiceExpr{
-				pos: position{line: 477, col: 20, offset: 11743},
+				pos: position{line: 564, col: 20, offset: 14824},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 477, col: 20, offset: 11743},
+						pos:  position{line: 564, col: 20, offset: 14824},
 						name: "FunctionNameStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 477, col: 40, offset: 11763},
+						pos:        position{line: 564, col: 40, offset: 14844},
 						val:        "[.0-9]",
 						chars:      []rune{'.'},
 						ranges:     []rune{'0', '9'},
@@ -3653,53 +4673,53 @@ var g = &grammar{
 		},
 		{
 			name: "ArgumentList",
-			pos:  position{line: 479, col: 1, offset: 11771},
+			pos:  position{line: 566, col: 1, offset: 14852},
 			expr: &choiceExpr{
-				pos: position{line: 480, col: 5, offset: 11788},
+				pos: position{line: 567, col: 5, offset: 14869},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 480, col: 5, offset: 11788},
+						pos: position{line: 567, col: 5, offset: 14869},
 						run: (*parser).callonArgumentList2,
 						expr: &seqExpr{
-							pos: position{line: 480, col: 5, offset: 11788},
+							pos: position{line: 567, col: 5, offset: 14869},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 480, col: 5, offset: 11788},
+									pos:   position{line: 567, col: 5, offset: 14869},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 480, col: 11, offset: 11794},
+										pos:  position{line: 567, col: 11, offset: 14875},
 										name: "Expression",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 480, col: 22, offset: 11805},
+									pos:   position{line: 567, col: 22, offset: 14886},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 480, col: 27, offset: 11810},
+										pos: position{line: 567, col: 27, offset: 14891},
 										expr: &actionExpr{
-											pos: position{line: 480, col: 28, offset: 11811},
+											pos: position{line: 567, col: 28, offset: 14892},
 											run: (*parser).callonArgumentList8,
 											expr: &seqExpr{
-												pos: position{line: 480, col: 28, offset: 11811},
+												pos: position{line: 567, col: 28, offset: 14892},
 												exprs: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 480, col: 28, offset: 11811},
+														pos:  position{line: 567, col: 28, offset: 14892},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 31, offset: 11814},
+														pos:        position{line: 567, col: 31, offset: 14895},
 														val:        ",",
 														ignoreCase: false,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 480, col: 35, offset: 11818},
+														pos:  position{line: 567, col: 35, offset: 14899},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 480, col: 38, offset: 11821},
+														pos:   position{line: 567, col: 38, offset: 14902},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 480, col: 40, offset: 11823},
+															pos:  position{line: 567, col: 40, offset: 14904},
 															name: "Expression",
 														},
 													},
@@ -3712,10 +4732,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 483, col: 5, offset: 11939},
+						pos: position{line: 570, col: 5, offset: 15020},
 						run: (*parser).callonArgumentList15,
 						expr: &ruleRefExpr{
-							pos:  position{line: 483, col: 5, offset: 11939},
+							pos:  position{line: 570, col: 5, offset: 15020},
 							name: "__",
 						},
 					},
@@ -3724,88 +4744,97 @@ var g = &grammar{
 		},
 		{
 			name: "DereferenceExpression",
-			pos:  position{line: 485, col: 1, offset: 11975},
+			pos:  position{line: 572, col: 1, offset: 15056},
 			expr: &actionExpr{
-				pos: position{line: 486, col: 5, offset: 12001},
+				pos: position{line: 573, col: 5, offset: 15082},
 				run: (*parser).callonDereferenceExpression1,
 				expr: &seqExpr{
-					pos: position{line: 486, col: 5, offset: 12001},
+					pos: position{line: 573, col: 5, offset: 15082},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 486, col: 5, offset: 12001},
+							pos:   position{line: 573, col: 5, offset: 15082},
 							label: "base",
-							expr: &ruleRefExpr{
-								pos:  position{line: 486, col: 10, offset: 12006},
-								name: "PrimaryExpression",
+							expr: &choiceExpr{
+								pos: position{line: 573, col: 11, offset: 15088},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 573, col: 11, offset: 15088},
+										name: "FunctionCall",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 573, col: 26, offset: 15103},
+										name: "PrimaryExpression",
+									},
+								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 487, col: 5, offset: 12028},
+							pos:   position{line: 574, col: 5, offset: 15126},
 							label: "derefs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 487, col: 12, offset: 12035},
+								pos: position{line: 574, col: 12, offset: 15133},
 								expr: &choiceExpr{
-									pos: position{line: 488, col: 9, offset: 12045},
+									pos: position{line: 575, col: 9, offset: 15143},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 488, col: 9, offset: 12045},
+											pos: position{line: 575, col: 9, offset: 15143},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 488, col: 9, offset: 12045},
+													pos:  position{line: 575, col: 9, offset: 15143},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 488, col: 12, offset: 12048},
+													pos:        position{line: 575, col: 12, offset: 15146},
 													val:        "[",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 488, col: 16, offset: 12052},
+													pos:  position{line: 575, col: 16, offset: 15150},
 													name: "__",
 												},
 												&labeledExpr{
-													pos:   position{line: 488, col: 19, offset: 12055},
+													pos:   position{line: 575, col: 19, offset: 15153},
 													label: "index",
 													expr: &ruleRefExpr{
-														pos:  position{line: 488, col: 25, offset: 12061},
+														pos:  position{line: 575, col: 25, offset: 15159},
 														name: "Expression",
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 488, col: 36, offset: 12072},
+													pos:  position{line: 575, col: 36, offset: 15170},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 488, col: 39, offset: 12075},
+													pos:        position{line: 575, col: 39, offset: 15173},
 													val:        "]",
 													ignoreCase: false,
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 489, col: 9, offset: 12087},
+											pos: position{line: 576, col: 9, offset: 15185},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 489, col: 9, offset: 12087},
+													pos:  position{line: 576, col: 9, offset: 15185},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 489, col: 12, offset: 12090},
+													pos:        position{line: 576, col: 12, offset: 15188},
 													val:        ".",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 489, col: 16, offset: 12094},
+													pos:  position{line: 576, col: 16, offset: 15192},
 													name: "__",
 												},
 												&actionExpr{
-													pos: position{line: 489, col: 20, offset: 12098},
-													run: (*parser).callonDereferenceExpression20,
+													pos: position{line: 576, col: 20, offset: 15196},
+													run: (*parser).callonDereferenceExpression22,
 													expr: &labeledExpr{
-														pos:   position{line: 489, col: 20, offset: 12098},
+														pos:   position{line: 576, col: 20, offset: 15196},
 														label: "field",
 														expr: &ruleRefExpr{
-															pos:  position{line: 489, col: 26, offset: 12104},
+															pos:  position{line: 576, col: 26, offset: 15202},
 															name: "fieldName",
 														},
 													},
@@ -3822,54 +4851,54 @@ var g = &grammar{
 		},
 		{
 			name: "duration",
-			pos:  position{line: 494, col: 1, offset: 12239},
+			pos:  position{line: 581, col: 1, offset: 15337},
 			expr: &choiceExpr{
-				pos: position{line: 495, col: 5, offset: 12252},
+				pos: position{line: 582, col: 5, offset: 15350},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 495, col: 5, offset: 12252},
+						pos:  position{line: 582, col: 5, offset: 15350},
 						name: "seconds",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 496, col: 5, offset: 12264},
+						pos:  position{line: 583, col: 5, offset: 15362},
 						name: "minutes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 497, col: 5, offset: 12276},
+						pos:  position{line: 584, col: 5, offset: 15374},
 						name: "hours",
 					},
 					&seqExpr{
-						pos: position{line: 498, col: 5, offset: 12286},
+						pos: position{line: 585, col: 5, offset: 15384},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 498, col: 5, offset: 12286},
+								pos:  position{line: 585, col: 5, offset: 15384},
 								name: "hours",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 498, col: 11, offset: 12292},
+								pos:  position{line: 585, col: 11, offset: 15390},
 								name: "_",
 							},
 							&litMatcher{
-								pos:        position{line: 498, col: 13, offset: 12294},
+								pos:        position{line: 585, col: 13, offset: 15392},
 								val:        "and",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 498, col: 19, offset: 12300},
+								pos:  position{line: 585, col: 19, offset: 15398},
 								name: "_",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 498, col: 21, offset: 12302},
+								pos:  position{line: 585, col: 21, offset: 15400},
 								name: "minutes",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 499, col: 5, offset: 12314},
+						pos:  position{line: 586, col: 5, offset: 15412},
 						name: "days",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 500, col: 5, offset: 12323},
+						pos:  position{line: 587, col: 5, offset: 15421},
 						name: "weeks",
 					},
 				},
@@ -3877,32 +4906,32 @@ var g = &grammar{
 		},
 		{
 			name: "sec_abbrev",
-			pos:  position{line: 502, col: 1, offset: 12330},
+			pos:  position{line: 589, col: 1, offset: 15428},
 			expr: &choiceExpr{
-				pos: position{line: 503, col: 5, offset: 12345},
+				pos: position{line: 590, col: 5, offset: 15443},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 503, col: 5, offset: 12345},
+						pos:        position{line: 590, col: 5, offset: 15443},
 						val:        "seconds",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 504, col: 5, offset: 12359},
+						pos:        position{line: 591, col: 5, offset: 15457},
 						val:        "second",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 505, col: 5, offset: 12372},
+						pos:        position{line: 592, col: 5, offset: 15470},
 						val:        "secs",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 506, col: 5, offset: 12383},
+						pos:        position{line: 593, col: 5, offset: 15481},
 						val:        "sec",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 507, col: 5, offset: 12393},
+						pos:        position{line: 594, col: 5, offset: 15491},
 						val:        "s",
 						ignoreCase: false,
 					},
@@ -3911,32 +4940,32 @@ var g = &grammar{
 		},
 		{
 			name: "min_abbrev",
-			pos:  position{line: 509, col: 1, offset: 12398},
+			pos:  position{line: 596, col: 1, offset: 15496},
 			expr: &choiceExpr{
-				pos: position{line: 510, col: 5, offset: 12413},
+				pos: position{line: 597, col: 5, offset: 15511},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 510, col: 5, offset: 12413},
+						pos:        position{line: 597, col: 5, offset: 15511},
 						val:        "minutes",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 511, col: 5, offset: 12427},
+						pos:        position{line: 598, col: 5, offset: 15525},
 						val:        "minute",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 512, col: 5, offset: 12440},
+						pos:        position{line: 599, col: 5, offset: 15538},
 						val:        "mins",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 513, col: 5, offset: 12451},
+						pos:        position{line: 600, col: 5, offset: 15549},
 						val:        "min",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 514, col: 5, offset: 12461},
+						pos:        position{line: 601, col: 5, offset: 15559},
 						val:        "m",
 						ignoreCase: false,
 					},
@@ -3945,32 +4974,32 @@ var g = &grammar{
 		},
 		{
 			name: "hour_abbrev",
-			pos:  position{line: 516, col: 1, offset: 12466},
+			pos:  position{line: 603, col: 1, offset: 15564},
 			expr: &choiceExpr{
-				pos: position{line: 517, col: 5, offset: 12482},
+				pos: position{line: 604, col: 5, offset: 15580},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 517, col: 5, offset: 12482},
+						pos:        position{line: 604, col: 5, offset: 15580},
 						val:        "hours",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 518, col: 5, offset: 12494},
+						pos:        position{line: 605, col: 5, offset: 15592},
 						val:        "hrs",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 519, col: 5, offset: 12504},
+						pos:        position{line: 606, col: 5, offset: 15602},
 						val:        "hr",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 520, col: 5, offset: 12513},
+						pos:        position{line: 607, col: 5, offset: 15611},
 						val:        "h",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 521, col: 5, offset: 12521},
+						pos:        position{line: 608, col: 5, offset: 15619},
 						val:        "hour",
 						ignoreCase: false,
 					},
@@ -3979,22 +5008,22 @@ var g = &grammar{
 		},
 		{
 			name: "day_abbrev",
-			pos:  position{line: 523, col: 1, offset: 12529},
+			pos:  position{line: 610, col: 1, offset: 15627},
 			expr: &choiceExpr{
-				pos: position{line: 523, col: 14, offset: 12542},
+				pos: position{line: 610, col: 14, offset: 15640},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 523, col: 14, offset: 12542},
+						pos:        position{line: 610, col: 14, offset: 15640},
 						val:        "days",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 523, col: 21, offset: 12549},
+						pos:        position{line: 610, col: 21, offset: 15647},
 						val:        "day",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 523, col: 27, offset: 12555},
+						pos:        position{line: 610, col: 27, offset: 15653},
 						val:        "d",
 						ignoreCase: false,
 					},
@@ -4003,32 +5032,32 @@ var g = &grammar{
 		},
 		{
 			name: "week_abbrev",
-			pos:  position{line: 524, col: 1, offset: 12559},
+			pos:  position{line: 611, col: 1, offset: 15657},
 			expr: &choiceExpr{
-				pos: position{line: 524, col: 15, offset: 12573},
+				pos: position{line: 611, col: 15, offset: 15671},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 524, col: 15, offset: 12573},
+						pos:        position{line: 611, col: 15, offset: 15671},
 						val:        "weeks",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 524, col: 23, offset: 12581},
+						pos:        position{line: 611, col: 23, offset: 15679},
 						val:        "week",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 524, col: 30, offset: 12588},
+						pos:        position{line: 611, col: 30, offset: 15686},
 						val:        "wks",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 524, col: 36, offset: 12594},
+						pos:        position{line: 611, col: 36, offset: 15692},
 						val:        "wk",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 524, col: 41, offset: 12599},
+						pos:        position{line: 611, col: 41, offset: 15697},
 						val:        "w",
 						ignoreCase: false,
 					},
@@ -4037,42 +5066,42 @@ var g = &grammar{
 		},
 		{
 			name: "seconds",
-			pos:  position{line: 526, col: 1, offset: 12604},
+			pos:  position{line: 613, col: 1, offset: 15702},
 			expr: &choiceExpr{
-				pos: position{line: 527, col: 5, offset: 12616},
+				pos: position{line: 614, col: 5, offset: 15714},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 527, col: 5, offset: 12616},
+						pos: position{line: 614, col: 5, offset: 15714},
 						run: (*parser).callonseconds2,
 						expr: &litMatcher{
-							pos:        position{line: 527, col: 5, offset: 12616},
+							pos:        position{line: 614, col: 5, offset: 15714},
 							val:        "second",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 528, col: 5, offset: 12661},
+						pos: position{line: 615, col: 5, offset: 15759},
 						run: (*parser).callonseconds4,
 						expr: &seqExpr{
-							pos: position{line: 528, col: 5, offset: 12661},
+							pos: position{line: 615, col: 5, offset: 15759},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 528, col: 5, offset: 12661},
+									pos:   position{line: 615, col: 5, offset: 15759},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 528, col: 9, offset: 12665},
+										pos:  position{line: 615, col: 9, offset: 15763},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 528, col: 16, offset: 12672},
+									pos: position{line: 615, col: 16, offset: 15770},
 									expr: &ruleRefExpr{
-										pos:  position{line: 528, col: 16, offset: 12672},
+										pos:  position{line: 615, col: 16, offset: 15770},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 528, col: 19, offset: 12675},
+									pos:  position{line: 615, col: 19, offset: 15773},
 									name: "sec_abbrev",
 								},
 							},
@@ -4083,42 +5112,42 @@ var g = &grammar{
 		},
 		{
 			name: "minutes",
-			pos:  position{line: 530, col: 1, offset: 12721},
+			pos:  position{line: 617, col: 1, offset: 15819},
 			expr: &choiceExpr{
-				pos: position{line: 531, col: 5, offset: 12733},
+				pos: position{line: 618, col: 5, offset: 15831},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 531, col: 5, offset: 12733},
+						pos: position{line: 618, col: 5, offset: 15831},
 						run: (*parser).callonminutes2,
 						expr: &litMatcher{
-							pos:        position{line: 531, col: 5, offset: 12733},
+							pos:        position{line: 618, col: 5, offset: 15831},
 							val:        "minute",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 532, col: 5, offset: 12779},
+						pos: position{line: 619, col: 5, offset: 15877},
 						run: (*parser).callonminutes4,
 						expr: &seqExpr{
-							pos: position{line: 532, col: 5, offset: 12779},
+							pos: position{line: 619, col: 5, offset: 15877},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 532, col: 5, offset: 12779},
+									pos:   position{line: 619, col: 5, offset: 15877},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 532, col: 9, offset: 12783},
+										pos:  position{line: 619, col: 9, offset: 15881},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 532, col: 16, offset: 12790},
+									pos: position{line: 619, col: 16, offset: 15888},
 									expr: &ruleRefExpr{
-										pos:  position{line: 532, col: 16, offset: 12790},
+										pos:  position{line: 619, col: 16, offset: 15888},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 532, col: 19, offset: 12793},
+									pos:  position{line: 619, col: 19, offset: 15891},
 									name: "min_abbrev",
 								},
 							},
@@ -4129,42 +5158,42 @@ var g = &grammar{
 		},
 		{
 			name: "hours",
-			pos:  position{line: 534, col: 1, offset: 12848},
+			pos:  position{line: 621, col: 1, offset: 15946},
 			expr: &choiceExpr{
-				pos: position{line: 535, col: 5, offset: 12858},
+				pos: position{line: 622, col: 5, offset: 15956},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 535, col: 5, offset: 12858},
+						pos: position{line: 622, col: 5, offset: 15956},
 						run: (*parser).callonhours2,
 						expr: &litMatcher{
-							pos:        position{line: 535, col: 5, offset: 12858},
+							pos:        position{line: 622, col: 5, offset: 15956},
 							val:        "hour",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 536, col: 5, offset: 12904},
+						pos: position{line: 623, col: 5, offset: 16002},
 						run: (*parser).callonhours4,
 						expr: &seqExpr{
-							pos: position{line: 536, col: 5, offset: 12904},
+							pos: position{line: 623, col: 5, offset: 16002},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 536, col: 5, offset: 12904},
+									pos:   position{line: 623, col: 5, offset: 16002},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 536, col: 9, offset: 12908},
+										pos:  position{line: 623, col: 9, offset: 16006},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 536, col: 16, offset: 12915},
+									pos: position{line: 623, col: 16, offset: 16013},
 									expr: &ruleRefExpr{
-										pos:  position{line: 536, col: 16, offset: 12915},
+										pos:  position{line: 623, col: 16, offset: 16013},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 536, col: 19, offset: 12918},
+									pos:  position{line: 623, col: 19, offset: 16016},
 									name: "hour_abbrev",
 								},
 							},
@@ -4175,42 +5204,42 @@ var g = &grammar{
 		},
 		{
 			name: "days",
-			pos:  position{line: 538, col: 1, offset: 12976},
+			pos:  position{line: 625, col: 1, offset: 16074},
 			expr: &choiceExpr{
-				pos: position{line: 539, col: 5, offset: 12985},
+				pos: position{line: 626, col: 5, offset: 16083},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 539, col: 5, offset: 12985},
+						pos: position{line: 626, col: 5, offset: 16083},
 						run: (*parser).callondays2,
 						expr: &litMatcher{
-							pos:        position{line: 539, col: 5, offset: 12985},
+							pos:        position{line: 626, col: 5, offset: 16083},
 							val:        "day",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 540, col: 5, offset: 13033},
+						pos: position{line: 627, col: 5, offset: 16131},
 						run: (*parser).callondays4,
 						expr: &seqExpr{
-							pos: position{line: 540, col: 5, offset: 13033},
+							pos: position{line: 627, col: 5, offset: 16131},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 540, col: 5, offset: 13033},
+									pos:   position{line: 627, col: 5, offset: 16131},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 540, col: 9, offset: 13037},
+										pos:  position{line: 627, col: 9, offset: 16135},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 540, col: 16, offset: 13044},
+									pos: position{line: 627, col: 16, offset: 16142},
 									expr: &ruleRefExpr{
-										pos:  position{line: 540, col: 16, offset: 13044},
+										pos:  position{line: 627, col: 16, offset: 16142},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 540, col: 19, offset: 13047},
+									pos:  position{line: 627, col: 19, offset: 16145},
 									name: "day_abbrev",
 								},
 							},
@@ -4221,30 +5250,30 @@ var g = &grammar{
 		},
 		{
 			name: "weeks",
-			pos:  position{line: 542, col: 1, offset: 13107},
+			pos:  position{line: 629, col: 1, offset: 16205},
 			expr: &actionExpr{
-				pos: position{line: 543, col: 5, offset: 13117},
+				pos: position{line: 630, col: 5, offset: 16215},
 				run: (*parser).callonweeks1,
 				expr: &seqExpr{
-					pos: position{line: 543, col: 5, offset: 13117},
+					pos: position{line: 630, col: 5, offset: 16215},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 543, col: 5, offset: 13117},
+							pos:   position{line: 630, col: 5, offset: 16215},
 							label: "num",
 							expr: &ruleRefExpr{
-								pos:  position{line: 543, col: 9, offset: 13121},
+								pos:  position{line: 630, col: 9, offset: 16219},
 								name: "number",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 543, col: 16, offset: 13128},
+							pos: position{line: 630, col: 16, offset: 16226},
 							expr: &ruleRefExpr{
-								pos:  position{line: 543, col: 16, offset: 13128},
+								pos:  position{line: 630, col: 16, offset: 16226},
 								name: "_",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 543, col: 19, offset: 13131},
+							pos:  position{line: 630, col: 19, offset: 16229},
 							name: "week_abbrev",
 						},
 					},
@@ -4253,53 +5282,53 @@ var g = &grammar{
 		},
 		{
 			name: "number",
-			pos:  position{line: 545, col: 1, offset: 13194},
+			pos:  position{line: 632, col: 1, offset: 16292},
 			expr: &ruleRefExpr{
-				pos:  position{line: 545, col: 10, offset: 13203},
+				pos:  position{line: 632, col: 10, offset: 16301},
 				name: "unsignedInteger",
 			},
 		},
 		{
 			name: "addr",
-			pos:  position{line: 549, col: 1, offset: 13249},
+			pos:  position{line: 636, col: 1, offset: 16347},
 			expr: &actionExpr{
-				pos: position{line: 550, col: 5, offset: 13258},
+				pos: position{line: 637, col: 5, offset: 16356},
 				run: (*parser).callonaddr1,
 				expr: &labeledExpr{
-					pos:   position{line: 550, col: 5, offset: 13258},
+					pos:   position{line: 637, col: 5, offset: 16356},
 					label: "a",
 					expr: &seqExpr{
-						pos: position{line: 550, col: 8, offset: 13261},
+						pos: position{line: 637, col: 8, offset: 16359},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 550, col: 8, offset: 13261},
+								pos:  position{line: 637, col: 8, offset: 16359},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 550, col: 24, offset: 13277},
+								pos:        position{line: 637, col: 24, offset: 16375},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 550, col: 28, offset: 13281},
+								pos:  position{line: 637, col: 28, offset: 16379},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 550, col: 44, offset: 13297},
+								pos:        position{line: 637, col: 44, offset: 16395},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 550, col: 48, offset: 13301},
+								pos:  position{line: 637, col: 48, offset: 16399},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 550, col: 64, offset: 13317},
+								pos:        position{line: 637, col: 64, offset: 16415},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 550, col: 68, offset: 13321},
+								pos:  position{line: 637, col: 68, offset: 16419},
 								name: "unsignedInteger",
 							},
 						},
@@ -4309,23 +5338,23 @@ var g = &grammar{
 		},
 		{
 			name: "port",
-			pos:  position{line: 552, col: 1, offset: 13370},
+			pos:  position{line: 639, col: 1, offset: 16468},
 			expr: &actionExpr{
-				pos: position{line: 553, col: 5, offset: 13379},
+				pos: position{line: 640, col: 5, offset: 16477},
 				run: (*parser).callonport1,
 				expr: &seqExpr{
-					pos: position{line: 553, col: 5, offset: 13379},
+					pos: position{line: 640, col: 5, offset: 16477},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 553, col: 5, offset: 13379},
+							pos:        position{line: 640, col: 5, offset: 16477},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 553, col: 9, offset: 13383},
+							pos:   position{line: 640, col: 9, offset: 16481},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 553, col: 11, offset: 13385},
+								pos:  position{line: 640, col: 11, offset: 16483},
 								name: "suint",
 							},
 						},
@@ -4335,32 +5364,32 @@ var g = &grammar{
 		},
 		{
 			name: "ip6addr",
-			pos:  position{line: 557, col: 1, offset: 13541},
+			pos:  position{line: 644, col: 1, offset: 16639},
 			expr: &choiceExpr{
-				pos: position{line: 558, col: 5, offset: 13553},
+				pos: position{line: 645, col: 5, offset: 16651},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 558, col: 5, offset: 13553},
+						pos: position{line: 645, col: 5, offset: 16651},
 						run: (*parser).callonip6addr2,
 						expr: &seqExpr{
-							pos: position{line: 558, col: 5, offset: 13553},
+							pos: position{line: 645, col: 5, offset: 16651},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 558, col: 5, offset: 13553},
+									pos:   position{line: 645, col: 5, offset: 16651},
 									label: "a",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 558, col: 7, offset: 13555},
+										pos: position{line: 645, col: 7, offset: 16653},
 										expr: &ruleRefExpr{
-											pos:  position{line: 558, col: 8, offset: 13556},
+											pos:  position{line: 645, col: 8, offset: 16654},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 558, col: 20, offset: 13568},
+									pos:   position{line: 645, col: 20, offset: 16666},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 558, col: 22, offset: 13570},
+										pos:  position{line: 645, col: 22, offset: 16668},
 										name: "ip6tail",
 									},
 								},
@@ -4368,51 +5397,51 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 561, col: 5, offset: 13634},
+						pos: position{line: 648, col: 5, offset: 16732},
 						run: (*parser).callonip6addr9,
 						expr: &seqExpr{
-							pos: position{line: 561, col: 5, offset: 13634},
+							pos: position{line: 648, col: 5, offset: 16732},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 561, col: 5, offset: 13634},
+									pos:   position{line: 648, col: 5, offset: 16732},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 561, col: 7, offset: 13636},
+										pos:  position{line: 648, col: 7, offset: 16734},
 										name: "h16",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 561, col: 11, offset: 13640},
+									pos:   position{line: 648, col: 11, offset: 16738},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 561, col: 13, offset: 13642},
+										pos: position{line: 648, col: 13, offset: 16740},
 										expr: &ruleRefExpr{
-											pos:  position{line: 561, col: 14, offset: 13643},
+											pos:  position{line: 648, col: 14, offset: 16741},
 											name: "h_append",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 561, col: 25, offset: 13654},
+									pos:        position{line: 648, col: 25, offset: 16752},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 561, col: 30, offset: 13659},
+									pos:   position{line: 648, col: 30, offset: 16757},
 									label: "d",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 561, col: 32, offset: 13661},
+										pos: position{line: 648, col: 32, offset: 16759},
 										expr: &ruleRefExpr{
-											pos:  position{line: 561, col: 33, offset: 13662},
+											pos:  position{line: 648, col: 33, offset: 16760},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 561, col: 45, offset: 13674},
+									pos:   position{line: 648, col: 45, offset: 16772},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 561, col: 47, offset: 13676},
+										pos:  position{line: 648, col: 47, offset: 16774},
 										name: "ip6tail",
 									},
 								},
@@ -4420,32 +5449,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 564, col: 5, offset: 13775},
+						pos: position{line: 651, col: 5, offset: 16873},
 						run: (*parser).callonip6addr22,
 						expr: &seqExpr{
-							pos: position{line: 564, col: 5, offset: 13775},
+							pos: position{line: 651, col: 5, offset: 16873},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 564, col: 5, offset: 13775},
+									pos:        position{line: 651, col: 5, offset: 16873},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 564, col: 10, offset: 13780},
+									pos:   position{line: 651, col: 10, offset: 16878},
 									label: "a",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 564, col: 12, offset: 13782},
+										pos: position{line: 651, col: 12, offset: 16880},
 										expr: &ruleRefExpr{
-											pos:  position{line: 564, col: 13, offset: 13783},
+											pos:  position{line: 651, col: 13, offset: 16881},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 564, col: 25, offset: 13795},
+									pos:   position{line: 651, col: 25, offset: 16893},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 564, col: 27, offset: 13797},
+										pos:  position{line: 651, col: 27, offset: 16895},
 										name: "ip6tail",
 									},
 								},
@@ -4453,32 +5482,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 567, col: 5, offset: 13868},
+						pos: position{line: 654, col: 5, offset: 16966},
 						run: (*parser).callonip6addr30,
 						expr: &seqExpr{
-							pos: position{line: 567, col: 5, offset: 13868},
+							pos: position{line: 654, col: 5, offset: 16966},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 567, col: 5, offset: 13868},
+									pos:   position{line: 654, col: 5, offset: 16966},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 567, col: 7, offset: 13870},
+										pos:  position{line: 654, col: 7, offset: 16968},
 										name: "h16",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 567, col: 11, offset: 13874},
+									pos:   position{line: 654, col: 11, offset: 16972},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 567, col: 13, offset: 13876},
+										pos: position{line: 654, col: 13, offset: 16974},
 										expr: &ruleRefExpr{
-											pos:  position{line: 567, col: 14, offset: 13877},
+											pos:  position{line: 654, col: 14, offset: 16975},
 											name: "h_append",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 567, col: 25, offset: 13888},
+									pos:        position{line: 654, col: 25, offset: 16986},
 									val:        "::",
 									ignoreCase: false,
 								},
@@ -4486,10 +5515,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 570, col: 5, offset: 13956},
+						pos: position{line: 657, col: 5, offset: 17054},
 						run: (*parser).callonip6addr38,
 						expr: &litMatcher{
-							pos:        position{line: 570, col: 5, offset: 13956},
+							pos:        position{line: 657, col: 5, offset: 17054},
 							val:        "::",
 							ignoreCase: false,
 						},
@@ -4499,16 +5528,16 @@ var g = &grammar{
 		},
 		{
 			name: "ip6tail",
-			pos:  position{line: 574, col: 1, offset: 13993},
+			pos:  position{line: 661, col: 1, offset: 17091},
 			expr: &choiceExpr{
-				pos: position{line: 575, col: 5, offset: 14005},
+				pos: position{line: 662, col: 5, offset: 17103},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 575, col: 5, offset: 14005},
+						pos:  position{line: 662, col: 5, offset: 17103},
 						name: "addr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 576, col: 5, offset: 14014},
+						pos:  position{line: 663, col: 5, offset: 17112},
 						name: "h16",
 					},
 				},
@@ -4516,23 +5545,23 @@ var g = &grammar{
 		},
 		{
 			name: "h_append",
-			pos:  position{line: 578, col: 1, offset: 14019},
+			pos:  position{line: 665, col: 1, offset: 17117},
 			expr: &actionExpr{
-				pos: position{line: 578, col: 12, offset: 14030},
+				pos: position{line: 665, col: 12, offset: 17128},
 				run: (*parser).callonh_append1,
 				expr: &seqExpr{
-					pos: position{line: 578, col: 12, offset: 14030},
+					pos: position{line: 665, col: 12, offset: 17128},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 578, col: 12, offset: 14030},
+							pos:        position{line: 665, col: 12, offset: 17128},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 578, col: 16, offset: 14034},
+							pos:   position{line: 665, col: 16, offset: 17132},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 578, col: 18, offset: 14036},
+								pos:  position{line: 665, col: 18, offset: 17134},
 								name: "h16",
 							},
 						},
@@ -4542,23 +5571,23 @@ var g = &grammar{
 		},
 		{
 			name: "h_prepend",
-			pos:  position{line: 579, col: 1, offset: 14073},
+			pos:  position{line: 666, col: 1, offset: 17171},
 			expr: &actionExpr{
-				pos: position{line: 579, col: 13, offset: 14085},
+				pos: position{line: 666, col: 13, offset: 17183},
 				run: (*parser).callonh_prepend1,
 				expr: &seqExpr{
-					pos: position{line: 579, col: 13, offset: 14085},
+					pos: position{line: 666, col: 13, offset: 17183},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 579, col: 13, offset: 14085},
+							pos:   position{line: 666, col: 13, offset: 17183},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 579, col: 15, offset: 14087},
+								pos:  position{line: 666, col: 15, offset: 17185},
 								name: "h16",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 579, col: 19, offset: 14091},
+							pos:        position{line: 666, col: 19, offset: 17189},
 							val:        ":",
 							ignoreCase: false,
 						},
@@ -4568,43 +5597,43 @@ var g = &grammar{
 		},
 		{
 			name: "sub_addr",
-			pos:  position{line: 581, col: 1, offset: 14129},
+			pos:  position{line: 668, col: 1, offset: 17227},
 			expr: &choiceExpr{
-				pos: position{line: 582, col: 5, offset: 14142},
+				pos: position{line: 669, col: 5, offset: 17240},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 582, col: 5, offset: 14142},
+						pos:  position{line: 669, col: 5, offset: 17240},
 						name: "addr",
 					},
 					&actionExpr{
-						pos: position{line: 583, col: 5, offset: 14151},
+						pos: position{line: 670, col: 5, offset: 17249},
 						run: (*parser).callonsub_addr3,
 						expr: &labeledExpr{
-							pos:   position{line: 583, col: 5, offset: 14151},
+							pos:   position{line: 670, col: 5, offset: 17249},
 							label: "a",
 							expr: &seqExpr{
-								pos: position{line: 583, col: 8, offset: 14154},
+								pos: position{line: 670, col: 8, offset: 17252},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 583, col: 8, offset: 14154},
+										pos:  position{line: 670, col: 8, offset: 17252},
 										name: "unsignedInteger",
 									},
 									&litMatcher{
-										pos:        position{line: 583, col: 24, offset: 14170},
+										pos:        position{line: 670, col: 24, offset: 17268},
 										val:        ".",
 										ignoreCase: false,
 									},
 									&ruleRefExpr{
-										pos:  position{line: 583, col: 28, offset: 14174},
+										pos:  position{line: 670, col: 28, offset: 17272},
 										name: "unsignedInteger",
 									},
 									&litMatcher{
-										pos:        position{line: 583, col: 44, offset: 14190},
+										pos:        position{line: 670, col: 44, offset: 17288},
 										val:        ".",
 										ignoreCase: false,
 									},
 									&ruleRefExpr{
-										pos:  position{line: 583, col: 48, offset: 14194},
+										pos:  position{line: 670, col: 48, offset: 17292},
 										name: "unsignedInteger",
 									},
 								},
@@ -4612,25 +5641,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 584, col: 5, offset: 14254},
+						pos: position{line: 671, col: 5, offset: 17352},
 						run: (*parser).callonsub_addr11,
 						expr: &labeledExpr{
-							pos:   position{line: 584, col: 5, offset: 14254},
+							pos:   position{line: 671, col: 5, offset: 17352},
 							label: "a",
 							expr: &seqExpr{
-								pos: position{line: 584, col: 8, offset: 14257},
+								pos: position{line: 671, col: 8, offset: 17355},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 584, col: 8, offset: 14257},
+										pos:  position{line: 671, col: 8, offset: 17355},
 										name: "unsignedInteger",
 									},
 									&litMatcher{
-										pos:        position{line: 584, col: 24, offset: 14273},
+										pos:        position{line: 671, col: 24, offset: 17371},
 										val:        ".",
 										ignoreCase: false,
 									},
 									&ruleRefExpr{
-										pos:  position{line: 584, col: 28, offset: 14277},
+										pos:  position{line: 671, col: 28, offset: 17375},
 										name: "unsignedInteger",
 									},
 								},
@@ -4638,13 +5667,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 585, col: 5, offset: 14339},
+						pos: position{line: 672, col: 5, offset: 17437},
 						run: (*parser).callonsub_addr17,
 						expr: &labeledExpr{
-							pos:   position{line: 585, col: 5, offset: 14339},
+							pos:   position{line: 672, col: 5, offset: 17437},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 585, col: 7, offset: 14341},
+								pos:  position{line: 672, col: 7, offset: 17439},
 								name: "unsignedInteger",
 							},
 						},
@@ -4654,31 +5683,31 @@ var g = &grammar{
 		},
 		{
 			name: "subnet",
-			pos:  position{line: 587, col: 1, offset: 14400},
+			pos:  position{line: 674, col: 1, offset: 17498},
 			expr: &actionExpr{
-				pos: position{line: 588, col: 5, offset: 14411},
+				pos: position{line: 675, col: 5, offset: 17509},
 				run: (*parser).callonsubnet1,
 				expr: &seqExpr{
-					pos: position{line: 588, col: 5, offset: 14411},
+					pos: position{line: 675, col: 5, offset: 17509},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 588, col: 5, offset: 14411},
+							pos:   position{line: 675, col: 5, offset: 17509},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 588, col: 7, offset: 14413},
+								pos:  position{line: 675, col: 7, offset: 17511},
 								name: "sub_addr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 588, col: 16, offset: 14422},
+							pos:        position{line: 675, col: 16, offset: 17520},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 588, col: 20, offset: 14426},
+							pos:   position{line: 675, col: 20, offset: 17524},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 588, col: 22, offset: 14428},
+								pos:  position{line: 675, col: 22, offset: 17526},
 								name: "unsignedInteger",
 							},
 						},
@@ -4688,31 +5717,31 @@ var g = &grammar{
 		},
 		{
 			name: "ip6subnet",
-			pos:  position{line: 592, col: 1, offset: 14512},
+			pos:  position{line: 679, col: 1, offset: 17610},
 			expr: &actionExpr{
-				pos: position{line: 593, col: 5, offset: 14526},
+				pos: position{line: 680, col: 5, offset: 17624},
 				run: (*parser).callonip6subnet1,
 				expr: &seqExpr{
-					pos: position{line: 593, col: 5, offset: 14526},
+					pos: position{line: 680, col: 5, offset: 17624},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 593, col: 5, offset: 14526},
+							pos:   position{line: 680, col: 5, offset: 17624},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 593, col: 7, offset: 14528},
+								pos:  position{line: 680, col: 7, offset: 17626},
 								name: "ip6addr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 593, col: 15, offset: 14536},
+							pos:        position{line: 680, col: 15, offset: 17634},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 593, col: 19, offset: 14540},
+							pos:   position{line: 680, col: 19, offset: 17638},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 593, col: 21, offset: 14542},
+								pos:  position{line: 680, col: 21, offset: 17640},
 								name: "unsignedInteger",
 							},
 						},
@@ -4722,15 +5751,15 @@ var g = &grammar{
 		},
 		{
 			name: "unsignedInteger",
-			pos:  position{line: 597, col: 1, offset: 14616},
+			pos:  position{line: 684, col: 1, offset: 17724},
 			expr: &actionExpr{
-				pos: position{line: 598, col: 5, offset: 14636},
+				pos: position{line: 685, col: 5, offset: 17744},
 				run: (*parser).callonunsignedInteger1,
 				expr: &labeledExpr{
-					pos:   position{line: 598, col: 5, offset: 14636},
+					pos:   position{line: 685, col: 5, offset: 17744},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 598, col: 7, offset: 14638},
+						pos:  position{line: 685, col: 7, offset: 17746},
 						name: "suint",
 					},
 				},
@@ -4738,14 +5767,14 @@ var g = &grammar{
 		},
 		{
 			name: "suint",
-			pos:  position{line: 600, col: 1, offset: 14673},
+			pos:  position{line: 687, col: 1, offset: 17781},
 			expr: &actionExpr{
-				pos: position{line: 601, col: 5, offset: 14683},
+				pos: position{line: 688, col: 5, offset: 17791},
 				run: (*parser).callonsuint1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 601, col: 5, offset: 14683},
+					pos: position{line: 688, col: 5, offset: 17791},
 					expr: &charClassMatcher{
-						pos:        position{line: 601, col: 5, offset: 14683},
+						pos:        position{line: 688, col: 5, offset: 17791},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -4756,15 +5785,15 @@ var g = &grammar{
 		},
 		{
 			name: "integer",
-			pos:  position{line: 603, col: 1, offset: 14722},
+			pos:  position{line: 690, col: 1, offset: 17830},
 			expr: &actionExpr{
-				pos: position{line: 604, col: 5, offset: 14734},
+				pos: position{line: 691, col: 5, offset: 17842},
 				run: (*parser).calloninteger1,
 				expr: &labeledExpr{
-					pos:   position{line: 604, col: 5, offset: 14734},
+					pos:   position{line: 691, col: 5, offset: 17842},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 604, col: 7, offset: 14736},
+						pos:  position{line: 691, col: 7, offset: 17844},
 						name: "sinteger",
 					},
 				},
@@ -4772,17 +5801,17 @@ var g = &grammar{
 		},
 		{
 			name: "sinteger",
-			pos:  position{line: 606, col: 1, offset: 14774},
+			pos:  position{line: 693, col: 1, offset: 17882},
 			expr: &actionExpr{
-				pos: position{line: 607, col: 5, offset: 14787},
+				pos: position{line: 694, col: 5, offset: 17895},
 				run: (*parser).callonsinteger1,
 				expr: &seqExpr{
-					pos: position{line: 607, col: 5, offset: 14787},
+					pos: position{line: 694, col: 5, offset: 17895},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 607, col: 5, offset: 14787},
+							pos: position{line: 694, col: 5, offset: 17895},
 							expr: &charClassMatcher{
-								pos:        position{line: 607, col: 5, offset: 14787},
+								pos:        position{line: 694, col: 5, offset: 17895},
 								val:        "[+-]",
 								chars:      []rune{'+', '-'},
 								ignoreCase: false,
@@ -4790,7 +5819,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 607, col: 11, offset: 14793},
+							pos:  position{line: 694, col: 11, offset: 17901},
 							name: "suint",
 						},
 					},
@@ -4799,15 +5828,15 @@ var g = &grammar{
 		},
 		{
 			name: "double",
-			pos:  position{line: 609, col: 1, offset: 14831},
+			pos:  position{line: 696, col: 1, offset: 17939},
 			expr: &actionExpr{
-				pos: position{line: 610, col: 5, offset: 14842},
+				pos: position{line: 697, col: 5, offset: 17950},
 				run: (*parser).callondouble1,
 				expr: &labeledExpr{
-					pos:   position{line: 610, col: 5, offset: 14842},
+					pos:   position{line: 697, col: 5, offset: 17950},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 610, col: 7, offset: 14844},
+						pos:  position{line: 697, col: 7, offset: 17952},
 						name: "sdouble",
 					},
 				},
@@ -4815,47 +5844,47 @@ var g = &grammar{
 		},
 		{
 			name: "sdouble",
-			pos:  position{line: 614, col: 1, offset: 14891},
+			pos:  position{line: 701, col: 1, offset: 17999},
 			expr: &choiceExpr{
-				pos: position{line: 615, col: 5, offset: 14903},
+				pos: position{line: 702, col: 5, offset: 18011},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 615, col: 5, offset: 14903},
+						pos: position{line: 702, col: 5, offset: 18011},
 						run: (*parser).callonsdouble2,
 						expr: &seqExpr{
-							pos: position{line: 615, col: 5, offset: 14903},
+							pos: position{line: 702, col: 5, offset: 18011},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 615, col: 5, offset: 14903},
+									pos: position{line: 702, col: 5, offset: 18011},
 									expr: &litMatcher{
-										pos:        position{line: 615, col: 5, offset: 14903},
+										pos:        position{line: 702, col: 5, offset: 18011},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 615, col: 10, offset: 14908},
+									pos: position{line: 702, col: 10, offset: 18016},
 									expr: &ruleRefExpr{
-										pos:  position{line: 615, col: 10, offset: 14908},
+										pos:  position{line: 702, col: 10, offset: 18016},
 										name: "doubleInteger",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 615, col: 25, offset: 14923},
+									pos:        position{line: 702, col: 25, offset: 18031},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 615, col: 29, offset: 14927},
+									pos: position{line: 702, col: 29, offset: 18035},
 									expr: &ruleRefExpr{
-										pos:  position{line: 615, col: 29, offset: 14927},
+										pos:  position{line: 702, col: 29, offset: 18035},
 										name: "doubleDigit",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 615, col: 42, offset: 14940},
+									pos: position{line: 702, col: 42, offset: 18048},
 									expr: &ruleRefExpr{
-										pos:  position{line: 615, col: 42, offset: 14940},
+										pos:  position{line: 702, col: 42, offset: 18048},
 										name: "exponentPart",
 									},
 								},
@@ -4863,35 +5892,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 618, col: 5, offset: 14999},
+						pos: position{line: 705, col: 5, offset: 18107},
 						run: (*parser).callonsdouble13,
 						expr: &seqExpr{
-							pos: position{line: 618, col: 5, offset: 14999},
+							pos: position{line: 705, col: 5, offset: 18107},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 618, col: 5, offset: 14999},
+									pos: position{line: 705, col: 5, offset: 18107},
 									expr: &litMatcher{
-										pos:        position{line: 618, col: 5, offset: 14999},
+										pos:        position{line: 705, col: 5, offset: 18107},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 618, col: 10, offset: 15004},
+									pos:        position{line: 705, col: 10, offset: 18112},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 618, col: 14, offset: 15008},
+									pos: position{line: 705, col: 14, offset: 18116},
 									expr: &ruleRefExpr{
-										pos:  position{line: 618, col: 14, offset: 15008},
+										pos:  position{line: 705, col: 14, offset: 18116},
 										name: "doubleDigit",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 618, col: 27, offset: 15021},
+									pos: position{line: 705, col: 27, offset: 18129},
 									expr: &ruleRefExpr{
-										pos:  position{line: 618, col: 27, offset: 15021},
+										pos:  position{line: 705, col: 27, offset: 18129},
 										name: "exponentPart",
 									},
 								},
@@ -4903,29 +5932,29 @@ var g = &grammar{
 		},
 		{
 			name: "doubleInteger",
-			pos:  position{line: 622, col: 1, offset: 15077},
+			pos:  position{line: 709, col: 1, offset: 18185},
 			expr: &choiceExpr{
-				pos: position{line: 623, col: 5, offset: 15095},
+				pos: position{line: 710, col: 5, offset: 18203},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 623, col: 5, offset: 15095},
+						pos:        position{line: 710, col: 5, offset: 18203},
 						val:        "0",
 						ignoreCase: false,
 					},
 					&seqExpr{
-						pos: position{line: 624, col: 5, offset: 15103},
+						pos: position{line: 711, col: 5, offset: 18211},
 						exprs: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 624, col: 5, offset: 15103},
+								pos:        position{line: 711, col: 5, offset: 18211},
 								val:        "[1-9]",
 								ranges:     []rune{'1', '9'},
 								ignoreCase: false,
 								inverted:   false,
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 624, col: 11, offset: 15109},
+								pos: position{line: 711, col: 11, offset: 18217},
 								expr: &charClassMatcher{
-									pos:        position{line: 624, col: 11, offset: 15109},
+									pos:        position{line: 711, col: 11, offset: 18217},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
@@ -4939,9 +5968,9 @@ var g = &grammar{
 		},
 		{
 			name: "doubleDigit",
-			pos:  position{line: 626, col: 1, offset: 15117},
+			pos:  position{line: 713, col: 1, offset: 18225},
 			expr: &charClassMatcher{
-				pos:        position{line: 626, col: 15, offset: 15131},
+				pos:        position{line: 713, col: 15, offset: 18239},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -4950,17 +5979,17 @@ var g = &grammar{
 		},
 		{
 			name: "exponentPart",
-			pos:  position{line: 628, col: 1, offset: 15138},
+			pos:  position{line: 715, col: 1, offset: 18246},
 			expr: &seqExpr{
-				pos: position{line: 628, col: 16, offset: 15153},
+				pos: position{line: 715, col: 16, offset: 18261},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 628, col: 16, offset: 15153},
+						pos:        position{line: 715, col: 16, offset: 18261},
 						val:        "e",
 						ignoreCase: true,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 628, col: 21, offset: 15158},
+						pos:  position{line: 715, col: 21, offset: 18266},
 						name: "sinteger",
 					},
 				},
@@ -4968,17 +5997,17 @@ var g = &grammar{
 		},
 		{
 			name: "h16",
-			pos:  position{line: 630, col: 1, offset: 15168},
+			pos:  position{line: 717, col: 1, offset: 18276},
 			expr: &actionExpr{
-				pos: position{line: 630, col: 7, offset: 15174},
+				pos: position{line: 717, col: 7, offset: 18282},
 				run: (*parser).callonh161,
 				expr: &labeledExpr{
-					pos:   position{line: 630, col: 7, offset: 15174},
+					pos:   position{line: 717, col: 7, offset: 18282},
 					label: "chars",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 630, col: 13, offset: 15180},
+						pos: position{line: 717, col: 13, offset: 18288},
 						expr: &ruleRefExpr{
-							pos:  position{line: 630, col: 13, offset: 15180},
+							pos:  position{line: 717, col: 13, offset: 18288},
 							name: "hexdigit",
 						},
 					},
@@ -4987,9 +6016,9 @@ var g = &grammar{
 		},
 		{
 			name: "hexdigit",
-			pos:  position{line: 632, col: 1, offset: 15222},
+			pos:  position{line: 719, col: 1, offset: 18330},
 			expr: &charClassMatcher{
-				pos:        position{line: 632, col: 12, offset: 15233},
+				pos:        position{line: 719, col: 12, offset: 18341},
 				val:        "[0-9a-fA-F]",
 				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
 				ignoreCase: false,
@@ -4998,17 +6027,17 @@ var g = &grammar{
 		},
 		{
 			name: "searchWord",
-			pos:  position{line: 634, col: 1, offset: 15246},
+			pos:  position{line: 721, col: 1, offset: 18354},
 			expr: &actionExpr{
-				pos: position{line: 635, col: 5, offset: 15261},
+				pos: position{line: 722, col: 5, offset: 18369},
 				run: (*parser).callonsearchWord1,
 				expr: &labeledExpr{
-					pos:   position{line: 635, col: 5, offset: 15261},
+					pos:   position{line: 722, col: 5, offset: 18369},
 					label: "chars",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 635, col: 11, offset: 15267},
+						pos: position{line: 722, col: 11, offset: 18375},
 						expr: &ruleRefExpr{
-							pos:  position{line: 635, col: 11, offset: 15267},
+							pos:  position{line: 722, col: 11, offset: 18375},
 							name: "searchWordPart",
 						},
 					},
@@ -5017,33 +6046,33 @@ var g = &grammar{
 		},
 		{
 			name: "searchWordPart",
-			pos:  position{line: 637, col: 1, offset: 15317},
+			pos:  position{line: 724, col: 1, offset: 18425},
 			expr: &choiceExpr{
-				pos: position{line: 638, col: 5, offset: 15336},
+				pos: position{line: 725, col: 5, offset: 18444},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 638, col: 5, offset: 15336},
+						pos: position{line: 725, col: 5, offset: 18444},
 						run: (*parser).callonsearchWordPart2,
 						expr: &seqExpr{
-							pos: position{line: 638, col: 5, offset: 15336},
+							pos: position{line: 725, col: 5, offset: 18444},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 638, col: 5, offset: 15336},
+									pos:        position{line: 725, col: 5, offset: 18444},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 638, col: 10, offset: 15341},
+									pos:   position{line: 725, col: 10, offset: 18449},
 									label: "s",
 									expr: &choiceExpr{
-										pos: position{line: 638, col: 13, offset: 15344},
+										pos: position{line: 725, col: 13, offset: 18452},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 638, col: 13, offset: 15344},
+												pos:  position{line: 725, col: 13, offset: 18452},
 												name: "escapeSequence",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 638, col: 30, offset: 15361},
+												pos:  position{line: 725, col: 30, offset: 18469},
 												name: "searchEscape",
 											},
 										},
@@ -5053,18 +6082,18 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 639, col: 5, offset: 15398},
+						pos: position{line: 726, col: 5, offset: 18506},
 						run: (*parser).callonsearchWordPart9,
 						expr: &seqExpr{
-							pos: position{line: 639, col: 5, offset: 15398},
+							pos: position{line: 726, col: 5, offset: 18506},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 639, col: 5, offset: 15398},
+									pos: position{line: 726, col: 5, offset: 18506},
 									expr: &choiceExpr{
-										pos: position{line: 639, col: 7, offset: 15400},
+										pos: position{line: 726, col: 7, offset: 18508},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 639, col: 7, offset: 15400},
+												pos:        position{line: 726, col: 7, offset: 18508},
 												val:        "[\\x00-\\x1F\\x5C(),!><=\\x22|\\x27;]",
 												chars:      []rune{'\\', '(', ')', ',', '!', '>', '<', '=', '"', '|', '\'', ';'},
 												ranges:     []rune{'\x00', '\x1f'},
@@ -5072,14 +6101,14 @@ var g = &grammar{
 												inverted:   false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 639, col: 42, offset: 15435},
+												pos:  position{line: 726, col: 42, offset: 18543},
 												name: "ws",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 639, col: 46, offset: 15439,
+									line: 726, col: 46, offset: 18547,
 								},
 							},
 						},
@@ -5089,34 +6118,34 @@ var g = &grammar{
 		},
 		{
 			name: "quotedString",
-			pos:  position{line: 641, col: 1, offset: 15473},
+			pos:  position{line: 728, col: 1, offset: 18581},
 			expr: &choiceExpr{
-				pos: position{line: 642, col: 5, offset: 15490},
+				pos: position{line: 729, col: 5, offset: 18598},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 642, col: 5, offset: 15490},
+						pos: position{line: 729, col: 5, offset: 18598},
 						run: (*parser).callonquotedString2,
 						expr: &seqExpr{
-							pos: position{line: 642, col: 5, offset: 15490},
+							pos: position{line: 729, col: 5, offset: 18598},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 642, col: 5, offset: 15490},
+									pos:        position{line: 729, col: 5, offset: 18598},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 642, col: 9, offset: 15494},
+									pos:   position{line: 729, col: 9, offset: 18602},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 642, col: 11, offset: 15496},
+										pos: position{line: 729, col: 11, offset: 18604},
 										expr: &ruleRefExpr{
-											pos:  position{line: 642, col: 11, offset: 15496},
+											pos:  position{line: 729, col: 11, offset: 18604},
 											name: "doubleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 642, col: 29, offset: 15514},
+									pos:        position{line: 729, col: 29, offset: 18622},
 									val:        "\"",
 									ignoreCase: false,
 								},
@@ -5124,29 +6153,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 643, col: 5, offset: 15551},
+						pos: position{line: 730, col: 5, offset: 18659},
 						run: (*parser).callonquotedString9,
 						expr: &seqExpr{
-							pos: position{line: 643, col: 5, offset: 15551},
+							pos: position{line: 730, col: 5, offset: 18659},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 643, col: 5, offset: 15551},
+									pos:        position{line: 730, col: 5, offset: 18659},
 									val:        "'",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 643, col: 9, offset: 15555},
+									pos:   position{line: 730, col: 9, offset: 18663},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 643, col: 11, offset: 15557},
+										pos: position{line: 730, col: 11, offset: 18665},
 										expr: &ruleRefExpr{
-											pos:  position{line: 643, col: 11, offset: 15557},
+											pos:  position{line: 730, col: 11, offset: 18665},
 											name: "singleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 643, col: 29, offset: 15575},
+									pos:        position{line: 730, col: 29, offset: 18683},
 									val:        "'",
 									ignoreCase: false,
 								},
@@ -5158,55 +6187,55 @@ var g = &grammar{
 		},
 		{
 			name: "doubleQuotedChar",
-			pos:  position{line: 645, col: 1, offset: 15609},
+			pos:  position{line: 732, col: 1, offset: 18717},
 			expr: &choiceExpr{
-				pos: position{line: 646, col: 5, offset: 15630},
+				pos: position{line: 733, col: 5, offset: 18738},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 646, col: 5, offset: 15630},
+						pos: position{line: 733, col: 5, offset: 18738},
 						run: (*parser).callondoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 646, col: 5, offset: 15630},
+							pos: position{line: 733, col: 5, offset: 18738},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 646, col: 5, offset: 15630},
+									pos: position{line: 733, col: 5, offset: 18738},
 									expr: &choiceExpr{
-										pos: position{line: 646, col: 7, offset: 15632},
+										pos: position{line: 733, col: 7, offset: 18740},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 646, col: 7, offset: 15632},
+												pos:        position{line: 733, col: 7, offset: 18740},
 												val:        "\"",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 646, col: 13, offset: 15638},
+												pos:  position{line: 733, col: 13, offset: 18746},
 												name: "escapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 646, col: 26, offset: 15651,
+									line: 733, col: 26, offset: 18759,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 647, col: 5, offset: 15688},
+						pos: position{line: 734, col: 5, offset: 18796},
 						run: (*parser).callondoubleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 647, col: 5, offset: 15688},
+							pos: position{line: 734, col: 5, offset: 18796},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 647, col: 5, offset: 15688},
+									pos:        position{line: 734, col: 5, offset: 18796},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 647, col: 10, offset: 15693},
+									pos:   position{line: 734, col: 10, offset: 18801},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 647, col: 12, offset: 15695},
+										pos:  position{line: 734, col: 12, offset: 18803},
 										name: "escapeSequence",
 									},
 								},
@@ -5218,55 +6247,55 @@ var g = &grammar{
 		},
 		{
 			name: "singleQuotedChar",
-			pos:  position{line: 649, col: 1, offset: 15729},
+			pos:  position{line: 736, col: 1, offset: 18837},
 			expr: &choiceExpr{
-				pos: position{line: 650, col: 5, offset: 15750},
+				pos: position{line: 737, col: 5, offset: 18858},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 650, col: 5, offset: 15750},
+						pos: position{line: 737, col: 5, offset: 18858},
 						run: (*parser).callonsingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 650, col: 5, offset: 15750},
+							pos: position{line: 737, col: 5, offset: 18858},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 650, col: 5, offset: 15750},
+									pos: position{line: 737, col: 5, offset: 18858},
 									expr: &choiceExpr{
-										pos: position{line: 650, col: 7, offset: 15752},
+										pos: position{line: 737, col: 7, offset: 18860},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 650, col: 7, offset: 15752},
+												pos:        position{line: 737, col: 7, offset: 18860},
 												val:        "'",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 650, col: 13, offset: 15758},
+												pos:  position{line: 737, col: 13, offset: 18866},
 												name: "escapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 650, col: 26, offset: 15771,
+									line: 737, col: 26, offset: 18879,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 651, col: 5, offset: 15808},
+						pos: position{line: 738, col: 5, offset: 18916},
 						run: (*parser).callonsingleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 651, col: 5, offset: 15808},
+							pos: position{line: 738, col: 5, offset: 18916},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 651, col: 5, offset: 15808},
+									pos:        position{line: 738, col: 5, offset: 18916},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 651, col: 10, offset: 15813},
+									pos:   position{line: 738, col: 10, offset: 18921},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 651, col: 12, offset: 15815},
+										pos:  position{line: 738, col: 12, offset: 18923},
 										name: "escapeSequence",
 									},
 								},
@@ -5278,38 +6307,38 @@ var g = &grammar{
 		},
 		{
 			name: "escapeSequence",
-			pos:  position{line: 653, col: 1, offset: 15849},
+			pos:  position{line: 740, col: 1, offset: 18957},
 			expr: &choiceExpr{
-				pos: position{line: 654, col: 5, offset: 15868},
+				pos: position{line: 741, col: 5, offset: 18976},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 654, col: 5, offset: 15868},
+						pos: position{line: 741, col: 5, offset: 18976},
 						run: (*parser).callonescapeSequence2,
 						expr: &seqExpr{
-							pos: position{line: 654, col: 5, offset: 15868},
+							pos: position{line: 741, col: 5, offset: 18976},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 654, col: 5, offset: 15868},
+									pos:        position{line: 741, col: 5, offset: 18976},
 									val:        "x",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 654, col: 9, offset: 15872},
+									pos:  position{line: 741, col: 9, offset: 18980},
 									name: "hexdigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 654, col: 18, offset: 15881},
+									pos:  position{line: 741, col: 18, offset: 18989},
 									name: "hexdigit",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 655, col: 5, offset: 15932},
+						pos:  position{line: 742, col: 5, offset: 19040},
 						name: "singleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 656, col: 5, offset: 15953},
+						pos:  position{line: 743, col: 5, offset: 19061},
 						name: "unicodeEscape",
 					},
 				},
@@ -5317,75 +6346,75 @@ var g = &grammar{
 		},
 		{
 			name: "singleCharEscape",
-			pos:  position{line: 658, col: 1, offset: 15968},
+			pos:  position{line: 745, col: 1, offset: 19076},
 			expr: &choiceExpr{
-				pos: position{line: 659, col: 5, offset: 15989},
+				pos: position{line: 746, col: 5, offset: 19097},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 659, col: 5, offset: 15989},
+						pos:        position{line: 746, col: 5, offset: 19097},
 						val:        "'",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 660, col: 5, offset: 15997},
+						pos:        position{line: 747, col: 5, offset: 19105},
 						val:        "\"",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 661, col: 5, offset: 16005},
+						pos:        position{line: 748, col: 5, offset: 19113},
 						val:        "\\",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 662, col: 5, offset: 16014},
+						pos: position{line: 749, col: 5, offset: 19122},
 						run: (*parser).callonsingleCharEscape5,
 						expr: &litMatcher{
-							pos:        position{line: 662, col: 5, offset: 16014},
+							pos:        position{line: 749, col: 5, offset: 19122},
 							val:        "b",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 663, col: 5, offset: 16043},
+						pos: position{line: 750, col: 5, offset: 19151},
 						run: (*parser).callonsingleCharEscape7,
 						expr: &litMatcher{
-							pos:        position{line: 663, col: 5, offset: 16043},
+							pos:        position{line: 750, col: 5, offset: 19151},
 							val:        "f",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 664, col: 5, offset: 16072},
+						pos: position{line: 751, col: 5, offset: 19180},
 						run: (*parser).callonsingleCharEscape9,
 						expr: &litMatcher{
-							pos:        position{line: 664, col: 5, offset: 16072},
+							pos:        position{line: 751, col: 5, offset: 19180},
 							val:        "n",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 665, col: 5, offset: 16101},
+						pos: position{line: 752, col: 5, offset: 19209},
 						run: (*parser).callonsingleCharEscape11,
 						expr: &litMatcher{
-							pos:        position{line: 665, col: 5, offset: 16101},
+							pos:        position{line: 752, col: 5, offset: 19209},
 							val:        "r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 666, col: 5, offset: 16130},
+						pos: position{line: 753, col: 5, offset: 19238},
 						run: (*parser).callonsingleCharEscape13,
 						expr: &litMatcher{
-							pos:        position{line: 666, col: 5, offset: 16130},
+							pos:        position{line: 753, col: 5, offset: 19238},
 							val:        "t",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 667, col: 5, offset: 16159},
+						pos: position{line: 754, col: 5, offset: 19267},
 						run: (*parser).callonsingleCharEscape15,
 						expr: &litMatcher{
-							pos:        position{line: 667, col: 5, offset: 16159},
+							pos:        position{line: 754, col: 5, offset: 19267},
 							val:        "v",
 							ignoreCase: false,
 						},
@@ -5395,24 +6424,24 @@ var g = &grammar{
 		},
 		{
 			name: "searchEscape",
-			pos:  position{line: 669, col: 1, offset: 16185},
+			pos:  position{line: 756, col: 1, offset: 19293},
 			expr: &choiceExpr{
-				pos: position{line: 670, col: 5, offset: 16202},
+				pos: position{line: 757, col: 5, offset: 19310},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 670, col: 5, offset: 16202},
+						pos: position{line: 757, col: 5, offset: 19310},
 						run: (*parser).callonsearchEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 670, col: 5, offset: 16202},
+							pos:        position{line: 757, col: 5, offset: 19310},
 							val:        "=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 671, col: 5, offset: 16230},
+						pos: position{line: 758, col: 5, offset: 19338},
 						run: (*parser).callonsearchEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 671, col: 5, offset: 16230},
+							pos:        position{line: 758, col: 5, offset: 19338},
 							val:        "*",
 							ignoreCase: false,
 						},
@@ -5422,41 +6451,41 @@ var g = &grammar{
 		},
 		{
 			name: "unicodeEscape",
-			pos:  position{line: 673, col: 1, offset: 16257},
+			pos:  position{line: 760, col: 1, offset: 19365},
 			expr: &choiceExpr{
-				pos: position{line: 674, col: 5, offset: 16275},
+				pos: position{line: 761, col: 5, offset: 19383},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 674, col: 5, offset: 16275},
+						pos: position{line: 761, col: 5, offset: 19383},
 						run: (*parser).callonunicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 674, col: 5, offset: 16275},
+							pos: position{line: 761, col: 5, offset: 19383},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 674, col: 5, offset: 16275},
+									pos:        position{line: 761, col: 5, offset: 19383},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 674, col: 9, offset: 16279},
+									pos:   position{line: 761, col: 9, offset: 19387},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 674, col: 16, offset: 16286},
+										pos: position{line: 761, col: 16, offset: 19394},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 674, col: 16, offset: 16286},
+												pos:  position{line: 761, col: 16, offset: 19394},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 674, col: 25, offset: 16295},
+												pos:  position{line: 761, col: 25, offset: 19403},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 674, col: 34, offset: 16304},
+												pos:  position{line: 761, col: 34, offset: 19412},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 674, col: 43, offset: 16313},
+												pos:  position{line: 761, col: 43, offset: 19421},
 												name: "hexdigit",
 											},
 										},
@@ -5466,63 +6495,63 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 677, col: 5, offset: 16376},
+						pos: position{line: 764, col: 5, offset: 19484},
 						run: (*parser).callonunicodeEscape11,
 						expr: &seqExpr{
-							pos: position{line: 677, col: 5, offset: 16376},
+							pos: position{line: 764, col: 5, offset: 19484},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 677, col: 5, offset: 16376},
+									pos:        position{line: 764, col: 5, offset: 19484},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 677, col: 9, offset: 16380},
+									pos:        position{line: 764, col: 9, offset: 19488},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 677, col: 13, offset: 16384},
+									pos:   position{line: 764, col: 13, offset: 19492},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 677, col: 20, offset: 16391},
+										pos: position{line: 764, col: 20, offset: 19499},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 677, col: 20, offset: 16391},
+												pos:  position{line: 764, col: 20, offset: 19499},
 												name: "hexdigit",
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 677, col: 29, offset: 16400},
+												pos: position{line: 764, col: 29, offset: 19508},
 												expr: &ruleRefExpr{
-													pos:  position{line: 677, col: 29, offset: 16400},
+													pos:  position{line: 764, col: 29, offset: 19508},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 677, col: 39, offset: 16410},
+												pos: position{line: 764, col: 39, offset: 19518},
 												expr: &ruleRefExpr{
-													pos:  position{line: 677, col: 39, offset: 16410},
+													pos:  position{line: 764, col: 39, offset: 19518},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 677, col: 49, offset: 16420},
+												pos: position{line: 764, col: 49, offset: 19528},
 												expr: &ruleRefExpr{
-													pos:  position{line: 677, col: 49, offset: 16420},
+													pos:  position{line: 764, col: 49, offset: 19528},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 677, col: 59, offset: 16430},
+												pos: position{line: 764, col: 59, offset: 19538},
 												expr: &ruleRefExpr{
-													pos:  position{line: 677, col: 59, offset: 16430},
+													pos:  position{line: 764, col: 59, offset: 19538},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 677, col: 69, offset: 16440},
+												pos: position{line: 764, col: 69, offset: 19548},
 												expr: &ruleRefExpr{
-													pos:  position{line: 677, col: 69, offset: 16440},
+													pos:  position{line: 764, col: 69, offset: 19548},
 													name: "hexdigit",
 												},
 											},
@@ -5530,7 +6559,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 677, col: 80, offset: 16451},
+									pos:        position{line: 764, col: 80, offset: 19559},
 									val:        "}",
 									ignoreCase: false,
 								},
@@ -5542,28 +6571,28 @@ var g = &grammar{
 		},
 		{
 			name: "reString",
-			pos:  position{line: 681, col: 1, offset: 16505},
+			pos:  position{line: 768, col: 1, offset: 19613},
 			expr: &actionExpr{
-				pos: position{line: 682, col: 5, offset: 16518},
+				pos: position{line: 769, col: 5, offset: 19626},
 				run: (*parser).callonreString1,
 				expr: &seqExpr{
-					pos: position{line: 682, col: 5, offset: 16518},
+					pos: position{line: 769, col: 5, offset: 19626},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 682, col: 5, offset: 16518},
+							pos:        position{line: 769, col: 5, offset: 19626},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 682, col: 9, offset: 16522},
+							pos:   position{line: 769, col: 9, offset: 19630},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 682, col: 11, offset: 16524},
+								pos:  position{line: 769, col: 11, offset: 19632},
 								name: "reBody",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 682, col: 18, offset: 16531},
+							pos:        position{line: 769, col: 18, offset: 19639},
 							val:        "/",
 							ignoreCase: false,
 						},
@@ -5573,24 +6602,24 @@ var g = &grammar{
 		},
 		{
 			name: "reBody",
-			pos:  position{line: 684, col: 1, offset: 16554},
+			pos:  position{line: 771, col: 1, offset: 19662},
 			expr: &actionExpr{
-				pos: position{line: 685, col: 5, offset: 16565},
+				pos: position{line: 772, col: 5, offset: 19673},
 				run: (*parser).callonreBody1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 685, col: 5, offset: 16565},
+					pos: position{line: 772, col: 5, offset: 19673},
 					expr: &choiceExpr{
-						pos: position{line: 685, col: 6, offset: 16566},
+						pos: position{line: 772, col: 6, offset: 19674},
 						alternatives: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 685, col: 6, offset: 16566},
+								pos:        position{line: 772, col: 6, offset: 19674},
 								val:        "[^/\\\\]",
 								chars:      []rune{'/', '\\'},
 								ignoreCase: false,
 								inverted:   true,
 							},
 							&litMatcher{
-								pos:        position{line: 685, col: 13, offset: 16573},
+								pos:        position{line: 772, col: 13, offset: 19681},
 								val:        "\\/",
 								ignoreCase: false,
 							},
@@ -5601,9 +6630,9 @@ var g = &grammar{
 		},
 		{
 			name: "escapedChar",
-			pos:  position{line: 687, col: 1, offset: 16613},
+			pos:  position{line: 774, col: 1, offset: 19721},
 			expr: &charClassMatcher{
-				pos:        position{line: 688, col: 5, offset: 16629},
+				pos:        position{line: 775, col: 5, offset: 19737},
 				val:        "[\\x00-\\x1f\\\\]",
 				chars:      []rune{'\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -5613,37 +6642,37 @@ var g = &grammar{
 		},
 		{
 			name: "ws",
-			pos:  position{line: 690, col: 1, offset: 16644},
+			pos:  position{line: 777, col: 1, offset: 19752},
 			expr: &choiceExpr{
-				pos: position{line: 691, col: 5, offset: 16651},
+				pos: position{line: 778, col: 5, offset: 19759},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 691, col: 5, offset: 16651},
+						pos:        position{line: 778, col: 5, offset: 19759},
 						val:        "\t",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 692, col: 5, offset: 16660},
+						pos:        position{line: 779, col: 5, offset: 19768},
 						val:        "\v",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 693, col: 5, offset: 16669},
+						pos:        position{line: 780, col: 5, offset: 19777},
 						val:        "\f",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 694, col: 5, offset: 16678},
+						pos:        position{line: 781, col: 5, offset: 19786},
 						val:        " ",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 695, col: 5, offset: 16686},
+						pos:        position{line: 782, col: 5, offset: 19794},
 						val:        "\u00a0",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 696, col: 5, offset: 16699},
+						pos:        position{line: 783, col: 5, offset: 19807},
 						val:        "\ufeff",
 						ignoreCase: false,
 					},
@@ -5653,33 +6682,33 @@ var g = &grammar{
 		{
 			name:        "_",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 698, col: 1, offset: 16709},
+			pos:         position{line: 785, col: 1, offset: 19817},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 698, col: 18, offset: 16726},
+				pos: position{line: 785, col: 18, offset: 19834},
 				expr: &ruleRefExpr{
-					pos:  position{line: 698, col: 18, offset: 16726},
+					pos:  position{line: 785, col: 18, offset: 19834},
 					name: "ws",
 				},
 			},
 		},
 		{
 			name: "__",
-			pos:  position{line: 699, col: 1, offset: 16730},
+			pos:  position{line: 786, col: 1, offset: 19838},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 699, col: 6, offset: 16735},
+				pos: position{line: 786, col: 6, offset: 19843},
 				expr: &ruleRefExpr{
-					pos:  position{line: 699, col: 6, offset: 16735},
+					pos:  position{line: 786, col: 6, offset: 19843},
 					name: "ws",
 				},
 			},
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 701, col: 1, offset: 16740},
+			pos:  position{line: 788, col: 1, offset: 19848},
 			expr: &notExpr{
-				pos: position{line: 701, col: 7, offset: 16746},
+				pos: position{line: 788, col: 7, offset: 19854},
 				expr: &anyMatcher{
-					line: 701, col: 8, offset: 16747,
+					line: 788, col: 8, offset: 19855,
 				},
 			},
 		},
@@ -5866,7 +6895,7 @@ func (p *parser) callonsearchPred13() (interface{}, error) {
 }
 
 func (c *current) onsearchPred24(f, fieldComparator, v interface{}) (interface{}, error) {
-	return makeCompareField(fieldComparator, f, v), nil
+	return makeCompareBareword(fieldComparator, f, v), nil
 
 }
 
@@ -5876,37 +6905,70 @@ func (p *parser) callonsearchPred24() (interface{}, error) {
 	return p.cur.onsearchPred24(stack["f"], stack["fieldComparator"], stack["v"])
 }
 
-func (c *current) onsearchPred36(v interface{}) (interface{}, error) {
-	return makeCompareAny("in", false, v), nil
+func (c *current) onsearchPred36(f, fieldComparator, v interface{}) (interface{}, error) {
+	return makeCompareField(fieldComparator, f, v), nil
 
 }
 
 func (p *parser) callonsearchPred36() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred36(stack["v"])
+	return p.cur.onsearchPred36(stack["f"], stack["fieldComparator"], stack["v"])
+}
+
+func (c *current) onsearchPred48(lhs, rest interface{}) (interface{}, error) {
+	return makeEvaluate(makeBinaryExprChain(lhs, []interface{}{rest})), nil
+
+}
+
+func (p *parser) callonsearchPred48() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred48(stack["lhs"], stack["rest"])
+}
+
+func (c *current) onsearchPred58(f interface{}) (interface{}, error) {
+	return makeEvaluate(f), nil
+
+}
+
+func (p *parser) callonsearchPred58() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred58(stack["f"])
+}
+
+func (c *current) onsearchPred66(v interface{}) (interface{}, error) {
+	return makeCompareAny("in", false, v), nil
+
+}
+
+func (p *parser) callonsearchPred66() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred66(stack["v"])
 }
 
-func (c *current) onsearchPred46(v, f interface{}) (interface{}, error) {
+func (c *current) onsearchPred76(v, f interface{}) (interface{}, error) {
 	return makeCompareField("in", f, v), nil
 
 }
 
-func (p *parser) callonsearchPred46() (interface{}, error) {
+func (p *parser) callonsearchPred76() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred46(stack["v"], stack["f"])
+	return p.cur.onsearchPred76(stack["v"], stack["f"])
 }
 
-func (c *current) onsearchPred57(v interface{}) (interface{}, error) {
+func (c *current) onsearchPred87(v interface{}) (interface{}, error) {
 	return makeSearch(string(c.text), v), nil
 
 }
 
-func (p *parser) callonsearchPred57() (interface{}, error) {
+func (p *parser) callonsearchPred87() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred57(stack["v"])
+	return p.cur.onsearchPred87(stack["v"])
 }
 
 func (c *current) onsearchValue8(i interface{}) (interface{}, error) {
@@ -5954,6 +7016,17 @@ func (p *parser) callonsearchValue30() (interface{}, error) {
 	return p.cur.onsearchValue30(stack["v"])
 }
 
+func (c *current) onbarewordField1(f interface{}) (interface{}, error) {
+	return []interface{}{f, string(c.text)}, nil
+
+}
+
+func (p *parser) callonbarewordField1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onbarewordField1(stack["f"])
+}
+
 func (c *current) onStringLiteral1(v interface{}) (interface{}, error) {
 	return makeLiteral("string", v), nil
 
@@ -6130,6 +7203,48 @@ func (p *parser) callongroupBy1() (interface{}, error) {
 	return p.cur.ongroupBy1(stack["list"])
 }
 
+func (c *current) ongroupByKey2(target, expr interface{}) (interface{}, error) {
+	return makeExpressionAssignment(target, expr), nil
+
+}
+
+func (p *parser) callongroupByKey2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ongroupByKey2(stack["target"], stack["expr"])
+}
+
+func (c *current) ongroupByKey11(field interface{}) (interface{}, error) {
+	return makeExpressionAssignment(nil, field), nil
+}
+
+func (p *parser) callongroupByKey11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ongroupByKey11(stack["field"])
+}
+
+func (c *current) ongroupByKeyList7(key interface{}) (interface{}, error) {
+	return key, nil
+}
+
+func (p *parser) callongroupByKeyList7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ongroupByKeyList7(stack["key"])
+}
+
+func (c *current) ongroupByKeyList1(first, rest interface{}) (interface{}, error) {
+	return append([]interface{}{first}, (rest.([]interface{}))...), nil
+
+}
+
+func (p *parser) callongroupByKeyList1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ongroupByKeyList1(stack["first"], stack["rest"])
+}
+
 func (c *current) oneveryDur1(dur interface{}) (interface{}, error) {
 	return dur, nil
 }
@@ -6305,6 +7420,58 @@ func (p *parser) callonfieldRefDotOnlyList1() (interface{}, error) {
 	return p.cur.onfieldRefDotOnlyList1(stack["first"], stack["rest"])
 }
 
+func (c *current) onfieldPatternName1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonfieldPatternName1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldPatternName1()
+}
+
+func (c *current) onfieldPattern7(field interface{}) (interface{}, error) {
+	return makeFieldCall("RecordFieldRead", nil, field), nil
+}
+
+func (p *parser) callonfieldPattern7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldPattern7(stack["field"])
+}
+
+func (c *current) onfieldPattern1(base, refs interface{}) (interface{}, error) {
+	return chainFieldCalls(base, refs), nil
+
+}
+
+func (p *parser) callonfieldPattern1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldPattern1(stack["base"], stack["refs"])
+}
+
+func (c *current) onfieldPatternList7(ref interface{}) (interface{}, error) {
+	return ref, nil
+}
+
+func (p *parser) callonfieldPatternList7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldPatternList7(stack["ref"])
+}
+
+func (c *current) onfieldPatternList1(first, rest interface{}) (interface{}, error) {
+	return append([]interface{}{first}, (rest.([]interface{}))...), nil
+
+}
+
+func (p *parser) callonfieldPatternList1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldPatternList1(stack["first"], stack["rest"])
+}
+
 func (c *current) onfieldNameList1(first, rest interface{}) (interface{}, error) {
 	result := []interface{}{first}
 	for _, r := range rest.([]interface{}) {
@@ -6351,7 +7518,7 @@ func (p *parser) callonfieldReducerOp4() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp6() (interface{}, error) {
-	return "Stdev", nil
+	return "Stddev", nil
 }
 
 func (p *parser) callonfieldReducerOp6() (interface{}, error) {
@@ -6371,7 +7538,7 @@ func (p *parser) callonfieldReducerOp8() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp10() (interface{}, error) {
-	return "Var", nil
+	return "Stdev", nil
 }
 
 func (p *parser) callonfieldReducerOp10() (interface{}, error) {
@@ -6381,7 +7548,7 @@ func (p *parser) callonfieldReducerOp10() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp12() (interface{}, error) {
-	return "Entropy", nil
+	return "Var", nil
 }
 
 func (p *parser) callonfieldReducerOp12() (interface{}, error) {
@@ -6391,7 +7558,7 @@ func (p *parser) callonfieldReducerOp12() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp14() (interface{}, error) {
-	return "Min", nil
+	return "Entropy", nil
 }
 
 func (p *parser) callonfieldReducerOp14() (interface{}, error) {
@@ -6401,7 +7568,7 @@ func (p *parser) callonfieldReducerOp14() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp16() (interface{}, error) {
-	return "Max", nil
+	return "Min", nil
 }
 
 func (p *parser) callonfieldReducerOp16() (interface{}, error) {
@@ -6411,7 +7578,7 @@ func (p *parser) callonfieldReducerOp16() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp18() (interface{}, error) {
-	return "First", nil
+	return "Max", nil
 }
 
 func (p *parser) callonfieldReducerOp18() (interface{}, error) {
@@ -6421,7 +7588,7 @@ func (p *parser) callonfieldReducerOp18() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp20() (interface{}, error) {
-	return "Last", nil
+	return "First", nil
 }
 
 func (p *parser) callonfieldReducerOp20() (interface{}, error) {
@@ -6431,7 +7598,7 @@ func (p *parser) callonfieldReducerOp20() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp22() (interface{}, error) {
-	return "CountDistinct", nil
+	return "Last", nil
 }
 
 func (p *parser) callonfieldReducerOp22() (interface{}, error) {
@@ -6440,14 +7607,114 @@ func (p *parser) callonfieldReducerOp22() (interface{}, error) {
 	return p.cur.onfieldReducerOp22()
 }
 
-func (c *current) onpaddedFieldExpr1(field interface{}) (interface{}, error) {
+func (c *current) onfieldReducerOp24() (interface{}, error) {
+	return "CountDistinct", nil
+}
+
+func (p *parser) callonfieldReducerOp24() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp24()
+}
+
+func (c *current) onfieldReducerOp26() (interface{}, error) {
+	return "Median", nil
+}
+
+func (p *parser) callonfieldReducerOp26() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp26()
+}
+
+func (c *current) onfieldReducerOp28() (interface{}, error) {
+	return "Collect", nil
+}
+
+func (p *parser) callonfieldReducerOp28() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp28()
+}
+
+func (c *current) onfieldReducerOp30() (interface{}, error) {
+	return "Union", nil
+}
+
+func (p *parser) callonfieldReducerOp30() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp30()
+}
+
+func (c *current) onparamReducerOp2() (interface{}, error) {
+	return "Quantile", nil
+}
+
+func (p *parser) callonparamReducerOp2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onparamReducerOp2()
+}
+
+func (c *current) onparamReducerOp4() (interface{}, error) {
+	return "Percentile", nil
+}
+
+func (p *parser) callonparamReducerOp4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onparamReducerOp4()
+}
+
+func (c *current) onparamReducerOp6() (interface{}, error) {
+	return "Histogram", nil
+}
+
+func (p *parser) callonparamReducerOp6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onparamReducerOp6()
+}
+
+func (c *current) onparamReducerOp8() (interface{}, error) {
+	return "Collect", nil
+}
+
+func (p *parser) callonparamReducerOp8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onparamReducerOp8()
+}
+
+func (c *current) onparamReducerOp10() (interface{}, error) {
+	return "Union", nil
+}
+
+func (p *parser) callonparamReducerOp10() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onparamReducerOp10()
+}
+
+func (c *current) onreducerArg2(field interface{}) (interface{}, error) {
 	return field, nil
 }
 
-func (p *parser) callonpaddedFieldExpr1() (interface{}, error) {
+func (p *parser) callonreducerArg2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onreducerArg2(stack["field"])
+}
+
+func (c *current) onpaddedReducerArg1(arg interface{}) (interface{}, error) {
+	return arg, nil
+}
+
+func (p *parser) callonpaddedReducerArg1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onpaddedFieldExpr1(stack["field"])
+	return p.cur.onpaddedReducerArg1(stack["arg"])
 }
 
 func (c *current) oncountReducer1(op, field interface{}) (interface{}, error) {
@@ -6472,6 +7739,27 @@ func (p *parser) callonfieldReducer1() (interface{}, error) {
 	return p.cur.onfieldReducer1(stack["op"], stack["field"])
 }
 
+func (c *current) onparamReducer1(op, field, param interface{}) (interface{}, error) {
+	return makeParamReducer(op, toLowerCase(op), field, param), nil
+
+}
+
+func (p *parser) callonparamReducer1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onparamReducer1(stack["op"], stack["field"], stack["param"])
+}
+
+func (c *current) onreducerParam1(s interface{}) (interface{}, error) {
+	return parseFloat(s), nil
+}
+
+func (p *parser) callonreducerParam1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onreducerParam1(stack["s"])
+}
+
 func (c *current) onreducerProc1(every, reducers, keys, limit interface{}) (interface{}, error) {
 	if OR(keys, every) != nil {
 		if keys != nil {
@@ -6666,6 +7954,48 @@ func (p *parser) calloncut1() (interface{}, error) {
 	return p.cur.oncut1(stack["list"])
 }
 
+func (c *current) ondrop1(list interface{}) (interface{}, error) {
+	return makeDropProc(list), nil
+}
+
+func (p *parser) callondrop1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondrop1(stack["list"])
+}
+
+func (c *current) onrename9(fa interface{}) (interface{}, error) {
+	return fa, nil
+}
+
+func (p *parser) callonrename9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onrename9(stack["fa"])
+}
+
+func (c *current) onrename1(first, rest interface{}) (interface{}, error) {
+	return makeRenameProc(append([]interface{}{first}, (rest.([]interface{}))...)), nil
+
+}
+
+func (p *parser) callonrename1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onrename1(stack["first"], stack["rest"])
+}
+
+func (c *current) onfieldAssignment1(target, source interface{}) (interface{}, error) {
+	return makeFieldAssignment(target, source), nil
+
+}
+
+func (p *parser) callonfieldAssignment1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldAssignment1(stack["target"], stack["source"])
+}
+
 func (c *current) onhead2(count interface{}) (interface{}, error) {
 	return makeHeadProc(count), nil
 }
@@ -6750,6 +8080,57 @@ func (p *parser) callonput1() (interface{}, error) {
 	return p.cur.onput1(stack["f"], stack["e"])
 }
 
+func (c *current) onjoin6(k interface{}) (interface{}, error) {
+	return k, nil
+}
+
+func (p *parser) callonjoin6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoin6(stack["k"])
+}
+
+func (c *current) onjoin23(l interface{}) (interface{}, error) {
+	return l, nil
+}
+
+func (p *parser) callonjoin23() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoin23(stack["l"])
+}
+
+func (c *current) onjoin1(kind, leftKey, rightKey, list interface{}) (interface{}, error) {
+	return makeJoinProc(kind, leftKey, rightKey, list), nil
+
+}
+
+func (p *parser) callonjoin1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoin1(stack["kind"], stack["leftKey"], stack["rightKey"], stack["list"])
+}
+
+func (c *current) onjoinKind2() (interface{}, error) {
+	return "inner", nil
+}
+
+func (p *parser) callonjoinKind2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinKind2()
+}
+
+func (c *current) onjoinKind4() (interface{}, error) {
+	return "left", nil
+}
+
+func (p *parser) callonjoinKind4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinKind4()
+}
+
 func (c *current) onPrimaryExpression12(expr interface{}) (interface{}, error) {
 	return expr, nil
 }
@@ -6909,15 +8290,15 @@ func (p *parser) callonNotExpression2() (interface{}, error) {
 	return p.cur.onNotExpression2(stack["e"])
 }
 
-func (c *current) onCallExpression2(fn, args interface{}) (interface{}, error) {
+func (c *current) onFunctionCall1(fn, args interface{}) (interface{}, error) {
 	return makeFunctionCall(fn, args), nil
 
 }
 
-func (p *parser) callonCallExpression2() (interface{}, error) {
+func (p *parser) callonFunctionCall1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onCallExpression2(stack["fn"], stack["args"])
+	return p.cur.onFunctionCall1(stack["fn"], stack["args"])
 }
 
 func (c *current) onFunctionName1() (interface{}, error) {
@@ -6961,14 +8342,14 @@ func (p *parser) callonArgumentList15() (interface{}, error) {
 	return p.cur.onArgumentList15()
 }
 
-func (c *current) onDereferenceExpression20(field interface{}) (interface{}, error) {
+func (c *current) onDereferenceExpression22(field interface{}) (interface{}, error) {
 	return makeLiteral("string", string(c.text)), nil
 }
 
-func (p *parser) callonDereferenceExpression20() (interface{}, error) {
+func (p *parser) callonDereferenceExpression22() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDereferenceExpression20(stack["field"])
+	return p.cur.onDereferenceExpression22(stack["field"])
 }
 
 func (c *current) onDereferenceExpression1(base, derefs interface{}) (interface{}, error) {
@@ -7209,7 +8590,7 @@ func (p *parser) callonsubnet1() (interface{}, error) {
 }
 
 func (c *current) onip6subnet1(a, m interface{}) (interface{}, error) {
-	return a.(string) + "/" + m.(string), nil
+	return a.(string) + "/" + fmt.Sprintf("%v", m), nil
 
 }
 
@@ -201,57 +201,69 @@ function peg$parse(input, options) {
             return makeCompareAny(fieldComparator, true, v)
           },
       peg$c30 = function(f, fieldComparator, v) {
+            return makeCompareBareword(fieldComparator, f, v)
+          },
+      peg$c31 = function(f, fieldComparator, v) {
             return makeCompareField(fieldComparator, f, v)
           },
-      peg$c31 = function(v) {
+      peg$c32 = function(lhs, rest) {
+            return makeEvaluate(makeBinaryExprChain(lhs, [rest]))
+          },
+      peg$c33 = function(f) {
+            return makeEvaluate(f)
+          },
+      peg$c34 = function(v) {
             return makeCompareAny("in", false, v)
           },
-      peg$c32 = function(v, f) {
+      peg$c35 = function(v, f) {
             return makeCompareField("in", f, v)
           },
-      peg$c33 = function(v) {
+      peg$c36 = function(v) {
             return makeSearch(text(), v)
           },
-      peg$c34 = function(i) { return i },
-      peg$c35 = function(v) { return v },
-      peg$c36 = function(v) {
+      peg$c37 = function(i) { return i },
+      peg$c38 = function(v) { return v },
+      peg$c39 = function(v) {
             if (reglob.IsGlobby(v)) {
                let re =  reglob.Reglob(v)
                return makeLiteral("regexp", re)
             }
             return makeLiteral("string", v)
           },
-      peg$c37 = function(v) {
+      peg$c40 = function(f) {
+            return [f, text()]
+          },
+      peg$c41 = function(v) {
             return makeLiteral("string", v)
           },
-      peg$c38 = function(v) {
+      peg$c42 = function(v) {
             return makeLiteral("regexp", v)
           },
-      peg$c39 = function(v) {
+      peg$c43 = function(v) {
             return makeLiteral("port", v)
           },
-      peg$c40 = function(v) {
+      peg$c44 = function(v) {
             return makeLiteral("net", v)
           },
-      peg$c41 = function(v) {
+      peg$c45 = function(v) {
             return makeLiteral("ip", v)
           },
-      peg$c42 = function(v) {
+      peg$c46 = function(v) {
             return makeLiteral("float64", v)
           },
-      peg$c43 = function(v) {
+      peg$c47 = function(v) {
             return makeLiteral("int64", v)
           },
-      peg$c44 = "true",
-      peg$c45 = peg$literalExpectation("true", false),
-      peg$c46 = function() { return makeLiteral("bool", "true") },
-      peg$c47 = "false",
-      peg$c48 = peg$literalExpectation("false", false),
-      peg$c49 = function() { return makeLiteral("bool", "false") },
-      peg$c50 = "null",
-      peg$c51 = peg$literalExpectation("null", false),
-      peg$c52 = function() { return makeLiteral("null", "") },
-      peg$c53 = function(first, rest) {
+      peg$c48 = "true",
+      peg$c49 = peg$literalExpectation("true", false),
+      peg$c50 = function() { return makeLiteral("bool", "true") },
+      peg$c51 = "false",
+      peg$c52 = peg$literalExpectation("false", false),
+      peg$c53 = function() { return makeLiteral("bool", "false") },
+      peg$c54 = "null",
+      peg$c55 = peg$literalExpectation("null", false),
+      peg$c56 = function() { return makeLiteral("null", "") },
+      peg$c57 = function(first, rest) {
             let fp =  makeSequentialProc(first)
             if ((rest)) {
               return makeParallelProc([fp, ... rest])
@@ -259,51 +271,61 @@ function peg$parse(input, options) {
               return fp
             }
           },
-      peg$c54 = ";",
-      peg$c55 = peg$literalExpectation(";", false),
-      peg$c56 = function(ch) { return makeSequentialProc(ch) },
-      peg$c57 = function(proc) {
+      peg$c58 = ";",
+      peg$c59 = peg$literalExpectation(";", false),
+      peg$c60 = function(ch) { return makeSequentialProc(ch) },
+      peg$c61 = function(proc) {
             return proc
           },
-      peg$c58 = "by",
-      peg$c59 = peg$literalExpectation("by", true),
-      peg$c60 = function(list) { return list },
-      peg$c61 = "every",
-      peg$c62 = peg$literalExpectation("every", true),
-      peg$c63 = function(dur) { return dur },
-      peg$c64 = "and",
-      peg$c65 = peg$literalExpectation("and", true),
-      peg$c66 = function() { return text() },
-      peg$c67 = "or",
-      peg$c68 = peg$literalExpectation("or", true),
-      peg$c69 = "in",
-      peg$c70 = peg$literalExpectation("in", true),
-      peg$c71 = "not",
-      peg$c72 = peg$literalExpectation("not", true),
-      peg$c73 = /^[A-Za-z_$]/,
-      peg$c74 = peg$classExpectation([["A", "Z"], ["a", "z"], "_", "$"], false, false),
-      peg$c75 = /^[0-9]/,
-      peg$c76 = peg$classExpectation([["0", "9"]], false, false),
-      peg$c77 = ".",
-      peg$c78 = peg$literalExpectation(".", false),
-      peg$c79 = function(base, field) { return makeFieldCall("RecordFieldRead", null, field) },
-      peg$c80 = "[",
-      peg$c81 = peg$literalExpectation("[", false),
-      peg$c82 = "]",
-      peg$c83 = peg$literalExpectation("]", false),
-      peg$c84 = function(base, index) { return makeFieldCall("Index", null, index) },
-      peg$c85 = function(base, derefs) {
+      peg$c62 = "by",
+      peg$c63 = peg$literalExpectation("by", true),
+      peg$c64 = function(list) { return list },
+      peg$c65 = "=",
+      peg$c66 = peg$literalExpectation("=", false),
+      peg$c67 = function(target, expr) {
+            return makeExpressionAssignment(target, expr)
+          },
+      peg$c68 = function(field) { return makeExpressionAssignment(null, field) },
+      peg$c69 = ",",
+      peg$c70 = peg$literalExpectation(",", false),
+      peg$c71 = function(first, key) { return key },
+      peg$c72 = function(first, rest) {
+            return [first, ... rest]
+        },
+      peg$c73 = "every",
+      peg$c74 = peg$literalExpectation("every", true),
+      peg$c75 = function(dur) { return dur },
+      peg$c76 = "and",
+      peg$c77 = peg$literalExpectation("and", true),
+      peg$c78 = function() { return text() },
+      peg$c79 = "or",
+      peg$c80 = peg$literalExpectation("or", true),
+      peg$c81 = "in",
+      peg$c82 = peg$literalExpectation("in", true),
+      peg$c83 = "not",
+      peg$c84 = peg$literalExpectation("not", true),
+      peg$c85 = /^[A-Za-z_$]/,
+      peg$c86 = peg$classExpectation([["A", "Z"], ["a", "z"], "_", "$"], false, false),
+      peg$c87 = /^[0-9]/,
+      peg$c88 = peg$classExpectation([["0", "9"]], false, false),
+      peg$c89 = ".",
+      peg$c90 = peg$literalExpectation(".", false),
+      peg$c91 = function(base, field) { return makeFieldCall("RecordFieldRead", null, field) },
+      peg$c92 = "[",
+      peg$c93 = peg$literalExpectation("[", false),
+      peg$c94 = "]",
+      peg$c95 = peg$literalExpectation("]", false),
+      peg$c96 = function(base, index) { return makeFieldCall("Index", null, index) },
+      peg$c97 = function(base, derefs) {
            return chainFieldCalls(base, derefs)
          },
-      peg$c86 = function(op, field) {
+      peg$c98 = function(op, field) {
             return makeFieldCall(op, field, null)
           },
-      peg$c87 = "len",
-      peg$c88 = peg$literalExpectation("len", true),
-      peg$c89 = function() { return "Len" },
-      peg$c90 = ",",
-      peg$c91 = peg$literalExpectation(",", false),
-      peg$c92 = function(first, rest) {
+      peg$c99 = "len",
+      peg$c100 = peg$literalExpectation("len", true),
+      peg$c101 = function() { return "Len" },
+      peg$c102 = function(first, rest) {
             let result =  [first]
 
             for(let  r of rest) {
@@ -312,67 +334,93 @@ function peg$parse(input, options) {
 
             return result
         },
-      peg$c93 = function(base, refs) {
+      peg$c103 = function(base, refs) {
           return chainFieldCalls(base, refs)
         },
-      peg$c94 = function(first, ref) { return ref },
-      peg$c95 = function(first, rest) {
+      peg$c104 = function(first, ref) { return ref },
+      peg$c105 = function(first, rest) {
         let result =  [first]
         for(let  r of rest) {
           result.push( r)
         }
         return result
         },
-      peg$c96 = function(first, rest) {
+      peg$c106 = function(first, rest) {
             let result =  [first]
             for(let  r of rest) {
               result.push( r[3])
             }
             return result
         },
-      peg$c97 = "count",
-      peg$c98 = peg$literalExpectation("count", true),
-      peg$c99 = function() { return "Count" },
-      peg$c100 = "sum",
-      peg$c101 = peg$literalExpectation("sum", true),
-      peg$c102 = function() { return "Sum" },
-      peg$c103 = "avg",
-      peg$c104 = peg$literalExpectation("avg", true),
-      peg$c105 = function() { return "Avg" },
-      peg$c106 = "stdev",
-      peg$c107 = peg$literalExpectation("stdev", true),
-      peg$c108 = function() { return "Stdev" },
-      peg$c109 = "sd",
-      peg$c110 = peg$literalExpectation("sd", true),
-      peg$c111 = "var",
-      peg$c112 = peg$literalExpectation("var", true),
-      peg$c113 = function() { return "Var" },
-      peg$c114 = "entropy",
-      peg$c115 = peg$literalExpectation("entropy", true),
-      peg$c116 = function() { return "Entropy" },
-      peg$c117 = "min",
-      peg$c118 = peg$literalExpectation("min", true),
-      peg$c119 = function() { return "Min" },
-      peg$c120 = "max",
-      peg$c121 = peg$literalExpectation("max", true),
-      peg$c122 = function() { return "Max" },
-      peg$c123 = "first",
-      peg$c124 = peg$literalExpectation("first", true),
-      peg$c125 = function() { return "First" },
-      peg$c126 = "last",
-      peg$c127 = peg$literalExpectation("last", true),
-      peg$c128 = function() { return "Last" },
-      peg$c129 = "countdistinct",
-      peg$c130 = peg$literalExpectation("countdistinct", true),
-      peg$c131 = function() { return "CountDistinct" },
-      peg$c132 = function(field) { return field },
-      peg$c133 = function(op, field) {
+      peg$c107 = "count",
+      peg$c108 = peg$literalExpectation("count", true),
+      peg$c109 = function() { return "Count" },
+      peg$c110 = "sum",
+      peg$c111 = peg$literalExpectation("sum", true),
+      peg$c112 = function() { return "Sum" },
+      peg$c113 = "avg",
+      peg$c114 = peg$literalExpectation("avg", true),
+      peg$c115 = function() { return "Avg" },
+      peg$c116 = "stddev",
+      peg$c117 = peg$literalExpectation("stddev", true),
+      peg$c118 = function() { return "Stddev" },
+      peg$c119 = "stdev",
+      peg$c120 = peg$literalExpectation("stdev", true),
+      peg$c121 = function() { return "Stdev" },
+      peg$c122 = "sd",
+      peg$c123 = peg$literalExpectation("sd", true),
+      peg$c124 = "var",
+      peg$c125 = peg$literalExpectation("var", true),
+      peg$c126 = function() { return "Var" },
+      peg$c127 = "entropy",
+      peg$c128 = peg$literalExpectation("entropy", true),
+      peg$c129 = function() { return "Entropy" },
+      peg$c130 = "min",
+      peg$c131 = peg$literalExpectation("min", true),
+      peg$c132 = function() { return "Min" },
+      peg$c133 = "max",
+      peg$c134 = peg$literalExpectation("max", true),
+      peg$c135 = function() { return "Max" },
+      peg$c136 = "first",
+      peg$c137 = peg$literalExpectation("first", true),
+      peg$c138 = function() { return "First" },
+      peg$c139 = "last",
+      peg$c140 = peg$literalExpectation("last", true),
+      peg$c141 = function() { return "Last" },
+      peg$c142 = "countdistinct",
+      peg$c143 = peg$literalExpectation("countdistinct", true),
+      peg$c144 = function() { return "CountDistinct" },
+      peg$c145 = "median",
+      peg$c146 = peg$literalExpectation("median", true),
+      peg$c147 = function() { return "Median" },
+      peg$c148 = "collect",
+      peg$c149 = peg$literalExpectation("collect", true),
+      peg$c150 = function() { return "Collect" },
+      peg$c151 = "union",
+      peg$c152 = peg$literalExpectation("union", true),
+      peg$c153 = function() { return "Union" },
+      peg$c154 = "quantile",
+      peg$c155 = peg$literalExpectation("quantile", true),
+      peg$c156 = function() { return "Quantile" },
+      peg$c157 = "percentile",
+      peg$c158 = peg$literalExpectation("percentile", true),
+      peg$c159 = function() { return "Percentile" },
+      peg$c160 = "histogram",
+      peg$c161 = peg$literalExpectation("histogram", true),
+      peg$c162 = function() { return "Histogram" },
+      peg$c163 = function(field) { return field },
+      peg$c164 = function(arg) { return arg },
+      peg$c165 = function(op, field) {
           return makeReducer(op, "count", field)
         },
-      peg$c134 = function(op, field) {
+      peg$c166 = function(op, field) {
           return makeReducer(op, toLowerCase(op), field)
         },
-      peg$c135 = function(every, reducers, keys, limit) {
+      peg$c167 = function(op, field, param) {
+          return makeParamReducer(op, toLowerCase(op), field, param)
+        },
+      peg$c168 = function(s) { return parseFloat(s) },
+      peg$c169 = function(every, reducers, keys, limit) {
           if (OR(keys, every)) {
             if (keys) {
               keys = keys[1]
@@ -389,290 +437,307 @@ function peg$parse(input, options) {
 
           return makeReducerProc(reducers)
         },
-      peg$c136 = "as",
-      peg$c137 = peg$literalExpectation("as", true),
-      peg$c138 = "=",
-      peg$c139 = peg$literalExpectation("=", false),
-      peg$c140 = function(field, f) {
+      peg$c170 = "as",
+      peg$c171 = peg$literalExpectation("as", true),
+      peg$c172 = function(field, f) {
           return overrideReducerVar(f, field)
         },
-      peg$c141 = function(f, field) {
+      peg$c173 = function(f, field) {
           return overrideReducerVar(f, field)
         },
-      peg$c142 = function(first, rest) {
+      peg$c174 = function(first, rest) {
             let result =  [first]
             for(let  r of rest) {
               result.push( r[3])
             }
             return result
           },
-      peg$c143 = "sort",
-      peg$c144 = peg$literalExpectation("sort", true),
-      peg$c145 = function(args, l) { return l },
-      peg$c146 = function(args, list) {
+      peg$c175 = "sort",
+      peg$c176 = peg$literalExpectation("sort", true),
+      peg$c177 = function(args, l) { return l },
+      peg$c178 = function(args, list) {
           return makeSortProc(args, list)
         },
-      peg$c147 = function(a) { return a },
-      peg$c148 = "-limit",
-      peg$c149 = peg$literalExpectation("-limit", false),
-      peg$c150 = function(limit) { return makeArg("limit", limit) },
-      peg$c151 = "-r",
-      peg$c152 = peg$literalExpectation("-r", false),
-      peg$c153 = function() { return makeArg("r", null) },
-      peg$c154 = "-nulls",
-      peg$c155 = peg$literalExpectation("-nulls", false),
-      peg$c156 = peg$literalExpectation("first", false),
-      peg$c157 = peg$literalExpectation("last", false),
-      peg$c158 = function(where) { return makeArg("nulls", where) },
-      peg$c159 = "top",
-      peg$c160 = peg$literalExpectation("top", true),
-      peg$c161 = function(n) { return n},
-      peg$c162 = "-flush",
-      peg$c163 = peg$literalExpectation("-flush", false),
-      peg$c164 = function(limit, flush, f) { return f },
-      peg$c165 = function(limit, flush, list) {
+      peg$c179 = function(a) { return a },
+      peg$c180 = "-limit",
+      peg$c181 = peg$literalExpectation("-limit", false),
+      peg$c182 = function(limit) { return makeArg("limit", limit) },
+      peg$c183 = "-r",
+      peg$c184 = peg$literalExpectation("-r", false),
+      peg$c185 = function() { return makeArg("r", null) },
+      peg$c186 = "-nulls",
+      peg$c187 = peg$literalExpectation("-nulls", false),
+      peg$c188 = peg$literalExpectation("first", false),
+      peg$c189 = peg$literalExpectation("last", false),
+      peg$c190 = function(where) { return makeArg("nulls", where) },
+      peg$c191 = "top",
+      peg$c192 = peg$literalExpectation("top", true),
+      peg$c193 = function(n) { return n},
+      peg$c194 = "-flush",
+      peg$c195 = peg$literalExpectation("-flush", false),
+      peg$c196 = function(limit, flush, f) { return f },
+      peg$c197 = function(limit, flush, list) {
           return makeTopProc(list, limit, flush)
         },
-      peg$c166 = function(limit) { return limit },
-      peg$c167 = "cut",
-      peg$c168 = peg$literalExpectation("cut", true),
-      peg$c169 = function(list) { return makeCutProc(list) },
-      peg$c170 = "head",
-      peg$c171 = peg$literalExpectation("head", true),
-      peg$c172 = function(count) { return makeHeadProc(count) },
-      peg$c173 = function() { return makeHeadProc(1) },
-      peg$c174 = "tail",
-      peg$c175 = peg$literalExpectation("tail", true),
-      peg$c176 = function(count) { return makeTailProc(count) },
-      peg$c177 = function() { return makeTailProc(1) },
-      peg$c178 = "filter",
-      peg$c179 = peg$literalExpectation("filter", true),
-      peg$c180 = "uniq",
-      peg$c181 = peg$literalExpectation("uniq", true),
-      peg$c182 = "-c",
-      peg$c183 = peg$literalExpectation("-c", false),
-      peg$c184 = function() {
+      peg$c198 = function(limit) { return limit },
+      peg$c199 = "cut",
+      peg$c200 = peg$literalExpectation("cut", true),
+      peg$c201 = function(list) { return makeCutProc(list) },
+      peg$c202 = "drop",
+      peg$c203 = peg$literalExpectation("drop", true),
+      peg$c204 = function(list) { return makeDropProc(list) },
+      peg$c205 = "rename",
+      peg$c206 = peg$literalExpectation("rename", true),
+      peg$c207 = function(first, fa) { return fa },
+      peg$c208 = function(first, rest) {
+            return makeRenameProc([first, ... rest])
+          },
+      peg$c209 = function(target, source) {
+            return makeFieldAssignment(target, source)
+          },
+      peg$c210 = "head",
+      peg$c211 = peg$literalExpectation("head", true),
+      peg$c212 = function(count) { return makeHeadProc(count) },
+      peg$c213 = function() { return makeHeadProc(1) },
+      peg$c214 = "tail",
+      peg$c215 = peg$literalExpectation("tail", true),
+      peg$c216 = function(count) { return makeTailProc(count) },
+      peg$c217 = function() { return makeTailProc(1) },
+      peg$c218 = "filter",
+      peg$c219 = peg$literalExpectation("filter", true),
+      peg$c220 = "uniq",
+      peg$c221 = peg$literalExpectation("uniq", true),
+      peg$c222 = "-c",
+      peg$c223 = peg$literalExpectation("-c", false),
+      peg$c224 = function() {
             return makeUniqProc(true)
           },
-      peg$c185 = function() {
+      peg$c225 = function() {
             return makeUniqProc(false)
           },
-      peg$c186 = "put",
-      peg$c187 = peg$literalExpectation("put", true),
-      peg$c188 = function(f, e) {
+      peg$c226 = "put",
+      peg$c227 = peg$literalExpectation("put", true),
+      peg$c228 = function(f, e) {
             return makePutProc(f, e)
           },
-      peg$c189 = function(f) {
+      peg$c229 = "join",
+      peg$c230 = peg$literalExpectation("join", true),
+      peg$c231 = function(k) { return k },
+      peg$c232 = function(kind, leftKey, rightKey, l) { return l },
+      peg$c233 = function(kind, leftKey, rightKey, list) {
+            return makeJoinProc(kind, leftKey, rightKey, list)
+          },
+      peg$c234 = "-inner",
+      peg$c235 = peg$literalExpectation("-inner", false),
+      peg$c236 = function() { return "inner" },
+      peg$c237 = "-left",
+      peg$c238 = peg$literalExpectation("-left", false),
+      peg$c239 = function() { return "left" },
+      peg$c240 = function(f) {
             return chainFieldCalls(f, [])
           },
-      peg$c190 = "?",
-      peg$c191 = peg$literalExpectation("?", false),
-      peg$c192 = ":",
-      peg$c193 = peg$literalExpectation(":", false),
-      peg$c194 = function(condition, thenClause, elseClause) {
+      peg$c241 = "?",
+      peg$c242 = peg$literalExpectation("?", false),
+      peg$c243 = ":",
+      peg$c244 = peg$literalExpectation(":", false),
+      peg$c245 = function(condition, thenClause, elseClause) {
           return makeConditionalExpr(condition, thenClause, elseClause)
         },
-      peg$c195 = function(first, rest) {
+      peg$c246 = function(first, rest) {
               return makeBinaryExprChain(first, rest)
           },
-      peg$c196 = "!=",
-      peg$c197 = peg$literalExpectation("!=", false),
-      peg$c198 = peg$literalExpectation("in", false),
-      peg$c199 = "<=",
-      peg$c200 = peg$literalExpectation("<=", false),
-      peg$c201 = "<",
-      peg$c202 = peg$literalExpectation("<", false),
-      peg$c203 = ">=",
-      peg$c204 = peg$literalExpectation(">=", false),
-      peg$c205 = ">",
-      peg$c206 = peg$literalExpectation(">", false),
-      peg$c207 = "+",
-      peg$c208 = peg$literalExpectation("+", false),
-      peg$c209 = "/",
-      peg$c210 = peg$literalExpectation("/", false),
-      peg$c211 = function(e) {
+      peg$c247 = "!=",
+      peg$c248 = peg$literalExpectation("!=", false),
+      peg$c249 = peg$literalExpectation("in", false),
+      peg$c250 = "<=",
+      peg$c251 = peg$literalExpectation("<=", false),
+      peg$c252 = "<",
+      peg$c253 = peg$literalExpectation("<", false),
+      peg$c254 = ">=",
+      peg$c255 = peg$literalExpectation(">=", false),
+      peg$c256 = ">",
+      peg$c257 = peg$literalExpectation(">", false),
+      peg$c258 = "+",
+      peg$c259 = peg$literalExpectation("+", false),
+      peg$c260 = "/",
+      peg$c261 = peg$literalExpectation("/", false),
+      peg$c262 = function(e) {
               return makeUnaryExpr("!", e)
           },
-      peg$c212 = function(fn, args) {
+      peg$c263 = function(fn, args) {
               return makeFunctionCall(fn, args)
           },
-      peg$c213 = /^[A-Za-z]/,
-      peg$c214 = peg$classExpectation([["A", "Z"], ["a", "z"]], false, false),
-      peg$c215 = /^[.0-9]/,
-      peg$c216 = peg$classExpectation([".", ["0", "9"]], false, false),
-      peg$c217 = function(first, e) { return e },
-      peg$c218 = function(first, rest) {
-            return [first, ... rest]
-        },
-      peg$c219 = function() { return [] },
-      peg$c220 = function(base, field) { return makeLiteral("string", text()) },
-      peg$c221 = function(base, derefs) {
+      peg$c264 = /^[A-Za-z]/,
+      peg$c265 = peg$classExpectation([["A", "Z"], ["a", "z"]], false, false),
+      peg$c266 = /^[.0-9]/,
+      peg$c267 = peg$classExpectation([".", ["0", "9"]], false, false),
+      peg$c268 = function(first, e) { return e },
+      peg$c269 = function() { return [] },
+      peg$c270 = function(base, field) { return makeLiteral("string", text()) },
+      peg$c271 = function(base, derefs) {
               return makeBinaryExprChain(base, derefs)
           },
-      peg$c222 = peg$literalExpectation("and", false),
-      peg$c223 = "seconds",
-      peg$c224 = peg$literalExpectation("seconds", false),
-      peg$c225 = "second",
-      peg$c226 = peg$literalExpectation("second", false),
-      peg$c227 = "secs",
-      peg$c228 = peg$literalExpectation("secs", false),
-      peg$c229 = "sec",
-      peg$c230 = peg$literalExpectation("sec", false),
-      peg$c231 = "s",
-      peg$c232 = peg$literalExpectation("s", false),
-      peg$c233 = "minutes",
-      peg$c234 = peg$literalExpectation("minutes", false),
-      peg$c235 = "minute",
-      peg$c236 = peg$literalExpectation("minute", false),
-      peg$c237 = "mins",
-      peg$c238 = peg$literalExpectation("mins", false),
-      peg$c239 = peg$literalExpectation("min", false),
-      peg$c240 = "m",
-      peg$c241 = peg$literalExpectation("m", false),
-      peg$c242 = "hours",
-      peg$c243 = peg$literalExpectation("hours", false),
-      peg$c244 = "hrs",
-      peg$c245 = peg$literalExpectation("hrs", false),
-      peg$c246 = "hr",
-      peg$c247 = peg$literalExpectation("hr", false),
-      peg$c248 = "h",
-      peg$c249 = peg$literalExpectation("h", false),
-      peg$c250 = "hour",
-      peg$c251 = peg$literalExpectation("hour", false),
-      peg$c252 = "days",
-      peg$c253 = peg$literalExpectation("days", false),
-      peg$c254 = "day",
-      peg$c255 = peg$literalExpectation("day", false),
-      peg$c256 = "d",
-      peg$c257 = peg$literalExpectation("d", false),
-      peg$c258 = "weeks",
-      peg$c259 = peg$literalExpectation("weeks", false),
-      peg$c260 = "week",
-      peg$c261 = peg$literalExpectation("week", false),
-      peg$c262 = "wks",
-      peg$c263 = peg$literalExpectation("wks", false),
-      peg$c264 = "wk",
-      peg$c265 = peg$literalExpectation("wk", false),
-      peg$c266 = "w",
-      peg$c267 = peg$literalExpectation("w", false),
-      peg$c268 = function() { return makeDuration(1) },
-      peg$c269 = function(num) { return makeDuration(num) },
-      peg$c270 = function() { return makeDuration(60) },
-      peg$c271 = function(num) { return makeDuration(num*60) },
-      peg$c272 = function() { return makeDuration(3600) },
-      peg$c273 = function(num) { return makeDuration(num*3600) },
-      peg$c274 = function() { return makeDuration(3600*24) },
-      peg$c275 = function(num) { return makeDuration(num*3600*24) },
-      peg$c276 = function(num) { return makeDuration(num*3600*24*7) },
-      peg$c277 = function(a) { return text() },
-      peg$c278 = function(a, b) {
+      peg$c272 = peg$literalExpectation("and", false),
+      peg$c273 = "seconds",
+      peg$c274 = peg$literalExpectation("seconds", false),
+      peg$c275 = "second",
+      peg$c276 = peg$literalExpectation("second", false),
+      peg$c277 = "secs",
+      peg$c278 = peg$literalExpectation("secs", false),
+      peg$c279 = "sec",
+      peg$c280 = peg$literalExpectation("sec", false),
+      peg$c281 = "s",
+      peg$c282 = peg$literalExpectation("s", false),
+      peg$c283 = "minutes",
+      peg$c284 = peg$literalExpectation("minutes", false),
+      peg$c285 = "minute",
+      peg$c286 = peg$literalExpectation("minute", false),
+      peg$c287 = "mins",
+      peg$c288 = peg$literalExpectation("mins", false),
+      peg$c289 = peg$literalExpectation("min", false),
+      peg$c290 = "m",
+      peg$c291 = peg$literalExpectation("m", false),
+      peg$c292 = "hours",
+      peg$c293 = peg$literalExpectation("hours", false),
+      peg$c294 = "hrs",
+      peg$c295 = peg$literalExpectation("hrs", false),
+      peg$c296 = "hr",
+      peg$c297 = peg$literalExpectation("hr", false),
+      peg$c298 = "h",
+      peg$c299 = peg$literalExpectation("h", false),
+      peg$c300 = "hour",
+      peg$c301 = peg$literalExpectation("hour", false),
+      peg$c302 = "days",
+      peg$c303 = peg$literalExpectation("days", false),
+      peg$c304 = "day",
+      peg$c305 = peg$literalExpectation("day", false),
+      peg$c306 = "d",
+      peg$c307 = peg$literalExpectation("d", false),
+      peg$c308 = "weeks",
+      peg$c309 = peg$literalExpectation("weeks", false),
+      peg$c310 = "week",
+      peg$c311 = peg$literalExpectation("week", false),
+      peg$c312 = "wks",
+      peg$c313 = peg$literalExpectation("wks", false),
+      peg$c314 = "wk",
+      peg$c315 = peg$literalExpectation("wk", false),
+      peg$c316 = "w",
+      peg$c317 = peg$literalExpectation("w", false),
+      peg$c318 = function() { return makeDuration(1) },
+      peg$c319 = function(num) { return makeDuration(num) },
+      peg$c320 = function() { return makeDuration(60) },
+      peg$c321 = function(num) { return makeDuration(num*60) },
+      peg$c322 = function() { return makeDuration(3600) },
+      peg$c323 = function(num) { return makeDuration(num*3600) },
+      peg$c324 = function() { return makeDuration(3600*24) },
+      peg$c325 = function(num) { return makeDuration(num*3600*24) },
+      peg$c326 = function(num) { return makeDuration(num*3600*24*7) },
+      peg$c327 = function(a) { return text() },
+      peg$c328 = function(a, b) {
             return joinChars(a) + b
           },
-      peg$c279 = "::",
-      peg$c280 = peg$literalExpectation("::", false),
-      peg$c281 = function(a, b, d, e) {
+      peg$c329 = "::",
+      peg$c330 = peg$literalExpectation("::", false),
+      peg$c331 = function(a, b, d, e) {
             return a + joinChars(b) + "::" + joinChars(d) + e
           },
-      peg$c282 = function(a, b) {
+      peg$c332 = function(a, b) {
             return "::" + joinChars(a) + b
           },
-      peg$c283 = function(a, b) {
+      peg$c333 = function(a, b) {
             return a + joinChars(b) + "::"
           },
-      peg$c284 = function() {
+      peg$c334 = function() {
             return "::"
           },
-      peg$c285 = function(v) { return ":" + v },
-      peg$c286 = function(v) { return v + ":" },
-      peg$c287 = function(a) { return text() + ".0" },
-      peg$c288 = function(a) { return text() + ".0.0" },
-      peg$c289 = function(a) { return text() + ".0.0.0" },
-      peg$c290 = function(a, m) {
+      peg$c335 = function(v) { return ":" + v },
+      peg$c336 = function(v) { return v + ":" },
+      peg$c337 = function(a) { return text() + ".0" },
+      peg$c338 = function(a) { return text() + ".0.0" },
+      peg$c339 = function(a) { return text() + ".0.0.0" },
+      peg$c340 = function(a, m) {
             return a + "/" + m.toString();
           },
-      peg$c291 = function(a, m) {
-            return a + "/" + m;
-          },
-      peg$c292 = function(s) { return parseInt(s) },
-      peg$c293 = /^[+\-]/,
-      peg$c294 = peg$classExpectation(["+", "-"], false, false),
-      peg$c295 = function(s) {
+      peg$c341 = function(s) { return parseInt(s) },
+      peg$c342 = /^[+\-]/,
+      peg$c343 = peg$classExpectation(["+", "-"], false, false),
+      peg$c344 = function(s) {
             return parseFloat(s)
         },
-      peg$c296 = function() {
+      peg$c345 = function() {
             return text()
           },
-      peg$c297 = "0",
-      peg$c298 = peg$literalExpectation("0", false),
-      peg$c299 = /^[1-9]/,
-      peg$c300 = peg$classExpectation([["1", "9"]], false, false),
-      peg$c301 = "e",
-      peg$c302 = peg$literalExpectation("e", true),
-      peg$c303 = function(chars) { return text() },
-      peg$c304 = /^[0-9a-fA-F]/,
-      peg$c305 = peg$classExpectation([["0", "9"], ["a", "f"], ["A", "F"]], false, false),
-      peg$c306 = function(chars) { return joinChars(chars) },
-      peg$c307 = "\\",
-      peg$c308 = peg$literalExpectation("\\", false),
-      peg$c309 = /^[\0-\x1F\\(),!><="|';]/,
-      peg$c310 = peg$classExpectation([["\0", "\x1F"], "\\", "(", ")", ",", "!", ">", "<", "=", "\"", "|", "'", ";"], false, false),
-      peg$c311 = peg$anyExpectation(),
-      peg$c312 = "\"",
-      peg$c313 = peg$literalExpectation("\"", false),
-      peg$c314 = function(v) { return joinChars(v) },
-      peg$c315 = "'",
-      peg$c316 = peg$literalExpectation("'", false),
-      peg$c317 = "x",
-      peg$c318 = peg$literalExpectation("x", false),
-      peg$c319 = function() { return "\\" + text() },
-      peg$c320 = "b",
-      peg$c321 = peg$literalExpectation("b", false),
-      peg$c322 = function() { return "\b" },
-      peg$c323 = "f",
-      peg$c324 = peg$literalExpectation("f", false),
-      peg$c325 = function() { return "\f" },
-      peg$c326 = "n",
-      peg$c327 = peg$literalExpectation("n", false),
-      peg$c328 = function() { return "\n" },
-      peg$c329 = "r",
-      peg$c330 = peg$literalExpectation("r", false),
-      peg$c331 = function() { return "\r" },
-      peg$c332 = "t",
-      peg$c333 = peg$literalExpectation("t", false),
-      peg$c334 = function() { return "\t" },
-      peg$c335 = "v",
-      peg$c336 = peg$literalExpectation("v", false),
-      peg$c337 = function() { return "\v" },
-      peg$c338 = function() { return "=" },
-      peg$c339 = function() { return "\\*" },
-      peg$c340 = "u",
-      peg$c341 = peg$literalExpectation("u", false),
-      peg$c342 = function(chars) {
+      peg$c346 = "0",
+      peg$c347 = peg$literalExpectation("0", false),
+      peg$c348 = /^[1-9]/,
+      peg$c349 = peg$classExpectation([["1", "9"]], false, false),
+      peg$c350 = "e",
+      peg$c351 = peg$literalExpectation("e", true),
+      peg$c352 = function(chars) { return text() },
+      peg$c353 = /^[0-9a-fA-F]/,
+      peg$c354 = peg$classExpectation([["0", "9"], ["a", "f"], ["A", "F"]], false, false),
+      peg$c355 = function(chars) { return joinChars(chars) },
+      peg$c356 = "\\",
+      peg$c357 = peg$literalExpectation("\\", false),
+      peg$c358 = /^[\0-\x1F\\(),!><="|';]/,
+      peg$c359 = peg$classExpectation([["\0", "\x1F"], "\\", "(", ")", ",", "!", ">", "<", "=", "\"", "|", "'", ";"], false, false),
+      peg$c360 = peg$anyExpectation(),
+      peg$c361 = "\"",
+      peg$c362 = peg$literalExpectation("\"", false),
+      peg$c363 = function(v) { return joinChars(v) },
+      peg$c364 = "'",
+      peg$c365 = peg$literalExpectation("'", false),
+      peg$c366 = "x",
+      peg$c367 = peg$literalExpectation("x", false),
+      peg$c368 = function() { return "\\" + text() },
+      peg$c369 = "b",
+      peg$c370 = peg$literalExpectation("b", false),
+      peg$c371 = function() { return "\b" },
+      peg$c372 = "f",
+      peg$c373 = peg$literalExpectation("f", false),
+      peg$c374 = function() { return "\f" },
+      peg$c375 = "n",
+      peg$c376 = peg$literalExpectation("n", false),
+      peg$c377 = function() { return "\n" },
+      peg$c378 = "r",
+      peg$c379 = peg$literalExpectation("r", false),
+      peg$c380 = function() { return "\r" },
+      peg$c381 = "t",
+      peg$c382 = peg$literalExpectation("t", false),
+      peg$c383 = function() { return "\t" },
+      peg$c384 = "v",
+      peg$c385 = peg$literalExpectation("v", false),
+      peg$c386 = function() { return "\v" },
+      peg$c387 = function() { return "=" },
+      peg$c388 = function() { return "\\*" },
+      peg$c389 = "u",
+      peg$c390 = peg$literalExpectation("u", false),
+      peg$c391 = function(chars) {
             return makeUnicodeChar(chars)
           },
-      peg$c343 = "{",
-      peg$c344 = peg$literalExpectation("{", false),
-      peg$c345 = "}",
-      peg$c346 = peg$literalExpectation("}", false),
-      peg$c347 = /^[^\/\\]/,
-      peg$c348 = peg$classExpectation(["/", "\\"], true, false),
-      peg$c349 = "\\/",
-      peg$c350 = peg$literalExpectation("\\/", false),
-      peg$c351 = /^[\0-\x1F\\]/,
-      peg$c352 = peg$classExpectation([["\0", "\x1F"], "\\"], false, false),
-      peg$c353 = "\t",
-      peg$c354 = peg$literalExpectation("\t", false),
-      peg$c355 = "\x0B",
-      peg$c356 = peg$literalExpectation("\x0B", false),
-      peg$c357 = "\f",
-      peg$c358 = peg$literalExpectation("\f", false),
-      peg$c359 = " ",
-      peg$c360 = peg$literalExpectation(" ", false),
-      peg$c361 = "\xA0",
-      peg$c362 = peg$literalExpectation("\xA0", false),
-      peg$c363 = "\uFEFF",
-      peg$c364 = peg$literalExpectation("\uFEFF", false),
-      peg$c365 = peg$otherExpectation("whitespace"),
+      peg$c392 = "{",
+      peg$c393 = peg$literalExpectation("{", false),
+      peg$c394 = "}",
+      peg$c395 = peg$literalExpectation("}", false),
+      peg$c396 = /^[^\/\\]/,
+      peg$c397 = peg$classExpectation(["/", "\\"], true, false),
+      peg$c398 = "\\/",
+      peg$c399 = peg$literalExpectation("\\/", false),
+      peg$c400 = /^[\0-\x1F\\]/,
+      peg$c401 = peg$classExpectation([["\0", "\x1F"], "\\"], false, false),
+      peg$c402 = "\t",
+      peg$c403 = peg$literalExpectation("\t", false),
+      peg$c404 = "\x0B",
+      peg$c405 = peg$literalExpectation("\x0B", false),
+      peg$c406 = "\f",
+      peg$c407 = peg$literalExpectation("\f", false),
+      peg$c408 = " ",
+      peg$c409 = peg$literalExpectation(" ", false),
+      peg$c410 = "\xA0",
+      peg$c411 = peg$literalExpectation("\xA0", false),
+      peg$c412 = "\uFEFF",
+      peg$c413 = peg$literalExpectation("\uFEFF", false),
+      peg$c414 = peg$otherExpectation("whitespace"),
 
       peg$currPos          = 0,
       peg$savedPos         = 0,
@@ -1283,7 +1348,7 @@ function peg$parse(input, options) {
   }
 
   function peg$parsesearchPred() {
-    var s0, s1, s2, s3, s4, s5;
+    var s0, s1, s2, s3, s4, s5, s6;
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 42) {
@@ -1387,14 +1452,14 @@ function peg$parse(input, options) {
             s2 = null;
           }
           if (s2 !== peg$FAILED) {
-            s3 = peg$parseequalityToken();
+            s3 = peg$parseRelativeOperator();
             if (s3 !== peg$FAILED) {
               s4 = peg$parse_();
               if (s4 === peg$FAILED) {
                 s4 = null;
               }
               if (s4 !== peg$FAILED) {
-                s5 = peg$parsesearchValue();
+                s5 = peg$parsebarewordField();
                 if (s5 !== peg$FAILED) {
                   peg$savedPos = s0;
                   s1 = peg$c30(s1, s3, s5);
@@ -1421,30 +1486,24 @@ function peg$parse(input, options) {
         }
         if (s0 === peg$FAILED) {
           s0 = peg$currPos;
-          s1 = peg$parsesearchValue();
+          s1 = peg$parsefieldExpr();
           if (s1 !== peg$FAILED) {
             s2 = peg$parse_();
             if (s2 === peg$FAILED) {
               s2 = null;
             }
             if (s2 !== peg$FAILED) {
-              s3 = peg$parseinToken();
+              s3 = peg$parseequalityToken();
               if (s3 !== peg$FAILED) {
                 s4 = peg$parse_();
                 if (s4 === peg$FAILED) {
                   s4 = null;
                 }
                 if (s4 !== peg$FAILED) {
-                  if (input.charCodeAt(peg$currPos) === 42) {
-                    s5 = peg$c24;
-                    peg$currPos++;
-                  } else {
-                    s5 = peg$FAILED;
-                    if (peg$silentFails === 0) { peg$fail(peg$c25); }
-                  }
+                  s5 = peg$parsesearchValue();
                   if (s5 !== peg$FAILED) {
                     peg$savedPos = s0;
-                    s1 = peg$c31(s1);
+                    s1 = peg$c31(s1, s3, s5);
                     s0 = s1;
                   } else {
                     peg$currPos = s0;
@@ -1468,37 +1527,39 @@ function peg$parse(input, options) {
           }
           if (s0 === peg$FAILED) {
             s0 = peg$currPos;
-            s1 = peg$parsesearchValue();
+            s1 = peg$parseAdditiveExpression();
             if (s1 !== peg$FAILED) {
-              s2 = peg$parse_();
-              if (s2 === peg$FAILED) {
-                s2 = null;
-              }
-              if (s2 !== peg$FAILED) {
-                s3 = peg$parseinToken();
-                if (s3 !== peg$FAILED) {
-                  s4 = peg$parse_();
-                  if (s4 === peg$FAILED) {
-                    s4 = null;
-                  }
-                  if (s4 !== peg$FAILED) {
-                    s5 = peg$parsefieldReference();
-                    if (s5 !== peg$FAILED) {
-                      peg$savedPos = s0;
-                      s1 = peg$c32(s1, s5);
-                      s0 = s1;
+              s2 = peg$currPos;
+              s3 = peg$parse__();
+              if (s3 !== peg$FAILED) {
+                s4 = peg$parseequalityToken();
+                if (s4 !== peg$FAILED) {
+                  s5 = peg$parse__();
+                  if (s5 !== peg$FAILED) {
+                    s6 = peg$parseAdditiveExpression();
+                    if (s6 !== peg$FAILED) {
+                      s3 = [s3, s4, s5, s6];
+                      s2 = s3;
                     } else {
-                      peg$currPos = s0;
-                      s0 = peg$FAILED;
+                      peg$currPos = s2;
+                      s2 = peg$FAILED;
                     }
                   } else {
-                    peg$currPos = s0;
-                    s0 = peg$FAILED;
+                    peg$currPos = s2;
+                    s2 = peg$FAILED;
                   }
                 } else {
-                  peg$currPos = s0;
-                  s0 = peg$FAILED;
+                  peg$currPos = s2;
+                  s2 = peg$FAILED;
                 }
+              } else {
+                peg$currPos = s2;
+                s2 = peg$FAILED;
+              }
+              if (s2 !== peg$FAILED) {
+                peg$savedPos = s0;
+                s1 = peg$c32(s1, s2);
+                s0 = s1;
               } else {
                 peg$currPos = s0;
                 s0 = peg$FAILED;
@@ -1509,12 +1570,149 @@ function peg$parse(input, options) {
             }
             if (s0 === peg$FAILED) {
               s0 = peg$currPos;
-              s1 = peg$parsesearchValue();
+              s1 = peg$currPos;
+              peg$silentFails++;
+              s2 = peg$currPos;
+              s3 = peg$parseFunctionName();
+              if (s3 !== peg$FAILED) {
+                if (input.charCodeAt(peg$currPos) === 40) {
+                  s4 = peg$c19;
+                  peg$currPos++;
+                } else {
+                  s4 = peg$FAILED;
+                  if (peg$silentFails === 0) { peg$fail(peg$c20); }
+                }
+                if (s4 !== peg$FAILED) {
+                  s3 = [s3, s4];
+                  s2 = s3;
+                } else {
+                  peg$currPos = s2;
+                  s2 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s2;
+                s2 = peg$FAILED;
+              }
+              peg$silentFails--;
+              if (s2 !== peg$FAILED) {
+                peg$currPos = s1;
+                s1 = void 0;
+              } else {
+                s1 = peg$FAILED;
+              }
               if (s1 !== peg$FAILED) {
-                peg$savedPos = s0;
-                s1 = peg$c33(s1);
+                s2 = peg$parseFunctionCall();
+                if (s2 !== peg$FAILED) {
+                  peg$savedPos = s0;
+                  s1 = peg$c33(s2);
+                  s0 = s1;
+                } else {
+                  peg$currPos = s0;
+                  s0 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s0;
+                s0 = peg$FAILED;
+              }
+              if (s0 === peg$FAILED) {
+                s0 = peg$currPos;
+                s1 = peg$parsesearchValue();
+                if (s1 !== peg$FAILED) {
+                  s2 = peg$parse_();
+                  if (s2 === peg$FAILED) {
+                    s2 = null;
+                  }
+                  if (s2 !== peg$FAILED) {
+                    s3 = peg$parseinToken();
+                    if (s3 !== peg$FAILED) {
+                      s4 = peg$parse_();
+                      if (s4 === peg$FAILED) {
+                        s4 = null;
+                      }
+                      if (s4 !== peg$FAILED) {
+                        if (input.charCodeAt(peg$currPos) === 42) {
+                          s5 = peg$c24;
+                          peg$currPos++;
+                        } else {
+                          s5 = peg$FAILED;
+                          if (peg$silentFails === 0) { peg$fail(peg$c25); }
+                        }
+                        if (s5 !== peg$FAILED) {
+                          peg$savedPos = s0;
+                          s1 = peg$c34(s1);
+                          s0 = s1;
+                        } else {
+                          peg$currPos = s0;
+                          s0 = peg$FAILED;
+                        }
+                      } else {
+                        peg$currPos = s0;
+                        s0 = peg$FAILED;
+                      }
+                    } else {
+                      peg$currPos = s0;
+                      s0 = peg$FAILED;
+                    }
+                  } else {
+                    peg$currPos = s0;
+                    s0 = peg$FAILED;
+                  }
+                } else {
+                  peg$currPos = s0;
+                  s0 = peg$FAILED;
+                }
+                if (s0 === peg$FAILED) {
+                  s0 = peg$currPos;
+                  s1 = peg$parsesearchValue();
+                  if (s1 !== peg$FAILED) {
+                    s2 = peg$parse_();
+                    if (s2 === peg$FAILED) {
+                      s2 = null;
+                    }
+                    if (s2 !== peg$FAILED) {
+                      s3 = peg$parseinToken();
+                      if (s3 !== peg$FAILED) {
+                        s4 = peg$parse_();
+                        if (s4 === peg$FAILED) {
+                          s4 = null;
+                        }
+                        if (s4 !== peg$FAILED) {
+                          s5 = peg$parsefieldReference();
+                          if (s5 !== peg$FAILED) {
+                            peg$savedPos = s0;
+                            s1 = peg$c35(s1, s5);
+                            s0 = s1;
+                          } else {
+                            peg$currPos = s0;
+                            s0 = peg$FAILED;
+                          }
+                        } else {
+                          peg$currPos = s0;
+                          s0 = peg$FAILED;
+                        }
+                      } else {
+                        peg$currPos = s0;
+                        s0 = peg$FAILED;
+                      }
+                    } else {
+                      peg$currPos = s0;
+                      s0 = peg$FAILED;
+                    }
+                  } else {
+                    peg$currPos = s0;
+                    s0 = peg$FAILED;
+                  }
+                  if (s0 === peg$FAILED) {
+                    s0 = peg$currPos;
+                    s1 = peg$parsesearchValue();
+                    if (s1 !== peg$FAILED) {
+                      peg$savedPos = s0;
+                      s1 = peg$c36(s1);
+                    }
+                    s0 = s1;
+                  }
+                }
               }
-              s0 = s1;
             }
           }
         }
@@ -1554,7 +1752,7 @@ function peg$parse(input, options) {
                   }
                   if (s2 !== peg$FAILED) {
                     peg$savedPos = s0;
-                    s1 = peg$c34(s1);
+                    s1 = peg$c37(s1);
                     s0 = s1;
                   } else {
                     peg$currPos = s0;
@@ -1594,7 +1792,7 @@ function peg$parse(input, options) {
                     s2 = peg$parseBooleanLiteral();
                     if (s2 !== peg$FAILED) {
                       peg$savedPos = s0;
-                      s1 = peg$c35(s2);
+                      s1 = peg$c38(s2);
                       s0 = s1;
                     } else {
                       peg$currPos = s0;
@@ -1634,7 +1832,7 @@ function peg$parse(input, options) {
                       s2 = peg$parseNullLiteral();
                       if (s2 !== peg$FAILED) {
                         peg$savedPos = s0;
-                        s1 = peg$c35(s2);
+                        s1 = peg$c38(s2);
                         s0 = s1;
                       } else {
                         peg$currPos = s0;
@@ -1674,7 +1872,7 @@ function peg$parse(input, options) {
                         s2 = peg$parsesearchWord();
                         if (s2 !== peg$FAILED) {
                           peg$savedPos = s0;
-                          s1 = peg$c36(s2);
+                          s1 = peg$c39(s2);
                           s0 = s1;
                         } else {
                           peg$currPos = s0;
@@ -1697,6 +1895,79 @@ function peg$parse(input, options) {
     return s0;
   }
 
+  function peg$parsebarewordField() {
+    var s0, s1, s2, s3, s4, s5;
+
+    s0 = peg$currPos;
+    s1 = peg$currPos;
+    peg$silentFails++;
+    s2 = peg$currPos;
+    s3 = peg$parseBooleanLiteral();
+    if (s3 === peg$FAILED) {
+      s3 = peg$parseNullLiteral();
+    }
+    if (s3 !== peg$FAILED) {
+      s4 = peg$currPos;
+      peg$silentFails++;
+      s5 = peg$parsesearchWordPart();
+      peg$silentFails--;
+      if (s5 === peg$FAILED) {
+        s4 = void 0;
+      } else {
+        peg$currPos = s4;
+        s4 = peg$FAILED;
+      }
+      if (s4 !== peg$FAILED) {
+        s3 = [s3, s4];
+        s2 = s3;
+      } else {
+        peg$currPos = s2;
+        s2 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s2;
+      s2 = peg$FAILED;
+    }
+    peg$silentFails--;
+    if (s2 === peg$FAILED) {
+      s1 = void 0;
+    } else {
+      peg$currPos = s1;
+      s1 = peg$FAILED;
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parsefieldReference();
+      if (s2 !== peg$FAILED) {
+        s3 = peg$currPos;
+        peg$silentFails++;
+        s4 = peg$parsesearchWordPart();
+        peg$silentFails--;
+        if (s4 === peg$FAILED) {
+          s3 = void 0;
+        } else {
+          peg$currPos = s3;
+          s3 = peg$FAILED;
+        }
+        if (s3 !== peg$FAILED) {
+          peg$savedPos = s0;
+          s1 = peg$c40(s2);
+          s0 = s1;
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
   function peg$parseStringLiteral() {
     var s0, s1;
 
@@ -1704,7 +1975,7 @@ function peg$parse(input, options) {
     s1 = peg$parsequotedString();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c37(s1);
+      s1 = peg$c41(s1);
     }
     s0 = s1;
 
@@ -1718,7 +1989,7 @@ function peg$parse(input, options) {
     s1 = peg$parsereString();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c38(s1);
+      s1 = peg$c42(s1);
     }
     s0 = s1;
 
@@ -1732,7 +2003,7 @@ function peg$parse(input, options) {
     s1 = peg$parseport();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c39(s1);
+      s1 = peg$c43(s1);
     }
     s0 = s1;
 
@@ -1746,7 +2017,7 @@ function peg$parse(input, options) {
     s1 = peg$parseip6subnet();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c40(s1);
+      s1 = peg$c44(s1);
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -1754,7 +2025,7 @@ function peg$parse(input, options) {
       s1 = peg$parsesubnet();
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c40(s1);
+        s1 = peg$c44(s1);
       }
       s0 = s1;
     }
@@ -1769,7 +2040,7 @@ function peg$parse(input, options) {
     s1 = peg$parseip6addr();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c41(s1);
+      s1 = peg$c45(s1);
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -1777,7 +2048,7 @@ function peg$parse(input, options) {
       s1 = peg$parseaddr();
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c41(s1);
+        s1 = peg$c45(s1);
       }
       s0 = s1;
     }
@@ -1792,7 +2063,7 @@ function peg$parse(input, options) {
     s1 = peg$parsesdouble();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c42(s1);
+      s1 = peg$c46(s1);
     }
     s0 = s1;
 
@@ -1806,7 +2077,7 @@ function peg$parse(input, options) {
     s1 = peg$parsesinteger();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c43(s1);
+      s1 = peg$c47(s1);
     }
     s0 = s1;
 
@@ -1817,30 +2088,30 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4) === peg$c44) {
-      s1 = peg$c44;
+    if (input.substr(peg$currPos, 4) === peg$c48) {
+      s1 = peg$c48;
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c45); }
+      if (peg$silentFails === 0) { peg$fail(peg$c49); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c46();
+      s1 = peg$c50();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 5) === peg$c47) {
-        s1 = peg$c47;
+      if (input.substr(peg$currPos, 5) === peg$c51) {
+        s1 = peg$c51;
         peg$currPos += 5;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c48); }
+        if (peg$silentFails === 0) { peg$fail(peg$c52); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c49();
+        s1 = peg$c53();
       }
       s0 = s1;
     }
@@ -1852,16 +2123,16 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4) === peg$c50) {
-      s1 = peg$c50;
+    if (input.substr(peg$currPos, 4) === peg$c54) {
+      s1 = peg$c54;
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c51); }
+      if (peg$silentFails === 0) { peg$fail(peg$c55); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c52();
+      s1 = peg$c56();
     }
     s0 = s1;
 
@@ -1896,7 +2167,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c53(s1, s2);
+        s1 = peg$c57(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -1920,11 +2191,11 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 59) {
-        s2 = peg$c54;
+        s2 = peg$c58;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c55); }
+        if (peg$silentFails === 0) { peg$fail(peg$c59); }
       }
       if (s2 !== peg$FAILED) {
         s3 = peg$parse_();
@@ -1935,7 +2206,7 @@ function peg$parse(input, options) {
           s4 = peg$parseprocChain();
           if (s4 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c56(s4);
+            s1 = peg$c60(s4);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -1994,7 +2265,7 @@ function peg$parse(input, options) {
                 }
                 if (s5 !== peg$FAILED) {
                   peg$savedPos = s0;
-                  s1 = peg$c57(s3);
+                  s1 = peg$c61(s3);
                   s0 = s1;
                 } else {
                   peg$currPos = s0;
@@ -2026,20 +2297,20 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 2).toLowerCase() === peg$c58) {
+    if (input.substr(peg$currPos, 2).toLowerCase() === peg$c62) {
       s1 = input.substr(peg$currPos, 2);
       peg$currPos += 2;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c59); }
+      if (peg$silentFails === 0) { peg$fail(peg$c63); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
       if (s2 !== peg$FAILED) {
-        s3 = peg$parsefieldExprList();
+        s3 = peg$parsegroupByKeyList();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c60(s3);
+          s1 = peg$c64(s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -2057,25 +2328,37 @@ function peg$parse(input, options) {
     return s0;
   }
 
-  function peg$parseeveryDur() {
-    var s0, s1, s2, s3;
+  function peg$parsegroupByKey() {
+    var s0, s1, s2, s3, s4, s5;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 5).toLowerCase() === peg$c61) {
-      s1 = input.substr(peg$currPos, 5);
-      peg$currPos += 5;
-    } else {
-      s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c62); }
-    }
+    s1 = peg$parsefieldName();
     if (s1 !== peg$FAILED) {
-      s2 = peg$parse_();
+      s2 = peg$parse__();
       if (s2 !== peg$FAILED) {
-        s3 = peg$parseduration();
+        if (input.charCodeAt(peg$currPos) === 61) {
+          s3 = peg$c65;
+          peg$currPos++;
+        } else {
+          s3 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c66); }
+        }
         if (s3 !== peg$FAILED) {
-          peg$savedPos = s0;
-          s1 = peg$c63(s3);
-          s0 = s1;
+          s4 = peg$parse__();
+          if (s4 !== peg$FAILED) {
+            s5 = peg$parseConditionalExpression();
+            if (s5 !== peg$FAILED) {
+              peg$savedPos = s0;
+              s1 = peg$c67(s1, s5);
+              s0 = s1;
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
         } else {
           peg$currPos = s0;
           s0 = peg$FAILED;
@@ -2088,14 +2371,164 @@ function peg$parse(input, options) {
       peg$currPos = s0;
       s0 = peg$FAILED;
     }
+    if (s0 === peg$FAILED) {
+      s0 = peg$currPos;
+      s1 = peg$parsefieldExpr();
+      if (s1 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c68(s1);
+      }
+      s0 = s1;
+    }
 
     return s0;
   }
 
-  function peg$parseequalityToken() {
-    var s0;
+  function peg$parsegroupByKeyList() {
+    var s0, s1, s2, s3, s4, s5, s6, s7;
 
-    s0 = peg$parseEqualityOperator();
+    s0 = peg$currPos;
+    s1 = peg$parsegroupByKey();
+    if (s1 !== peg$FAILED) {
+      s2 = [];
+      s3 = peg$currPos;
+      s4 = peg$parse_();
+      if (s4 === peg$FAILED) {
+        s4 = null;
+      }
+      if (s4 !== peg$FAILED) {
+        if (input.charCodeAt(peg$currPos) === 44) {
+          s5 = peg$c69;
+          peg$currPos++;
+        } else {
+          s5 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c70); }
+        }
+        if (s5 !== peg$FAILED) {
+          s6 = peg$parse_();
+          if (s6 === peg$FAILED) {
+            s6 = null;
+          }
+          if (s6 !== peg$FAILED) {
+            s7 = peg$parsegroupByKey();
+            if (s7 !== peg$FAILED) {
+              peg$savedPos = s3;
+              s4 = peg$c71(s1, s7);
+              s3 = s4;
+            } else {
+              peg$currPos = s3;
+              s3 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s3;
+            s3 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s3;
+          s3 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s3;
+        s3 = peg$FAILED;
+      }
+      while (s3 !== peg$FAILED) {
+        s2.push(s3);
+        s3 = peg$currPos;
+        s4 = peg$parse_();
+        if (s4 === peg$FAILED) {
+          s4 = null;
+        }
+        if (s4 !== peg$FAILED) {
+          if (input.charCodeAt(peg$currPos) === 44) {
+            s5 = peg$c69;
+            peg$currPos++;
+          } else {
+            s5 = peg$FAILED;
+            if (peg$silentFails === 0) { peg$fail(peg$c70); }
+          }
+          if (s5 !== peg$FAILED) {
+            s6 = peg$parse_();
+            if (s6 === peg$FAILED) {
+              s6 = null;
+            }
+            if (s6 !== peg$FAILED) {
+              s7 = peg$parsegroupByKey();
+              if (s7 !== peg$FAILED) {
+                peg$savedPos = s3;
+                s4 = peg$c71(s1, s7);
+                s3 = s4;
+              } else {
+                peg$currPos = s3;
+                s3 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s3;
+              s3 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s3;
+            s3 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s3;
+          s3 = peg$FAILED;
+        }
+      }
+      if (s2 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c72(s1, s2);
+        s0 = s1;
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parseeveryDur() {
+    var s0, s1, s2, s3;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 5).toLowerCase() === peg$c73) {
+      s1 = input.substr(peg$currPos, 5);
+      peg$currPos += 5;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c74); }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse_();
+      if (s2 !== peg$FAILED) {
+        s3 = peg$parseduration();
+        if (s3 !== peg$FAILED) {
+          peg$savedPos = s0;
+          s1 = peg$c75(s3);
+          s0 = s1;
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parseequalityToken() {
+    var s0;
+
+    s0 = peg$parseEqualityOperator();
     if (s0 === peg$FAILED) {
       s0 = peg$parseRelativeOperator();
     }
@@ -2107,16 +2540,16 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c64) {
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c76) {
       s1 = input.substr(peg$currPos, 3);
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c65); }
+      if (peg$silentFails === 0) { peg$fail(peg$c77); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c66();
+      s1 = peg$c78();
     }
     s0 = s1;
 
@@ -2127,16 +2560,16 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 2).toLowerCase() === peg$c67) {
+    if (input.substr(peg$currPos, 2).toLowerCase() === peg$c79) {
       s1 = input.substr(peg$currPos, 2);
       peg$currPos += 2;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c68); }
+      if (peg$silentFails === 0) { peg$fail(peg$c80); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c66();
+      s1 = peg$c78();
     }
     s0 = s1;
 
@@ -2147,16 +2580,16 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 2).toLowerCase() === peg$c69) {
+    if (input.substr(peg$currPos, 2).toLowerCase() === peg$c81) {
       s1 = input.substr(peg$currPos, 2);
       peg$currPos += 2;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c70); }
+      if (peg$silentFails === 0) { peg$fail(peg$c82); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c66();
+      s1 = peg$c78();
     }
     s0 = s1;
 
@@ -2167,16 +2600,16 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c71) {
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c83) {
       s1 = input.substr(peg$currPos, 3);
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c72); }
+      if (peg$silentFails === 0) { peg$fail(peg$c84); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c66();
+      s1 = peg$c78();
     }
     s0 = s1;
 
@@ -2197,7 +2630,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c66();
+        s1 = peg$c78();
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -2214,12 +2647,12 @@ function peg$parse(input, options) {
   function peg$parsefieldNameStart() {
     var s0;
 
-    if (peg$c73.test(input.charAt(peg$currPos))) {
+    if (peg$c85.test(input.charAt(peg$currPos))) {
       s0 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c74); }
+      if (peg$silentFails === 0) { peg$fail(peg$c86); }
     }
 
     return s0;
@@ -2230,12 +2663,12 @@ function peg$parse(input, options) {
 
     s0 = peg$parsefieldNameStart();
     if (s0 === peg$FAILED) {
-      if (peg$c75.test(input.charAt(peg$currPos))) {
+      if (peg$c87.test(input.charAt(peg$currPos))) {
         s0 = input.charAt(peg$currPos);
         peg$currPos++;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c76); }
+        if (peg$silentFails === 0) { peg$fail(peg$c88); }
       }
     }
 
@@ -2251,17 +2684,17 @@ function peg$parse(input, options) {
       s2 = [];
       s3 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 46) {
-        s4 = peg$c77;
+        s4 = peg$c89;
         peg$currPos++;
       } else {
         s4 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c78); }
+        if (peg$silentFails === 0) { peg$fail(peg$c90); }
       }
       if (s4 !== peg$FAILED) {
         s5 = peg$parsefieldName();
         if (s5 !== peg$FAILED) {
           peg$savedPos = s3;
-          s4 = peg$c79(s1, s5);
+          s4 = peg$c91(s1, s5);
           s3 = s4;
         } else {
           peg$currPos = s3;
@@ -2274,25 +2707,25 @@ function peg$parse(input, options) {
       if (s3 === peg$FAILED) {
         s3 = peg$currPos;
         if (input.charCodeAt(peg$currPos) === 91) {
-          s4 = peg$c80;
+          s4 = peg$c92;
           peg$currPos++;
         } else {
           s4 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c81); }
+          if (peg$silentFails === 0) { peg$fail(peg$c93); }
         }
         if (s4 !== peg$FAILED) {
           s5 = peg$parsesuint();
           if (s5 !== peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 93) {
-              s6 = peg$c82;
+              s6 = peg$c94;
               peg$currPos++;
             } else {
               s6 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c83); }
+              if (peg$silentFails === 0) { peg$fail(peg$c95); }
             }
             if (s6 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c84(s1, s5);
+              s4 = peg$c96(s1, s5);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -2311,17 +2744,17 @@ function peg$parse(input, options) {
         s2.push(s3);
         s3 = peg$currPos;
         if (input.charCodeAt(peg$currPos) === 46) {
-          s4 = peg$c77;
+          s4 = peg$c89;
           peg$currPos++;
         } else {
           s4 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c78); }
+          if (peg$silentFails === 0) { peg$fail(peg$c90); }
         }
         if (s4 !== peg$FAILED) {
           s5 = peg$parsefieldName();
           if (s5 !== peg$FAILED) {
             peg$savedPos = s3;
-            s4 = peg$c79(s1, s5);
+            s4 = peg$c91(s1, s5);
             s3 = s4;
           } else {
             peg$currPos = s3;
@@ -2334,25 +2767,25 @@ function peg$parse(input, options) {
         if (s3 === peg$FAILED) {
           s3 = peg$currPos;
           if (input.charCodeAt(peg$currPos) === 91) {
-            s4 = peg$c80;
+            s4 = peg$c92;
             peg$currPos++;
           } else {
             s4 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c81); }
+            if (peg$silentFails === 0) { peg$fail(peg$c93); }
           }
           if (s4 !== peg$FAILED) {
             s5 = peg$parsesuint();
             if (s5 !== peg$FAILED) {
               if (input.charCodeAt(peg$currPos) === 93) {
-                s6 = peg$c82;
+                s6 = peg$c94;
                 peg$currPos++;
               } else {
                 s6 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c83); }
+                if (peg$silentFails === 0) { peg$fail(peg$c95); }
               }
               if (s6 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c84(s1, s5);
+                s4 = peg$c96(s1, s5);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -2370,7 +2803,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c85(s1, s2);
+        s1 = peg$c97(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -2424,7 +2857,7 @@ function peg$parse(input, options) {
                 }
                 if (s7 !== peg$FAILED) {
                   peg$savedPos = s0;
-                  s1 = peg$c86(s1, s5);
+                  s1 = peg$c98(s1, s5);
                   s0 = s1;
                 } else {
                   peg$currPos = s0;
@@ -2465,16 +2898,16 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c87) {
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c99) {
       s1 = input.substr(peg$currPos, 3);
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c88); }
+      if (peg$silentFails === 0) { peg$fail(peg$c100); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c89();
+      s1 = peg$c101();
     }
     s0 = s1;
 
@@ -2495,11 +2928,11 @@ function peg$parse(input, options) {
       }
       if (s4 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 44) {
-          s5 = peg$c90;
+          s5 = peg$c69;
           peg$currPos++;
         } else {
           s5 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c91); }
+          if (peg$silentFails === 0) { peg$fail(peg$c70); }
         }
         if (s5 !== peg$FAILED) {
           s6 = peg$parse_();
@@ -2536,11 +2969,11 @@ function peg$parse(input, options) {
         }
         if (s4 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 44) {
-            s5 = peg$c90;
+            s5 = peg$c69;
             peg$currPos++;
           } else {
             s5 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c91); }
+            if (peg$silentFails === 0) { peg$fail(peg$c70); }
           }
           if (s5 !== peg$FAILED) {
             s6 = peg$parse_();
@@ -2571,7 +3004,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c92(s1, s2);
+        s1 = peg$c102(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -2594,17 +3027,17 @@ function peg$parse(input, options) {
       s2 = [];
       s3 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 46) {
-        s4 = peg$c77;
+        s4 = peg$c89;
         peg$currPos++;
       } else {
         s4 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c78); }
+        if (peg$silentFails === 0) { peg$fail(peg$c90); }
       }
       if (s4 !== peg$FAILED) {
         s5 = peg$parsefieldName();
         if (s5 !== peg$FAILED) {
           peg$savedPos = s3;
-          s4 = peg$c79(s1, s5);
+          s4 = peg$c91(s1, s5);
           s3 = s4;
         } else {
           peg$currPos = s3;
@@ -2618,17 +3051,17 @@ function peg$parse(input, options) {
         s2.push(s3);
         s3 = peg$currPos;
         if (input.charCodeAt(peg$currPos) === 46) {
-          s4 = peg$c77;
+          s4 = peg$c89;
           peg$currPos++;
         } else {
           s4 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c78); }
+          if (peg$silentFails === 0) { peg$fail(peg$c90); }
         }
         if (s4 !== peg$FAILED) {
           s5 = peg$parsefieldName();
           if (s5 !== peg$FAILED) {
             peg$savedPos = s3;
-            s4 = peg$c79(s1, s5);
+            s4 = peg$c91(s1, s5);
             s3 = s4;
           } else {
             peg$currPos = s3;
@@ -2641,7 +3074,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c93(s1, s2);
+        s1 = peg$c103(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -2669,11 +3102,11 @@ function peg$parse(input, options) {
       }
       if (s4 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 44) {
-          s5 = peg$c90;
+          s5 = peg$c69;
           peg$currPos++;
         } else {
           s5 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c91); }
+          if (peg$silentFails === 0) { peg$fail(peg$c70); }
         }
         if (s5 !== peg$FAILED) {
           s6 = peg$parse_();
@@ -2684,7 +3117,7 @@ function peg$parse(input, options) {
             s7 = peg$parsefieldRefDotOnly();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c94(s1, s7);
+              s4 = peg$c104(s1, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -2711,11 +3144,11 @@ function peg$parse(input, options) {
         }
         if (s4 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 44) {
-            s5 = peg$c90;
+            s5 = peg$c69;
             peg$currPos++;
           } else {
             s5 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c91); }
+            if (peg$silentFails === 0) { peg$fail(peg$c70); }
           }
           if (s5 !== peg$FAILED) {
             s6 = peg$parse_();
@@ -2726,7 +3159,7 @@ function peg$parse(input, options) {
               s7 = peg$parsefieldRefDotOnly();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c94(s1, s7);
+                s4 = peg$c104(s1, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -2747,7 +3180,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c95(s1, s2);
+        s1 = peg$c105(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -2761,11 +3194,136 @@ function peg$parse(input, options) {
     return s0;
   }
 
-  function peg$parsefieldNameList() {
+  function peg$parsefieldPatternName() {
+    var s0, s1, s2, s3;
+
+    s0 = peg$currPos;
+    s1 = peg$parsefieldNameStart();
+    if (s1 === peg$FAILED) {
+      if (input.charCodeAt(peg$currPos) === 42) {
+        s1 = peg$c24;
+        peg$currPos++;
+      } else {
+        s1 = peg$FAILED;
+        if (peg$silentFails === 0) { peg$fail(peg$c25); }
+      }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = [];
+      s3 = peg$parsefieldNameRest();
+      if (s3 === peg$FAILED) {
+        if (input.charCodeAt(peg$currPos) === 42) {
+          s3 = peg$c24;
+          peg$currPos++;
+        } else {
+          s3 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c25); }
+        }
+      }
+      while (s3 !== peg$FAILED) {
+        s2.push(s3);
+        s3 = peg$parsefieldNameRest();
+        if (s3 === peg$FAILED) {
+          if (input.charCodeAt(peg$currPos) === 42) {
+            s3 = peg$c24;
+            peg$currPos++;
+          } else {
+            s3 = peg$FAILED;
+            if (peg$silentFails === 0) { peg$fail(peg$c25); }
+          }
+        }
+      }
+      if (s2 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c78();
+        s0 = s1;
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parsefieldPattern() {
+    var s0, s1, s2, s3, s4, s5;
+
+    s0 = peg$currPos;
+    s1 = peg$parsefieldPatternName();
+    if (s1 !== peg$FAILED) {
+      s2 = [];
+      s3 = peg$currPos;
+      if (input.charCodeAt(peg$currPos) === 46) {
+        s4 = peg$c89;
+        peg$currPos++;
+      } else {
+        s4 = peg$FAILED;
+        if (peg$silentFails === 0) { peg$fail(peg$c90); }
+      }
+      if (s4 !== peg$FAILED) {
+        s5 = peg$parsefieldPatternName();
+        if (s5 !== peg$FAILED) {
+          peg$savedPos = s3;
+          s4 = peg$c91(s1, s5);
+          s3 = s4;
+        } else {
+          peg$currPos = s3;
+          s3 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s3;
+        s3 = peg$FAILED;
+      }
+      while (s3 !== peg$FAILED) {
+        s2.push(s3);
+        s3 = peg$currPos;
+        if (input.charCodeAt(peg$currPos) === 46) {
+          s4 = peg$c89;
+          peg$currPos++;
+        } else {
+          s4 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c90); }
+        }
+        if (s4 !== peg$FAILED) {
+          s5 = peg$parsefieldPatternName();
+          if (s5 !== peg$FAILED) {
+            peg$savedPos = s3;
+            s4 = peg$c91(s1, s5);
+            s3 = s4;
+          } else {
+            peg$currPos = s3;
+            s3 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s3;
+          s3 = peg$FAILED;
+        }
+      }
+      if (s2 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c103(s1, s2);
+        s0 = s1;
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parsefieldPatternList() {
     var s0, s1, s2, s3, s4, s5, s6, s7;
 
     s0 = peg$currPos;
-    s1 = peg$parsefieldName();
+    s1 = peg$parsefieldPattern();
     if (s1 !== peg$FAILED) {
       s2 = [];
       s3 = peg$currPos;
@@ -2775,11 +3333,11 @@ function peg$parse(input, options) {
       }
       if (s4 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 44) {
-          s5 = peg$c90;
+          s5 = peg$c69;
           peg$currPos++;
         } else {
           s5 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c91); }
+          if (peg$silentFails === 0) { peg$fail(peg$c70); }
         }
         if (s5 !== peg$FAILED) {
           s6 = peg$parse_();
@@ -2787,9 +3345,10 @@ function peg$parse(input, options) {
             s6 = null;
           }
           if (s6 !== peg$FAILED) {
-            s7 = peg$parsefieldName();
+            s7 = peg$parsefieldPattern();
             if (s7 !== peg$FAILED) {
-              s4 = [s4, s5, s6, s7];
+              peg$savedPos = s3;
+              s4 = peg$c104(s1, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -2816,11 +3375,11 @@ function peg$parse(input, options) {
         }
         if (s4 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 44) {
-            s5 = peg$c90;
+            s5 = peg$c69;
             peg$currPos++;
           } else {
             s5 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c91); }
+            if (peg$silentFails === 0) { peg$fail(peg$c70); }
           }
           if (s5 !== peg$FAILED) {
             s6 = peg$parse_();
@@ -2828,9 +3387,10 @@ function peg$parse(input, options) {
               s6 = null;
             }
             if (s6 !== peg$FAILED) {
-              s7 = peg$parsefieldName();
+              s7 = peg$parsefieldPattern();
               if (s7 !== peg$FAILED) {
-                s4 = [s4, s5, s6, s7];
+                peg$savedPos = s3;
+                s4 = peg$c104(s1, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -2851,7 +3411,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c96(s1, s2);
+        s1 = peg$c72(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -2865,20 +3425,124 @@ function peg$parse(input, options) {
     return s0;
   }
 
-  function peg$parsecountOp() {
-    var s0, s1;
+  function peg$parsefieldNameList() {
+    var s0, s1, s2, s3, s4, s5, s6, s7;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 5).toLowerCase() === peg$c97) {
-      s1 = input.substr(peg$currPos, 5);
-      peg$currPos += 5;
-    } else {
-      s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c98); }
-    }
+    s1 = peg$parsefieldName();
+    if (s1 !== peg$FAILED) {
+      s2 = [];
+      s3 = peg$currPos;
+      s4 = peg$parse_();
+      if (s4 === peg$FAILED) {
+        s4 = null;
+      }
+      if (s4 !== peg$FAILED) {
+        if (input.charCodeAt(peg$currPos) === 44) {
+          s5 = peg$c69;
+          peg$currPos++;
+        } else {
+          s5 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c70); }
+        }
+        if (s5 !== peg$FAILED) {
+          s6 = peg$parse_();
+          if (s6 === peg$FAILED) {
+            s6 = null;
+          }
+          if (s6 !== peg$FAILED) {
+            s7 = peg$parsefieldName();
+            if (s7 !== peg$FAILED) {
+              s4 = [s4, s5, s6, s7];
+              s3 = s4;
+            } else {
+              peg$currPos = s3;
+              s3 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s3;
+            s3 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s3;
+          s3 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s3;
+        s3 = peg$FAILED;
+      }
+      while (s3 !== peg$FAILED) {
+        s2.push(s3);
+        s3 = peg$currPos;
+        s4 = peg$parse_();
+        if (s4 === peg$FAILED) {
+          s4 = null;
+        }
+        if (s4 !== peg$FAILED) {
+          if (input.charCodeAt(peg$currPos) === 44) {
+            s5 = peg$c69;
+            peg$currPos++;
+          } else {
+            s5 = peg$FAILED;
+            if (peg$silentFails === 0) { peg$fail(peg$c70); }
+          }
+          if (s5 !== peg$FAILED) {
+            s6 = peg$parse_();
+            if (s6 === peg$FAILED) {
+              s6 = null;
+            }
+            if (s6 !== peg$FAILED) {
+              s7 = peg$parsefieldName();
+              if (s7 !== peg$FAILED) {
+                s4 = [s4, s5, s6, s7];
+                s3 = s4;
+              } else {
+                peg$currPos = s3;
+                s3 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s3;
+              s3 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s3;
+            s3 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s3;
+          s3 = peg$FAILED;
+        }
+      }
+      if (s2 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c106(s1, s2);
+        s0 = s1;
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parsecountOp() {
+    var s0, s1;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 5).toLowerCase() === peg$c107) {
+      s1 = input.substr(peg$currPos, 5);
+      peg$currPos += 5;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c108); }
+    }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c99();
+      s1 = peg$c109();
     }
     s0 = s1;
 
@@ -2889,158 +3553,218 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c100) {
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c110) {
       s1 = input.substr(peg$currPos, 3);
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c101); }
+      if (peg$silentFails === 0) { peg$fail(peg$c111); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c102();
+      s1 = peg$c112();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 3).toLowerCase() === peg$c103) {
+      if (input.substr(peg$currPos, 3).toLowerCase() === peg$c113) {
         s1 = input.substr(peg$currPos, 3);
         peg$currPos += 3;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c104); }
+        if (peg$silentFails === 0) { peg$fail(peg$c114); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c105();
+        s1 = peg$c115();
       }
       s0 = s1;
       if (s0 === peg$FAILED) {
         s0 = peg$currPos;
-        if (input.substr(peg$currPos, 5).toLowerCase() === peg$c106) {
-          s1 = input.substr(peg$currPos, 5);
-          peg$currPos += 5;
+        if (input.substr(peg$currPos, 6).toLowerCase() === peg$c116) {
+          s1 = input.substr(peg$currPos, 6);
+          peg$currPos += 6;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c107); }
+          if (peg$silentFails === 0) { peg$fail(peg$c117); }
         }
         if (s1 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c108();
+          s1 = peg$c118();
         }
         s0 = s1;
         if (s0 === peg$FAILED) {
           s0 = peg$currPos;
-          if (input.substr(peg$currPos, 2).toLowerCase() === peg$c109) {
-            s1 = input.substr(peg$currPos, 2);
-            peg$currPos += 2;
+          if (input.substr(peg$currPos, 5).toLowerCase() === peg$c119) {
+            s1 = input.substr(peg$currPos, 5);
+            peg$currPos += 5;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c110); }
+            if (peg$silentFails === 0) { peg$fail(peg$c120); }
           }
           if (s1 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c108();
+            s1 = peg$c121();
           }
           s0 = s1;
           if (s0 === peg$FAILED) {
             s0 = peg$currPos;
-            if (input.substr(peg$currPos, 3).toLowerCase() === peg$c111) {
-              s1 = input.substr(peg$currPos, 3);
-              peg$currPos += 3;
+            if (input.substr(peg$currPos, 2).toLowerCase() === peg$c122) {
+              s1 = input.substr(peg$currPos, 2);
+              peg$currPos += 2;
             } else {
               s1 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c112); }
+              if (peg$silentFails === 0) { peg$fail(peg$c123); }
             }
             if (s1 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c113();
+              s1 = peg$c121();
             }
             s0 = s1;
             if (s0 === peg$FAILED) {
               s0 = peg$currPos;
-              if (input.substr(peg$currPos, 7).toLowerCase() === peg$c114) {
-                s1 = input.substr(peg$currPos, 7);
-                peg$currPos += 7;
+              if (input.substr(peg$currPos, 3).toLowerCase() === peg$c124) {
+                s1 = input.substr(peg$currPos, 3);
+                peg$currPos += 3;
               } else {
                 s1 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c115); }
+                if (peg$silentFails === 0) { peg$fail(peg$c125); }
               }
               if (s1 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c116();
+                s1 = peg$c126();
               }
               s0 = s1;
               if (s0 === peg$FAILED) {
                 s0 = peg$currPos;
-                if (input.substr(peg$currPos, 3).toLowerCase() === peg$c117) {
-                  s1 = input.substr(peg$currPos, 3);
-                  peg$currPos += 3;
+                if (input.substr(peg$currPos, 7).toLowerCase() === peg$c127) {
+                  s1 = input.substr(peg$currPos, 7);
+                  peg$currPos += 7;
                 } else {
                   s1 = peg$FAILED;
-                  if (peg$silentFails === 0) { peg$fail(peg$c118); }
+                  if (peg$silentFails === 0) { peg$fail(peg$c128); }
                 }
                 if (s1 !== peg$FAILED) {
                   peg$savedPos = s0;
-                  s1 = peg$c119();
+                  s1 = peg$c129();
                 }
                 s0 = s1;
                 if (s0 === peg$FAILED) {
                   s0 = peg$currPos;
-                  if (input.substr(peg$currPos, 3).toLowerCase() === peg$c120) {
+                  if (input.substr(peg$currPos, 3).toLowerCase() === peg$c130) {
                     s1 = input.substr(peg$currPos, 3);
                     peg$currPos += 3;
                   } else {
                     s1 = peg$FAILED;
-                    if (peg$silentFails === 0) { peg$fail(peg$c121); }
+                    if (peg$silentFails === 0) { peg$fail(peg$c131); }
                   }
                   if (s1 !== peg$FAILED) {
                     peg$savedPos = s0;
-                    s1 = peg$c122();
+                    s1 = peg$c132();
                   }
                   s0 = s1;
                   if (s0 === peg$FAILED) {
                     s0 = peg$currPos;
-                    if (input.substr(peg$currPos, 5).toLowerCase() === peg$c123) {
-                      s1 = input.substr(peg$currPos, 5);
-                      peg$currPos += 5;
+                    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c133) {
+                      s1 = input.substr(peg$currPos, 3);
+                      peg$currPos += 3;
                     } else {
                       s1 = peg$FAILED;
-                      if (peg$silentFails === 0) { peg$fail(peg$c124); }
+                      if (peg$silentFails === 0) { peg$fail(peg$c134); }
                     }
                     if (s1 !== peg$FAILED) {
                       peg$savedPos = s0;
-                      s1 = peg$c125();
+                      s1 = peg$c135();
                     }
                     s0 = s1;
                     if (s0 === peg$FAILED) {
                       s0 = peg$currPos;
-                      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c126) {
-                        s1 = input.substr(peg$currPos, 4);
-                        peg$currPos += 4;
+                      if (input.substr(peg$currPos, 5).toLowerCase() === peg$c136) {
+                        s1 = input.substr(peg$currPos, 5);
+                        peg$currPos += 5;
                       } else {
                         s1 = peg$FAILED;
-                        if (peg$silentFails === 0) { peg$fail(peg$c127); }
+                        if (peg$silentFails === 0) { peg$fail(peg$c137); }
                       }
                       if (s1 !== peg$FAILED) {
                         peg$savedPos = s0;
-                        s1 = peg$c128();
+                        s1 = peg$c138();
                       }
                       s0 = s1;
                       if (s0 === peg$FAILED) {
                         s0 = peg$currPos;
-                        if (input.substr(peg$currPos, 13).toLowerCase() === peg$c129) {
-                          s1 = input.substr(peg$currPos, 13);
-                          peg$currPos += 13;
+                        if (input.substr(peg$currPos, 4).toLowerCase() === peg$c139) {
+                          s1 = input.substr(peg$currPos, 4);
+                          peg$currPos += 4;
                         } else {
                           s1 = peg$FAILED;
-                          if (peg$silentFails === 0) { peg$fail(peg$c130); }
+                          if (peg$silentFails === 0) { peg$fail(peg$c140); }
                         }
                         if (s1 !== peg$FAILED) {
                           peg$savedPos = s0;
-                          s1 = peg$c131();
+                          s1 = peg$c141();
                         }
                         s0 = s1;
+                        if (s0 === peg$FAILED) {
+                          s0 = peg$currPos;
+                          if (input.substr(peg$currPos, 13).toLowerCase() === peg$c142) {
+                            s1 = input.substr(peg$currPos, 13);
+                            peg$currPos += 13;
+                          } else {
+                            s1 = peg$FAILED;
+                            if (peg$silentFails === 0) { peg$fail(peg$c143); }
+                          }
+                          if (s1 !== peg$FAILED) {
+                            peg$savedPos = s0;
+                            s1 = peg$c144();
+                          }
+                          s0 = s1;
+                          if (s0 === peg$FAILED) {
+                            s0 = peg$currPos;
+                            if (input.substr(peg$currPos, 6).toLowerCase() === peg$c145) {
+                              s1 = input.substr(peg$currPos, 6);
+                              peg$currPos += 6;
+                            } else {
+                              s1 = peg$FAILED;
+                              if (peg$silentFails === 0) { peg$fail(peg$c146); }
+                            }
+                            if (s1 !== peg$FAILED) {
+                              peg$savedPos = s0;
+                              s1 = peg$c147();
+                            }
+                            s0 = s1;
+                            if (s0 === peg$FAILED) {
+                              s0 = peg$currPos;
+                              if (input.substr(peg$currPos, 7).toLowerCase() === peg$c148) {
+                                s1 = input.substr(peg$currPos, 7);
+                                peg$currPos += 7;
+                              } else {
+                                s1 = peg$FAILED;
+                                if (peg$silentFails === 0) { peg$fail(peg$c149); }
+                              }
+                              if (s1 !== peg$FAILED) {
+                                peg$savedPos = s0;
+                                s1 = peg$c150();
+                              }
+                              s0 = s1;
+                              if (s0 === peg$FAILED) {
+                                s0 = peg$currPos;
+                                if (input.substr(peg$currPos, 5).toLowerCase() === peg$c151) {
+                                  s1 = input.substr(peg$currPos, 5);
+                                  peg$currPos += 5;
+                                } else {
+                                  s1 = peg$FAILED;
+                                  if (peg$silentFails === 0) { peg$fail(peg$c152); }
+                                }
+                                if (s1 !== peg$FAILED) {
+                                  peg$savedPos = s0;
+                                  s1 = peg$c153();
+                                }
+                                s0 = s1;
+                              }
+                            }
+                          }
+                        }
                       }
                     }
                   }
@@ -3055,7 +3779,154 @@ function peg$parse(input, options) {
     return s0;
   }
 
-  function peg$parsepaddedFieldExpr() {
+  function peg$parseparamReducerOp() {
+    var s0, s1;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 8).toLowerCase() === peg$c154) {
+      s1 = input.substr(peg$currPos, 8);
+      peg$currPos += 8;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c155); }
+    }
+    if (s1 !== peg$FAILED) {
+      peg$savedPos = s0;
+      s1 = peg$c156();
+    }
+    s0 = s1;
+    if (s0 === peg$FAILED) {
+      s0 = peg$currPos;
+      if (input.substr(peg$currPos, 10).toLowerCase() === peg$c157) {
+        s1 = input.substr(peg$currPos, 10);
+        peg$currPos += 10;
+      } else {
+        s1 = peg$FAILED;
+        if (peg$silentFails === 0) { peg$fail(peg$c158); }
+      }
+      if (s1 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c159();
+      }
+      s0 = s1;
+      if (s0 === peg$FAILED) {
+        s0 = peg$currPos;
+        if (input.substr(peg$currPos, 9).toLowerCase() === peg$c160) {
+          s1 = input.substr(peg$currPos, 9);
+          peg$currPos += 9;
+        } else {
+          s1 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c161); }
+        }
+        if (s1 !== peg$FAILED) {
+          peg$savedPos = s0;
+          s1 = peg$c162();
+        }
+        s0 = s1;
+        if (s0 === peg$FAILED) {
+          s0 = peg$currPos;
+          if (input.substr(peg$currPos, 7).toLowerCase() === peg$c148) {
+            s1 = input.substr(peg$currPos, 7);
+            peg$currPos += 7;
+          } else {
+            s1 = peg$FAILED;
+            if (peg$silentFails === 0) { peg$fail(peg$c149); }
+          }
+          if (s1 !== peg$FAILED) {
+            peg$savedPos = s0;
+            s1 = peg$c150();
+          }
+          s0 = s1;
+          if (s0 === peg$FAILED) {
+            s0 = peg$currPos;
+            if (input.substr(peg$currPos, 5).toLowerCase() === peg$c151) {
+              s1 = input.substr(peg$currPos, 5);
+              peg$currPos += 5;
+            } else {
+              s1 = peg$FAILED;
+              if (peg$silentFails === 0) { peg$fail(peg$c152); }
+            }
+            if (s1 !== peg$FAILED) {
+              peg$savedPos = s0;
+              s1 = peg$c153();
+            }
+            s0 = s1;
+          }
+        }
+      }
+    }
+
+    return s0;
+  }
+
+  function peg$parsereducerArg() {
+    var s0, s1, s2, s3, s4, s5;
+
+    s0 = peg$currPos;
+    s1 = peg$parsefieldExpr();
+    if (s1 !== peg$FAILED) {
+      s2 = peg$currPos;
+      peg$silentFails++;
+      s3 = peg$currPos;
+      s4 = peg$parse_();
+      if (s4 === peg$FAILED) {
+        s4 = null;
+      }
+      if (s4 !== peg$FAILED) {
+        if (input.charCodeAt(peg$currPos) === 41) {
+          s5 = peg$c21;
+          peg$currPos++;
+        } else {
+          s5 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c22); }
+        }
+        if (s5 === peg$FAILED) {
+          if (input.charCodeAt(peg$currPos) === 44) {
+            s5 = peg$c69;
+            peg$currPos++;
+          } else {
+            s5 = peg$FAILED;
+            if (peg$silentFails === 0) { peg$fail(peg$c70); }
+          }
+        }
+        if (s5 !== peg$FAILED) {
+          s4 = [s4, s5];
+          s3 = s4;
+        } else {
+          peg$currPos = s3;
+          s3 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s3;
+        s3 = peg$FAILED;
+      }
+      peg$silentFails--;
+      if (s3 !== peg$FAILED) {
+        peg$currPos = s2;
+        s2 = void 0;
+      } else {
+        s2 = peg$FAILED;
+      }
+      if (s2 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c163(s1);
+        s0 = s1;
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+    if (s0 === peg$FAILED) {
+      s0 = peg$parseConditionalExpression();
+    }
+
+    return s0;
+  }
+
+  function peg$parsepaddedReducerArg() {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
@@ -3064,7 +3935,7 @@ function peg$parse(input, options) {
       s1 = null;
     }
     if (s1 !== peg$FAILED) {
-      s2 = peg$parsefieldExpr();
+      s2 = peg$parsereducerArg();
       if (s2 !== peg$FAILED) {
         s3 = peg$parse_();
         if (s3 === peg$FAILED) {
@@ -3072,7 +3943,7 @@ function peg$parse(input, options) {
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c132(s2);
+          s1 = peg$c164(s2);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -3109,7 +3980,7 @@ function peg$parse(input, options) {
           if (peg$silentFails === 0) { peg$fail(peg$c20); }
         }
         if (s3 !== peg$FAILED) {
-          s4 = peg$parsepaddedFieldExpr();
+          s4 = peg$parsepaddedReducerArg();
           if (s4 === peg$FAILED) {
             s4 = null;
           }
@@ -3127,9 +3998,83 @@ function peg$parse(input, options) {
                 if (peg$silentFails === 0) { peg$fail(peg$c22); }
               }
               if (s6 !== peg$FAILED) {
-                peg$savedPos = s0;
-                s1 = peg$c133(s1, s4);
-                s0 = s1;
+                peg$savedPos = s0;
+                s1 = peg$c165(s1, s4);
+                s0 = s1;
+              } else {
+                peg$currPos = s0;
+                s0 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parsefieldReducer() {
+    var s0, s1, s2, s3, s4, s5, s6, s7;
+
+    s0 = peg$currPos;
+    s1 = peg$parsefieldReducerOp();
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse_();
+      if (s2 === peg$FAILED) {
+        s2 = null;
+      }
+      if (s2 !== peg$FAILED) {
+        if (input.charCodeAt(peg$currPos) === 40) {
+          s3 = peg$c19;
+          peg$currPos++;
+        } else {
+          s3 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c20); }
+        }
+        if (s3 !== peg$FAILED) {
+          s4 = peg$parse_();
+          if (s4 === peg$FAILED) {
+            s4 = null;
+          }
+          if (s4 !== peg$FAILED) {
+            s5 = peg$parsereducerArg();
+            if (s5 !== peg$FAILED) {
+              s6 = peg$parse_();
+              if (s6 === peg$FAILED) {
+                s6 = null;
+              }
+              if (s6 !== peg$FAILED) {
+                if (input.charCodeAt(peg$currPos) === 41) {
+                  s7 = peg$c21;
+                  peg$currPos++;
+                } else {
+                  s7 = peg$FAILED;
+                  if (peg$silentFails === 0) { peg$fail(peg$c22); }
+                }
+                if (s7 !== peg$FAILED) {
+                  peg$savedPos = s0;
+                  s1 = peg$c166(s1, s5);
+                  s0 = s1;
+                } else {
+                  peg$currPos = s0;
+                  s0 = peg$FAILED;
+                }
               } else {
                 peg$currPos = s0;
                 s0 = peg$FAILED;
@@ -3158,11 +4103,11 @@ function peg$parse(input, options) {
     return s0;
   }
 
-  function peg$parsefieldReducer() {
-    var s0, s1, s2, s3, s4, s5, s6, s7;
+  function peg$parseparamReducer() {
+    var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10, s11;
 
     s0 = peg$currPos;
-    s1 = peg$parsefieldReducerOp();
+    s1 = peg$parseparamReducerOp();
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
       if (s2 === peg$FAILED) {
@@ -3182,24 +4127,60 @@ function peg$parse(input, options) {
             s4 = null;
           }
           if (s4 !== peg$FAILED) {
-            s5 = peg$parsefieldExpr();
+            s5 = peg$parsereducerArg();
             if (s5 !== peg$FAILED) {
               s6 = peg$parse_();
               if (s6 === peg$FAILED) {
                 s6 = null;
               }
               if (s6 !== peg$FAILED) {
-                if (input.charCodeAt(peg$currPos) === 41) {
-                  s7 = peg$c21;
+                if (input.charCodeAt(peg$currPos) === 44) {
+                  s7 = peg$c69;
                   peg$currPos++;
                 } else {
                   s7 = peg$FAILED;
-                  if (peg$silentFails === 0) { peg$fail(peg$c22); }
+                  if (peg$silentFails === 0) { peg$fail(peg$c70); }
                 }
                 if (s7 !== peg$FAILED) {
-                  peg$savedPos = s0;
-                  s1 = peg$c134(s1, s5);
-                  s0 = s1;
+                  s8 = peg$parse_();
+                  if (s8 === peg$FAILED) {
+                    s8 = null;
+                  }
+                  if (s8 !== peg$FAILED) {
+                    s9 = peg$parsereducerParam();
+                    if (s9 !== peg$FAILED) {
+                      s10 = peg$parse_();
+                      if (s10 === peg$FAILED) {
+                        s10 = null;
+                      }
+                      if (s10 !== peg$FAILED) {
+                        if (input.charCodeAt(peg$currPos) === 41) {
+                          s11 = peg$c21;
+                          peg$currPos++;
+                        } else {
+                          s11 = peg$FAILED;
+                          if (peg$silentFails === 0) { peg$fail(peg$c22); }
+                        }
+                        if (s11 !== peg$FAILED) {
+                          peg$savedPos = s0;
+                          s1 = peg$c167(s1, s5, s9);
+                          s0 = s1;
+                        } else {
+                          peg$currPos = s0;
+                          s0 = peg$FAILED;
+                        }
+                      } else {
+                        peg$currPos = s0;
+                        s0 = peg$FAILED;
+                      }
+                    } else {
+                      peg$currPos = s0;
+                      s0 = peg$FAILED;
+                    }
+                  } else {
+                    peg$currPos = s0;
+                    s0 = peg$FAILED;
+                  }
                 } else {
                   peg$currPos = s0;
                   s0 = peg$FAILED;
@@ -3232,6 +4213,23 @@ function peg$parse(input, options) {
     return s0;
   }
 
+  function peg$parsereducerParam() {
+    var s0, s1;
+
+    s0 = peg$currPos;
+    s1 = peg$parsesdouble();
+    if (s1 === peg$FAILED) {
+      s1 = peg$parsesinteger();
+    }
+    if (s1 !== peg$FAILED) {
+      peg$savedPos = s0;
+      s1 = peg$c168(s1);
+    }
+    s0 = s1;
+
+    return s0;
+  }
+
   function peg$parsereducerProc() {
     var s0, s1, s2, s3, s4, s5;
 
@@ -3282,7 +4280,7 @@ function peg$parse(input, options) {
           }
           if (s4 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c135(s1, s2, s3, s4);
+            s1 = peg$c169(s1, s2, s3, s4);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -3308,12 +4306,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 2).toLowerCase() === peg$c136) {
+    if (input.substr(peg$currPos, 2).toLowerCase() === peg$c170) {
       s1 = input.substr(peg$currPos, 2);
       peg$currPos += 2;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c137); }
+      if (peg$silentFails === 0) { peg$fail(peg$c171); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
@@ -3321,7 +4319,7 @@ function peg$parse(input, options) {
         s3 = peg$parsefieldName();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c35(s3);
+          s1 = peg$c38(s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -3343,7 +4341,7 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4, s5;
 
     s0 = peg$currPos;
-    s1 = peg$parsefieldExpr();
+    s1 = peg$parsefieldName();
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
       if (s2 === peg$FAILED) {
@@ -3351,11 +4349,11 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 61) {
-          s3 = peg$c138;
+          s3 = peg$c65;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c139); }
+          if (peg$silentFails === 0) { peg$fail(peg$c66); }
         }
         if (s3 !== peg$FAILED) {
           s4 = peg$parse_();
@@ -3366,7 +4364,7 @@ function peg$parse(input, options) {
             s5 = peg$parsereducer();
             if (s5 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c140(s1, s5);
+              s1 = peg$c172(s1, s5);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -3397,7 +4395,7 @@ function peg$parse(input, options) {
           s3 = peg$parseasClause();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c141(s1, s3);
+            s1 = peg$c173(s1, s3);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -3425,6 +4423,9 @@ function peg$parse(input, options) {
     s0 = peg$parsecountReducer();
     if (s0 === peg$FAILED) {
       s0 = peg$parsefieldReducer();
+      if (s0 === peg$FAILED) {
+        s0 = peg$parseparamReducer();
+      }
     }
 
     return s0;
@@ -3444,11 +4445,11 @@ function peg$parse(input, options) {
       }
       if (s4 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 44) {
-          s5 = peg$c90;
+          s5 = peg$c69;
           peg$currPos++;
         } else {
           s5 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c91); }
+          if (peg$silentFails === 0) { peg$fail(peg$c70); }
         }
         if (s5 !== peg$FAILED) {
           s6 = peg$parse_();
@@ -3485,11 +4486,11 @@ function peg$parse(input, options) {
         }
         if (s4 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 44) {
-            s5 = peg$c90;
+            s5 = peg$c69;
             peg$currPos++;
           } else {
             s5 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c91); }
+            if (peg$silentFails === 0) { peg$fail(peg$c70); }
           }
           if (s5 !== peg$FAILED) {
             s6 = peg$parse_();
@@ -3520,7 +4521,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c142(s1, s2);
+        s1 = peg$c174(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -3543,15 +4544,24 @@ function peg$parse(input, options) {
       if (s0 === peg$FAILED) {
         s0 = peg$parsecut();
         if (s0 === peg$FAILED) {
-          s0 = peg$parsehead();
+          s0 = peg$parsedrop();
           if (s0 === peg$FAILED) {
-            s0 = peg$parsetail();
+            s0 = peg$parserename();
             if (s0 === peg$FAILED) {
-              s0 = peg$parsefilter();
+              s0 = peg$parsehead();
               if (s0 === peg$FAILED) {
-                s0 = peg$parseuniq();
+                s0 = peg$parsetail();
                 if (s0 === peg$FAILED) {
-                  s0 = peg$parseput();
+                  s0 = peg$parsefilter();
+                  if (s0 === peg$FAILED) {
+                    s0 = peg$parseuniq();
+                    if (s0 === peg$FAILED) {
+                      s0 = peg$parseput();
+                      if (s0 === peg$FAILED) {
+                        s0 = peg$parsejoin();
+                      }
+                    }
+                  }
                 }
               }
             }
@@ -3567,12 +4577,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4, s5;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c143) {
+    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c175) {
       s1 = input.substr(peg$currPos, 4);
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c144); }
+      if (peg$silentFails === 0) { peg$fail(peg$c176); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsesortArgs();
@@ -3583,7 +4593,7 @@ function peg$parse(input, options) {
           s5 = peg$parsefieldExprList();
           if (s5 !== peg$FAILED) {
             peg$savedPos = s3;
-            s4 = peg$c145(s2, s5);
+            s4 = peg$c177(s2, s5);
             s3 = s4;
           } else {
             peg$currPos = s3;
@@ -3598,7 +4608,7 @@ function peg$parse(input, options) {
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c146(s2, s3);
+          s1 = peg$c178(s2, s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -3626,7 +4636,7 @@ function peg$parse(input, options) {
       s3 = peg$parsesortArg();
       if (s3 !== peg$FAILED) {
         peg$savedPos = s1;
-        s2 = peg$c147(s3);
+        s2 = peg$c179(s3);
         s1 = s2;
       } else {
         peg$currPos = s1;
@@ -3644,7 +4654,7 @@ function peg$parse(input, options) {
         s3 = peg$parsesortArg();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s1;
-          s2 = peg$c147(s3);
+          s2 = peg$c179(s3);
           s1 = s2;
         } else {
           peg$currPos = s1;
@@ -3663,12 +4673,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 6) === peg$c148) {
-      s1 = peg$c148;
+    if (input.substr(peg$currPos, 6) === peg$c180) {
+      s1 = peg$c180;
       peg$currPos += 6;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c149); }
+      if (peg$silentFails === 0) { peg$fail(peg$c181); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
@@ -3676,7 +4686,7 @@ function peg$parse(input, options) {
         s3 = peg$parsesuint();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c150(s3);
+          s1 = peg$c182(s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -3692,55 +4702,55 @@ function peg$parse(input, options) {
     }
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 2) === peg$c151) {
-        s1 = peg$c151;
+      if (input.substr(peg$currPos, 2) === peg$c183) {
+        s1 = peg$c183;
         peg$currPos += 2;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c152); }
+        if (peg$silentFails === 0) { peg$fail(peg$c184); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c153();
+        s1 = peg$c185();
       }
       s0 = s1;
       if (s0 === peg$FAILED) {
         s0 = peg$currPos;
-        if (input.substr(peg$currPos, 6) === peg$c154) {
-          s1 = peg$c154;
+        if (input.substr(peg$currPos, 6) === peg$c186) {
+          s1 = peg$c186;
           peg$currPos += 6;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c155); }
+          if (peg$silentFails === 0) { peg$fail(peg$c187); }
         }
         if (s1 !== peg$FAILED) {
           s2 = peg$parse_();
           if (s2 !== peg$FAILED) {
             s3 = peg$currPos;
-            if (input.substr(peg$currPos, 5) === peg$c123) {
-              s4 = peg$c123;
+            if (input.substr(peg$currPos, 5) === peg$c136) {
+              s4 = peg$c136;
               peg$currPos += 5;
             } else {
               s4 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c156); }
+              if (peg$silentFails === 0) { peg$fail(peg$c188); }
             }
             if (s4 === peg$FAILED) {
-              if (input.substr(peg$currPos, 4) === peg$c126) {
-                s4 = peg$c126;
+              if (input.substr(peg$currPos, 4) === peg$c139) {
+                s4 = peg$c139;
                 peg$currPos += 4;
               } else {
                 s4 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c157); }
+                if (peg$silentFails === 0) { peg$fail(peg$c189); }
               }
             }
             if (s4 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c66();
+              s4 = peg$c78();
             }
             s3 = s4;
             if (s3 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c158(s3);
+              s1 = peg$c190(s3);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -3764,12 +4774,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4, s5, s6;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c159) {
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c191) {
       s1 = input.substr(peg$currPos, 3);
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c160); }
+      if (peg$silentFails === 0) { peg$fail(peg$c192); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$currPos;
@@ -3778,7 +4788,7 @@ function peg$parse(input, options) {
         s4 = peg$parseunsignedInteger();
         if (s4 !== peg$FAILED) {
           peg$savedPos = s2;
-          s3 = peg$c161(s4);
+          s3 = peg$c193(s4);
           s2 = s3;
         } else {
           peg$currPos = s2;
@@ -3795,12 +4805,12 @@ function peg$parse(input, options) {
         s3 = peg$currPos;
         s4 = peg$parse_();
         if (s4 !== peg$FAILED) {
-          if (input.substr(peg$currPos, 6) === peg$c162) {
-            s5 = peg$c162;
+          if (input.substr(peg$currPos, 6) === peg$c194) {
+            s5 = peg$c194;
             peg$currPos += 6;
           } else {
             s5 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c163); }
+            if (peg$silentFails === 0) { peg$fail(peg$c195); }
           }
           if (s5 !== peg$FAILED) {
             s4 = [s4, s5];
@@ -3822,23 +4832,258 @@ function peg$parse(input, options) {
           if (s5 !== peg$FAILED) {
             s6 = peg$parsefieldExprList();
             if (s6 !== peg$FAILED) {
-              peg$savedPos = s4;
-              s5 = peg$c164(s2, s3, s6);
-              s4 = s5;
+              peg$savedPos = s4;
+              s5 = peg$c196(s2, s3, s6);
+              s4 = s5;
+            } else {
+              peg$currPos = s4;
+              s4 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s4;
+            s4 = peg$FAILED;
+          }
+          if (s4 === peg$FAILED) {
+            s4 = null;
+          }
+          if (s4 !== peg$FAILED) {
+            peg$savedPos = s0;
+            s1 = peg$c197(s2, s3, s4);
+            s0 = s1;
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parseprocLimitArg() {
+    var s0, s1, s2, s3, s4;
+
+    s0 = peg$currPos;
+    s1 = peg$parse_();
+    if (s1 !== peg$FAILED) {
+      if (input.substr(peg$currPos, 6) === peg$c180) {
+        s2 = peg$c180;
+        peg$currPos += 6;
+      } else {
+        s2 = peg$FAILED;
+        if (peg$silentFails === 0) { peg$fail(peg$c181); }
+      }
+      if (s2 !== peg$FAILED) {
+        s3 = peg$parse_();
+        if (s3 !== peg$FAILED) {
+          s4 = peg$parseunsignedInteger();
+          if (s4 !== peg$FAILED) {
+            peg$savedPos = s0;
+            s1 = peg$c198(s4);
+            s0 = s1;
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parsecut() {
+    var s0, s1, s2, s3;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c199) {
+      s1 = input.substr(peg$currPos, 3);
+      peg$currPos += 3;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c200); }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse_();
+      if (s2 !== peg$FAILED) {
+        s3 = peg$parsefieldPatternList();
+        if (s3 !== peg$FAILED) {
+          peg$savedPos = s0;
+          s1 = peg$c201(s3);
+          s0 = s1;
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parsedrop() {
+    var s0, s1, s2, s3;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c202) {
+      s1 = input.substr(peg$currPos, 4);
+      peg$currPos += 4;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c203); }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse_();
+      if (s2 !== peg$FAILED) {
+        s3 = peg$parsefieldPatternList();
+        if (s3 !== peg$FAILED) {
+          peg$savedPos = s0;
+          s1 = peg$c204(s3);
+          s0 = s1;
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parserename() {
+    var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 6).toLowerCase() === peg$c205) {
+      s1 = input.substr(peg$currPos, 6);
+      peg$currPos += 6;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c206); }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse_();
+      if (s2 !== peg$FAILED) {
+        s3 = peg$parsefieldAssignment();
+        if (s3 !== peg$FAILED) {
+          s4 = [];
+          s5 = peg$currPos;
+          s6 = peg$parse_();
+          if (s6 === peg$FAILED) {
+            s6 = null;
+          }
+          if (s6 !== peg$FAILED) {
+            if (input.charCodeAt(peg$currPos) === 44) {
+              s7 = peg$c69;
+              peg$currPos++;
+            } else {
+              s7 = peg$FAILED;
+              if (peg$silentFails === 0) { peg$fail(peg$c70); }
+            }
+            if (s7 !== peg$FAILED) {
+              s8 = peg$parse_();
+              if (s8 === peg$FAILED) {
+                s8 = null;
+              }
+              if (s8 !== peg$FAILED) {
+                s9 = peg$parsefieldAssignment();
+                if (s9 !== peg$FAILED) {
+                  peg$savedPos = s5;
+                  s6 = peg$c207(s3, s9);
+                  s5 = s6;
+                } else {
+                  peg$currPos = s5;
+                  s5 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s5;
+                s5 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s5;
+              s5 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s5;
+            s5 = peg$FAILED;
+          }
+          while (s5 !== peg$FAILED) {
+            s4.push(s5);
+            s5 = peg$currPos;
+            s6 = peg$parse_();
+            if (s6 === peg$FAILED) {
+              s6 = null;
+            }
+            if (s6 !== peg$FAILED) {
+              if (input.charCodeAt(peg$currPos) === 44) {
+                s7 = peg$c69;
+                peg$currPos++;
+              } else {
+                s7 = peg$FAILED;
+                if (peg$silentFails === 0) { peg$fail(peg$c70); }
+              }
+              if (s7 !== peg$FAILED) {
+                s8 = peg$parse_();
+                if (s8 === peg$FAILED) {
+                  s8 = null;
+                }
+                if (s8 !== peg$FAILED) {
+                  s9 = peg$parsefieldAssignment();
+                  if (s9 !== peg$FAILED) {
+                    peg$savedPos = s5;
+                    s6 = peg$c207(s3, s9);
+                    s5 = s6;
+                  } else {
+                    peg$currPos = s5;
+                    s5 = peg$FAILED;
+                  }
+                } else {
+                  peg$currPos = s5;
+                  s5 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s5;
+                s5 = peg$FAILED;
+              }
             } else {
-              peg$currPos = s4;
-              s4 = peg$FAILED;
+              peg$currPos = s5;
+              s5 = peg$FAILED;
             }
-          } else {
-            peg$currPos = s4;
-            s4 = peg$FAILED;
-          }
-          if (s4 === peg$FAILED) {
-            s4 = null;
           }
           if (s4 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c165(s2, s3, s4);
+            s1 = peg$c208(s3, s4);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -3860,27 +5105,39 @@ function peg$parse(input, options) {
     return s0;
   }
 
-  function peg$parseprocLimitArg() {
-    var s0, s1, s2, s3, s4;
+  function peg$parsefieldAssignment() {
+    var s0, s1, s2, s3, s4, s5;
 
     s0 = peg$currPos;
-    s1 = peg$parse_();
+    s1 = peg$parsefieldRefDotOnly();
     if (s1 !== peg$FAILED) {
-      if (input.substr(peg$currPos, 6) === peg$c148) {
-        s2 = peg$c148;
-        peg$currPos += 6;
-      } else {
-        s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c149); }
+      s2 = peg$parse_();
+      if (s2 === peg$FAILED) {
+        s2 = null;
       }
       if (s2 !== peg$FAILED) {
-        s3 = peg$parse_();
+        if (input.charCodeAt(peg$currPos) === 61) {
+          s3 = peg$c65;
+          peg$currPos++;
+        } else {
+          s3 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c66); }
+        }
         if (s3 !== peg$FAILED) {
-          s4 = peg$parseunsignedInteger();
+          s4 = peg$parse_();
+          if (s4 === peg$FAILED) {
+            s4 = null;
+          }
           if (s4 !== peg$FAILED) {
-            peg$savedPos = s0;
-            s1 = peg$c166(s4);
-            s0 = s1;
+            s5 = peg$parsefieldRefDotOnly();
+            if (s5 !== peg$FAILED) {
+              peg$savedPos = s0;
+              s1 = peg$c209(s1, s5);
+              s0 = s1;
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
           } else {
             peg$currPos = s0;
             s0 = peg$FAILED;
@@ -3901,51 +5158,16 @@ function peg$parse(input, options) {
     return s0;
   }
 
-  function peg$parsecut() {
-    var s0, s1, s2, s3;
-
-    s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c167) {
-      s1 = input.substr(peg$currPos, 3);
-      peg$currPos += 3;
-    } else {
-      s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c168); }
-    }
-    if (s1 !== peg$FAILED) {
-      s2 = peg$parse_();
-      if (s2 !== peg$FAILED) {
-        s3 = peg$parsefieldRefDotOnlyList();
-        if (s3 !== peg$FAILED) {
-          peg$savedPos = s0;
-          s1 = peg$c169(s3);
-          s0 = s1;
-        } else {
-          peg$currPos = s0;
-          s0 = peg$FAILED;
-        }
-      } else {
-        peg$currPos = s0;
-        s0 = peg$FAILED;
-      }
-    } else {
-      peg$currPos = s0;
-      s0 = peg$FAILED;
-    }
-
-    return s0;
-  }
-
   function peg$parsehead() {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c170) {
+    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c210) {
       s1 = input.substr(peg$currPos, 4);
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c171); }
+      if (peg$silentFails === 0) { peg$fail(peg$c211); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
@@ -3953,7 +5175,7 @@ function peg$parse(input, options) {
         s3 = peg$parseunsignedInteger();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c172(s3);
+          s1 = peg$c212(s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -3969,16 +5191,16 @@ function peg$parse(input, options) {
     }
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c170) {
+      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c210) {
         s1 = input.substr(peg$currPos, 4);
         peg$currPos += 4;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c171); }
+        if (peg$silentFails === 0) { peg$fail(peg$c211); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c173();
+        s1 = peg$c213();
       }
       s0 = s1;
     }
@@ -3990,12 +5212,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c174) {
+    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c214) {
       s1 = input.substr(peg$currPos, 4);
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c175); }
+      if (peg$silentFails === 0) { peg$fail(peg$c215); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
@@ -4003,7 +5225,7 @@ function peg$parse(input, options) {
         s3 = peg$parseunsignedInteger();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c176(s3);
+          s1 = peg$c216(s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -4019,16 +5241,16 @@ function peg$parse(input, options) {
     }
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c174) {
+      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c214) {
         s1 = input.substr(peg$currPos, 4);
         peg$currPos += 4;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c175); }
+        if (peg$silentFails === 0) { peg$fail(peg$c215); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c177();
+        s1 = peg$c217();
       }
       s0 = s1;
     }
@@ -4040,12 +5262,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 6).toLowerCase() === peg$c178) {
+    if (input.substr(peg$currPos, 6).toLowerCase() === peg$c218) {
       s1 = input.substr(peg$currPos, 6);
       peg$currPos += 6;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c179); }
+      if (peg$silentFails === 0) { peg$fail(peg$c219); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
@@ -4075,26 +5297,26 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c180) {
+    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c220) {
       s1 = input.substr(peg$currPos, 4);
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c181); }
+      if (peg$silentFails === 0) { peg$fail(peg$c221); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
       if (s2 !== peg$FAILED) {
-        if (input.substr(peg$currPos, 2) === peg$c182) {
-          s3 = peg$c182;
+        if (input.substr(peg$currPos, 2) === peg$c222) {
+          s3 = peg$c222;
           peg$currPos += 2;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c183); }
+          if (peg$silentFails === 0) { peg$fail(peg$c223); }
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c184();
+          s1 = peg$c224();
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -4110,16 +5332,16 @@ function peg$parse(input, options) {
     }
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c180) {
+      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c220) {
         s1 = input.substr(peg$currPos, 4);
         peg$currPos += 4;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c181); }
+        if (peg$silentFails === 0) { peg$fail(peg$c221); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c185();
+        s1 = peg$c225();
       }
       s0 = s1;
     }
@@ -4131,12 +5353,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4, s5, s6, s7;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c186) {
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c226) {
       s1 = input.substr(peg$currPos, 3);
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c187); }
+      if (peg$silentFails === 0) { peg$fail(peg$c227); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
@@ -4146,11 +5368,11 @@ function peg$parse(input, options) {
           s4 = peg$parse__();
           if (s4 !== peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 61) {
-              s5 = peg$c138;
+              s5 = peg$c65;
               peg$currPos++;
             } else {
               s5 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c139); }
+              if (peg$silentFails === 0) { peg$fail(peg$c66); }
             }
             if (s5 !== peg$FAILED) {
               s6 = peg$parse__();
@@ -4158,7 +5380,7 @@ function peg$parse(input, options) {
                 s7 = peg$parseConditionalExpression();
                 if (s7 !== peg$FAILED) {
                   peg$savedPos = s0;
-                  s1 = peg$c188(s3, s7);
+                  s1 = peg$c228(s3, s7);
                   s0 = s1;
                 } else {
                   peg$currPos = s0;
@@ -4192,6 +5414,160 @@ function peg$parse(input, options) {
     return s0;
   }
 
+  function peg$parsejoin() {
+    var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10, s11;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c229) {
+      s1 = input.substr(peg$currPos, 4);
+      peg$currPos += 4;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c230); }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$currPos;
+      s3 = peg$parse_();
+      if (s3 !== peg$FAILED) {
+        s4 = peg$parsejoinKind();
+        if (s4 !== peg$FAILED) {
+          peg$savedPos = s2;
+          s3 = peg$c231(s4);
+          s2 = s3;
+        } else {
+          peg$currPos = s2;
+          s2 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s2;
+        s2 = peg$FAILED;
+      }
+      if (s2 === peg$FAILED) {
+        s2 = null;
+      }
+      if (s2 !== peg$FAILED) {
+        s3 = peg$parse_();
+        if (s3 !== peg$FAILED) {
+          s4 = peg$parsefieldExpr();
+          if (s4 !== peg$FAILED) {
+            s5 = peg$parse_();
+            if (s5 === peg$FAILED) {
+              s5 = null;
+            }
+            if (s5 !== peg$FAILED) {
+              if (input.charCodeAt(peg$currPos) === 61) {
+                s6 = peg$c65;
+                peg$currPos++;
+              } else {
+                s6 = peg$FAILED;
+                if (peg$silentFails === 0) { peg$fail(peg$c66); }
+              }
+              if (s6 !== peg$FAILED) {
+                s7 = peg$parse_();
+                if (s7 === peg$FAILED) {
+                  s7 = null;
+                }
+                if (s7 !== peg$FAILED) {
+                  s8 = peg$parsefieldExpr();
+                  if (s8 !== peg$FAILED) {
+                    s9 = peg$currPos;
+                    s10 = peg$parse_();
+                    if (s10 !== peg$FAILED) {
+                      s11 = peg$parsefieldRefDotOnlyList();
+                      if (s11 !== peg$FAILED) {
+                        peg$savedPos = s9;
+                        s10 = peg$c232(s2, s4, s8, s11);
+                        s9 = s10;
+                      } else {
+                        peg$currPos = s9;
+                        s9 = peg$FAILED;
+                      }
+                    } else {
+                      peg$currPos = s9;
+                      s9 = peg$FAILED;
+                    }
+                    if (s9 === peg$FAILED) {
+                      s9 = null;
+                    }
+                    if (s9 !== peg$FAILED) {
+                      peg$savedPos = s0;
+                      s1 = peg$c233(s2, s4, s8, s9);
+                      s0 = s1;
+                    } else {
+                      peg$currPos = s0;
+                      s0 = peg$FAILED;
+                    }
+                  } else {
+                    peg$currPos = s0;
+                    s0 = peg$FAILED;
+                  }
+                } else {
+                  peg$currPos = s0;
+                  s0 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s0;
+                s0 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parsejoinKind() {
+    var s0, s1;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 6) === peg$c234) {
+      s1 = peg$c234;
+      peg$currPos += 6;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c235); }
+    }
+    if (s1 !== peg$FAILED) {
+      peg$savedPos = s0;
+      s1 = peg$c236();
+    }
+    s0 = s1;
+    if (s0 === peg$FAILED) {
+      s0 = peg$currPos;
+      if (input.substr(peg$currPos, 5) === peg$c237) {
+        s1 = peg$c237;
+        peg$currPos += 5;
+      } else {
+        s1 = peg$FAILED;
+        if (peg$silentFails === 0) { peg$fail(peg$c238); }
+      }
+      if (s1 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c239();
+      }
+      s0 = s1;
+    }
+
+    return s0;
+  }
+
   function peg$parsePrimaryExpression() {
     var s0, s1, s2, s3, s4, s5;
 
@@ -4282,7 +5658,7 @@ function peg$parse(input, options) {
     s1 = peg$parsefieldName();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c189(s1);
+      s1 = peg$c240(s1);
     }
     s0 = s1;
 
@@ -4306,11 +5682,11 @@ function peg$parse(input, options) {
       s2 = peg$parse__();
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 63) {
-          s3 = peg$c190;
+          s3 = peg$c241;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c191); }
+          if (peg$silentFails === 0) { peg$fail(peg$c242); }
         }
         if (s3 !== peg$FAILED) {
           s4 = peg$parse__();
@@ -4320,11 +5696,11 @@ function peg$parse(input, options) {
               s6 = peg$parse__();
               if (s6 !== peg$FAILED) {
                 if (input.charCodeAt(peg$currPos) === 58) {
-                  s7 = peg$c192;
+                  s7 = peg$c243;
                   peg$currPos++;
                 } else {
                   s7 = peg$FAILED;
-                  if (peg$silentFails === 0) { peg$fail(peg$c193); }
+                  if (peg$silentFails === 0) { peg$fail(peg$c244); }
                 }
                 if (s7 !== peg$FAILED) {
                   s8 = peg$parse__();
@@ -4332,7 +5708,7 @@ function peg$parse(input, options) {
                     s9 = peg$parseConditionalExpression();
                     if (s9 !== peg$FAILED) {
                       peg$savedPos = s0;
-                      s1 = peg$c194(s1, s5, s9);
+                      s1 = peg$c245(s1, s5, s9);
                       s0 = s1;
                     } else {
                       peg$currPos = s0;
@@ -4443,7 +5819,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c195(s1, s2);
+        s1 = peg$c246(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4523,7 +5899,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c195(s1, s2);
+        s1 = peg$c246(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4603,7 +5979,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c195(s1, s2);
+        s1 = peg$c246(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4622,24 +5998,24 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 61) {
-      s1 = peg$c138;
+      s1 = peg$c65;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c139); }
+      if (peg$silentFails === 0) { peg$fail(peg$c66); }
     }
     if (s1 === peg$FAILED) {
-      if (input.substr(peg$currPos, 2) === peg$c196) {
-        s1 = peg$c196;
+      if (input.substr(peg$currPos, 2) === peg$c247) {
+        s1 = peg$c247;
         peg$currPos += 2;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c197); }
+        if (peg$silentFails === 0) { peg$fail(peg$c248); }
       }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c66();
+      s1 = peg$c78();
     }
     s0 = s1;
 
@@ -4652,16 +6028,16 @@ function peg$parse(input, options) {
     s0 = peg$parseEqualityOperator();
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 2) === peg$c69) {
-        s1 = peg$c69;
+      if (input.substr(peg$currPos, 2) === peg$c81) {
+        s1 = peg$c81;
         peg$currPos += 2;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c198); }
+        if (peg$silentFails === 0) { peg$fail(peg$c249); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c66();
+        s1 = peg$c78();
       }
       s0 = s1;
     }
@@ -4735,7 +6111,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c195(s1, s2);
+        s1 = peg$c246(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4753,43 +6129,43 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 2) === peg$c199) {
-      s1 = peg$c199;
+    if (input.substr(peg$currPos, 2) === peg$c250) {
+      s1 = peg$c250;
       peg$currPos += 2;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c200); }
+      if (peg$silentFails === 0) { peg$fail(peg$c251); }
     }
     if (s1 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 60) {
-        s1 = peg$c201;
+        s1 = peg$c252;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c202); }
+        if (peg$silentFails === 0) { peg$fail(peg$c253); }
       }
       if (s1 === peg$FAILED) {
-        if (input.substr(peg$currPos, 2) === peg$c203) {
-          s1 = peg$c203;
+        if (input.substr(peg$currPos, 2) === peg$c254) {
+          s1 = peg$c254;
           peg$currPos += 2;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c204); }
+          if (peg$silentFails === 0) { peg$fail(peg$c255); }
         }
         if (s1 === peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 62) {
-            s1 = peg$c205;
+            s1 = peg$c256;
             peg$currPos++;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c206); }
+            if (peg$silentFails === 0) { peg$fail(peg$c257); }
           }
         }
       }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c66();
+      s1 = peg$c78();
     }
     s0 = s1;
 
@@ -4862,7 +6238,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c195(s1, s2);
+        s1 = peg$c246(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4881,11 +6257,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 43) {
-      s1 = peg$c207;
+      s1 = peg$c258;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c208); }
+      if (peg$silentFails === 0) { peg$fail(peg$c259); }
     }
     if (s1 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 45) {
@@ -4898,7 +6274,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c66();
+      s1 = peg$c78();
     }
     s0 = s1;
 
@@ -4971,7 +6347,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c195(s1, s2);
+        s1 = peg$c246(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4998,16 +6374,16 @@ function peg$parse(input, options) {
     }
     if (s1 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 47) {
-        s1 = peg$c209;
+        s1 = peg$c260;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c210); }
+        if (peg$silentFails === 0) { peg$fail(peg$c261); }
       }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c66();
+      s1 = peg$c78();
     }
     s0 = s1;
 
@@ -5031,7 +6407,7 @@ function peg$parse(input, options) {
         s3 = peg$parseNotExpression();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c211(s3);
+          s1 = peg$c262(s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -5046,13 +6422,13 @@ function peg$parse(input, options) {
       s0 = peg$FAILED;
     }
     if (s0 === peg$FAILED) {
-      s0 = peg$parseCallExpression();
+      s0 = peg$parseDereferenceExpression();
     }
 
     return s0;
   }
 
-  function peg$parseCallExpression() {
+  function peg$parseFunctionCall() {
     var s0, s1, s2, s3, s4, s5;
 
     s0 = peg$currPos;
@@ -5079,7 +6455,7 @@ function peg$parse(input, options) {
             }
             if (s5 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c212(s1, s4);
+              s1 = peg$c263(s1, s4);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -5101,9 +6477,6 @@ function peg$parse(input, options) {
       peg$currPos = s0;
       s0 = peg$FAILED;
     }
-    if (s0 === peg$FAILED) {
-      s0 = peg$parseDereferenceExpression();
-    }
 
     return s0;
   }
@@ -5122,7 +6495,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c66();
+        s1 = peg$c78();
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5139,12 +6512,12 @@ function peg$parse(input, options) {
   function peg$parseFunctionNameStart() {
     var s0;
 
-    if (peg$c213.test(input.charAt(peg$currPos))) {
+    if (peg$c264.test(input.charAt(peg$currPos))) {
       s0 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c214); }
+      if (peg$silentFails === 0) { peg$fail(peg$c265); }
     }
 
     return s0;
@@ -5155,12 +6528,12 @@ function peg$parse(input, options) {
 
     s0 = peg$parseFunctionNameStart();
     if (s0 === peg$FAILED) {
-      if (peg$c215.test(input.charAt(peg$currPos))) {
+      if (peg$c266.test(input.charAt(peg$currPos))) {
         s0 = input.charAt(peg$currPos);
         peg$currPos++;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c216); }
+        if (peg$silentFails === 0) { peg$fail(peg$c267); }
       }
     }
 
@@ -5178,11 +6551,11 @@ function peg$parse(input, options) {
       s4 = peg$parse__();
       if (s4 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 44) {
-          s5 = peg$c90;
+          s5 = peg$c69;
           peg$currPos++;
         } else {
           s5 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c91); }
+          if (peg$silentFails === 0) { peg$fail(peg$c70); }
         }
         if (s5 !== peg$FAILED) {
           s6 = peg$parse__();
@@ -5190,7 +6563,7 @@ function peg$parse(input, options) {
             s7 = peg$parseConditionalExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c217(s1, s7);
+              s4 = peg$c268(s1, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -5214,11 +6587,11 @@ function peg$parse(input, options) {
         s4 = peg$parse__();
         if (s4 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 44) {
-            s5 = peg$c90;
+            s5 = peg$c69;
             peg$currPos++;
           } else {
             s5 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c91); }
+            if (peg$silentFails === 0) { peg$fail(peg$c70); }
           }
           if (s5 !== peg$FAILED) {
             s6 = peg$parse__();
@@ -5226,7 +6599,7 @@ function peg$parse(input, options) {
               s7 = peg$parseConditionalExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c217(s1, s7);
+                s4 = peg$c268(s1, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -5247,7 +6620,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c218(s1, s2);
+        s1 = peg$c72(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5262,7 +6635,7 @@ function peg$parse(input, options) {
       s1 = peg$parse__();
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c219();
+        s1 = peg$c269();
       }
       s0 = s1;
     }
@@ -5274,18 +6647,21 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9;
 
     s0 = peg$currPos;
-    s1 = peg$parsePrimaryExpression();
+    s1 = peg$parseFunctionCall();
+    if (s1 === peg$FAILED) {
+      s1 = peg$parsePrimaryExpression();
+    }
     if (s1 !== peg$FAILED) {
       s2 = [];
       s3 = peg$currPos;
       s4 = peg$parse__();
       if (s4 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 91) {
-          s5 = peg$c80;
+          s5 = peg$c92;
           peg$currPos++;
         } else {
           s5 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c81); }
+          if (peg$silentFails === 0) { peg$fail(peg$c93); }
         }
         if (s5 !== peg$FAILED) {
           s6 = peg$parse__();
@@ -5295,11 +6671,11 @@ function peg$parse(input, options) {
               s8 = peg$parse__();
               if (s8 !== peg$FAILED) {
                 if (input.charCodeAt(peg$currPos) === 93) {
-                  s9 = peg$c82;
+                  s9 = peg$c94;
                   peg$currPos++;
                 } else {
                   s9 = peg$FAILED;
-                  if (peg$silentFails === 0) { peg$fail(peg$c83); }
+                  if (peg$silentFails === 0) { peg$fail(peg$c95); }
                 }
                 if (s9 !== peg$FAILED) {
                   s4 = [s4, s5, s6, s7, s8, s9];
@@ -5333,11 +6709,11 @@ function peg$parse(input, options) {
         s4 = peg$parse__();
         if (s4 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 46) {
-            s5 = peg$c77;
+            s5 = peg$c89;
             peg$currPos++;
           } else {
             s5 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c78); }
+            if (peg$silentFails === 0) { peg$fail(peg$c90); }
           }
           if (s5 !== peg$FAILED) {
             s6 = peg$parse__();
@@ -5346,7 +6722,7 @@ function peg$parse(input, options) {
               s8 = peg$parsefieldName();
               if (s8 !== peg$FAILED) {
                 peg$savedPos = s7;
-                s8 = peg$c220(s1, s8);
+                s8 = peg$c270(s1, s8);
               }
               s7 = s8;
               if (s7 !== peg$FAILED) {
@@ -5375,11 +6751,11 @@ function peg$parse(input, options) {
         s4 = peg$parse__();
         if (s4 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 91) {
-            s5 = peg$c80;
+            s5 = peg$c92;
             peg$currPos++;
           } else {
             s5 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c81); }
+            if (peg$silentFails === 0) { peg$fail(peg$c93); }
           }
           if (s5 !== peg$FAILED) {
             s6 = peg$parse__();
@@ -5389,11 +6765,11 @@ function peg$parse(input, options) {
                 s8 = peg$parse__();
                 if (s8 !== peg$FAILED) {
                   if (input.charCodeAt(peg$currPos) === 93) {
-                    s9 = peg$c82;
+                    s9 = peg$c94;
                     peg$currPos++;
                   } else {
                     s9 = peg$FAILED;
-                    if (peg$silentFails === 0) { peg$fail(peg$c83); }
+                    if (peg$silentFails === 0) { peg$fail(peg$c95); }
                   }
                   if (s9 !== peg$FAILED) {
                     s4 = [s4, s5, s6, s7, s8, s9];
@@ -5427,11 +6803,11 @@ function peg$parse(input, options) {
           s4 = peg$parse__();
           if (s4 !== peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 46) {
-              s5 = peg$c77;
+              s5 = peg$c89;
               peg$currPos++;
             } else {
               s5 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c78); }
+              if (peg$silentFails === 0) { peg$fail(peg$c90); }
             }
             if (s5 !== peg$FAILED) {
               s6 = peg$parse__();
@@ -5440,7 +6816,7 @@ function peg$parse(input, options) {
                 s8 = peg$parsefieldName();
                 if (s8 !== peg$FAILED) {
                   peg$savedPos = s7;
-                  s8 = peg$c220(s1, s8);
+                  s8 = peg$c270(s1, s8);
                 }
                 s7 = s8;
                 if (s7 !== peg$FAILED) {
@@ -5466,7 +6842,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c221(s1, s2);
+        s1 = peg$c271(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5494,12 +6870,12 @@ function peg$parse(input, options) {
           if (s1 !== peg$FAILED) {
             s2 = peg$parse_();
             if (s2 !== peg$FAILED) {
-              if (input.substr(peg$currPos, 3) === peg$c64) {
-                s3 = peg$c64;
+              if (input.substr(peg$currPos, 3) === peg$c76) {
+                s3 = peg$c76;
                 peg$currPos += 3;
               } else {
                 s3 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c222); }
+                if (peg$silentFails === 0) { peg$fail(peg$c272); }
               }
               if (s3 !== peg$FAILED) {
                 s4 = peg$parse_();
@@ -5544,44 +6920,44 @@ function peg$parse(input, options) {
   function peg$parsesec_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 7) === peg$c223) {
-      s0 = peg$c223;
+    if (input.substr(peg$currPos, 7) === peg$c273) {
+      s0 = peg$c273;
       peg$currPos += 7;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c224); }
+      if (peg$silentFails === 0) { peg$fail(peg$c274); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 6) === peg$c225) {
-        s0 = peg$c225;
+      if (input.substr(peg$currPos, 6) === peg$c275) {
+        s0 = peg$c275;
         peg$currPos += 6;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c226); }
+        if (peg$silentFails === 0) { peg$fail(peg$c276); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 4) === peg$c227) {
-          s0 = peg$c227;
+        if (input.substr(peg$currPos, 4) === peg$c277) {
+          s0 = peg$c277;
           peg$currPos += 4;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c228); }
+          if (peg$silentFails === 0) { peg$fail(peg$c278); }
         }
         if (s0 === peg$FAILED) {
-          if (input.substr(peg$currPos, 3) === peg$c229) {
-            s0 = peg$c229;
+          if (input.substr(peg$currPos, 3) === peg$c279) {
+            s0 = peg$c279;
             peg$currPos += 3;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c230); }
+            if (peg$silentFails === 0) { peg$fail(peg$c280); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 115) {
-              s0 = peg$c231;
+              s0 = peg$c281;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c232); }
+              if (peg$silentFails === 0) { peg$fail(peg$c282); }
             }
           }
         }
@@ -5594,44 +6970,44 @@ function peg$parse(input, options) {
   function peg$parsemin_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 7) === peg$c233) {
-      s0 = peg$c233;
+    if (input.substr(peg$currPos, 7) === peg$c283) {
+      s0 = peg$c283;
       peg$currPos += 7;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c234); }
+      if (peg$silentFails === 0) { peg$fail(peg$c284); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 6) === peg$c235) {
-        s0 = peg$c235;
+      if (input.substr(peg$currPos, 6) === peg$c285) {
+        s0 = peg$c285;
         peg$currPos += 6;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c236); }
+        if (peg$silentFails === 0) { peg$fail(peg$c286); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 4) === peg$c237) {
-          s0 = peg$c237;
+        if (input.substr(peg$currPos, 4) === peg$c287) {
+          s0 = peg$c287;
           peg$currPos += 4;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c238); }
+          if (peg$silentFails === 0) { peg$fail(peg$c288); }
         }
         if (s0 === peg$FAILED) {
-          if (input.substr(peg$currPos, 3) === peg$c117) {
-            s0 = peg$c117;
+          if (input.substr(peg$currPos, 3) === peg$c130) {
+            s0 = peg$c130;
             peg$currPos += 3;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c239); }
+            if (peg$silentFails === 0) { peg$fail(peg$c289); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 109) {
-              s0 = peg$c240;
+              s0 = peg$c290;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c241); }
+              if (peg$silentFails === 0) { peg$fail(peg$c291); }
             }
           }
         }
@@ -5644,44 +7020,44 @@ function peg$parse(input, options) {
   function peg$parsehour_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 5) === peg$c242) {
-      s0 = peg$c242;
+    if (input.substr(peg$currPos, 5) === peg$c292) {
+      s0 = peg$c292;
       peg$currPos += 5;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c243); }
+      if (peg$silentFails === 0) { peg$fail(peg$c293); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 3) === peg$c244) {
-        s0 = peg$c244;
+      if (input.substr(peg$currPos, 3) === peg$c294) {
+        s0 = peg$c294;
         peg$currPos += 3;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c245); }
+        if (peg$silentFails === 0) { peg$fail(peg$c295); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 2) === peg$c246) {
-          s0 = peg$c246;
+        if (input.substr(peg$currPos, 2) === peg$c296) {
+          s0 = peg$c296;
           peg$currPos += 2;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c247); }
+          if (peg$silentFails === 0) { peg$fail(peg$c297); }
         }
         if (s0 === peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 104) {
-            s0 = peg$c248;
+            s0 = peg$c298;
             peg$currPos++;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c249); }
+            if (peg$silentFails === 0) { peg$fail(peg$c299); }
           }
           if (s0 === peg$FAILED) {
-            if (input.substr(peg$currPos, 4) === peg$c250) {
-              s0 = peg$c250;
+            if (input.substr(peg$currPos, 4) === peg$c300) {
+              s0 = peg$c300;
               peg$currPos += 4;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c251); }
+              if (peg$silentFails === 0) { peg$fail(peg$c301); }
             }
           }
         }
@@ -5694,28 +7070,28 @@ function peg$parse(input, options) {
   function peg$parseday_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 4) === peg$c252) {
-      s0 = peg$c252;
+    if (input.substr(peg$currPos, 4) === peg$c302) {
+      s0 = peg$c302;
       peg$currPos += 4;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c253); }
+      if (peg$silentFails === 0) { peg$fail(peg$c303); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 3) === peg$c254) {
-        s0 = peg$c254;
+      if (input.substr(peg$currPos, 3) === peg$c304) {
+        s0 = peg$c304;
         peg$currPos += 3;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c255); }
+        if (peg$silentFails === 0) { peg$fail(peg$c305); }
       }
       if (s0 === peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 100) {
-          s0 = peg$c256;
+          s0 = peg$c306;
           peg$currPos++;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c257); }
+          if (peg$silentFails === 0) { peg$fail(peg$c307); }
         }
       }
     }
@@ -5726,44 +7102,44 @@ function peg$parse(input, options) {
   function peg$parseweek_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 5) === peg$c258) {
-      s0 = peg$c258;
+    if (input.substr(peg$currPos, 5) === peg$c308) {
+      s0 = peg$c308;
       peg$currPos += 5;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c259); }
+      if (peg$silentFails === 0) { peg$fail(peg$c309); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 4) === peg$c260) {
-        s0 = peg$c260;
+      if (input.substr(peg$currPos, 4) === peg$c310) {
+        s0 = peg$c310;
         peg$currPos += 4;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c261); }
+        if (peg$silentFails === 0) { peg$fail(peg$c311); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 3) === peg$c262) {
-          s0 = peg$c262;
+        if (input.substr(peg$currPos, 3) === peg$c312) {
+          s0 = peg$c312;
           peg$currPos += 3;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c263); }
+          if (peg$silentFails === 0) { peg$fail(peg$c313); }
         }
         if (s0 === peg$FAILED) {
-          if (input.substr(peg$currPos, 2) === peg$c264) {
-            s0 = peg$c264;
+          if (input.substr(peg$currPos, 2) === peg$c314) {
+            s0 = peg$c314;
             peg$currPos += 2;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c265); }
+            if (peg$silentFails === 0) { peg$fail(peg$c315); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 119) {
-              s0 = peg$c266;
+              s0 = peg$c316;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c267); }
+              if (peg$silentFails === 0) { peg$fail(peg$c317); }
             }
           }
         }
@@ -5777,16 +7153,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 6) === peg$c225) {
-      s1 = peg$c225;
+    if (input.substr(peg$currPos, 6) === peg$c275) {
+      s1 = peg$c275;
       peg$currPos += 6;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c226); }
+      if (peg$silentFails === 0) { peg$fail(peg$c276); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c268();
+      s1 = peg$c318();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -5801,7 +7177,7 @@ function peg$parse(input, options) {
           s3 = peg$parsesec_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c269(s1);
+            s1 = peg$c319(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -5824,16 +7200,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 6) === peg$c235) {
-      s1 = peg$c235;
+    if (input.substr(peg$currPos, 6) === peg$c285) {
+      s1 = peg$c285;
       peg$currPos += 6;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c236); }
+      if (peg$silentFails === 0) { peg$fail(peg$c286); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c270();
+      s1 = peg$c320();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -5848,7 +7224,7 @@ function peg$parse(input, options) {
           s3 = peg$parsemin_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c271(s1);
+            s1 = peg$c321(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -5871,16 +7247,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4) === peg$c250) {
-      s1 = peg$c250;
+    if (input.substr(peg$currPos, 4) === peg$c300) {
+      s1 = peg$c300;
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c251); }
+      if (peg$silentFails === 0) { peg$fail(peg$c301); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c272();
+      s1 = peg$c322();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -5895,7 +7271,7 @@ function peg$parse(input, options) {
           s3 = peg$parsehour_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c273(s1);
+            s1 = peg$c323(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -5918,16 +7294,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3) === peg$c254) {
-      s1 = peg$c254;
+    if (input.substr(peg$currPos, 3) === peg$c304) {
+      s1 = peg$c304;
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c255); }
+      if (peg$silentFails === 0) { peg$fail(peg$c305); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c274();
+      s1 = peg$c324();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -5942,7 +7318,7 @@ function peg$parse(input, options) {
           s3 = peg$parseday_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c275(s1);
+            s1 = peg$c325(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -5975,7 +7351,7 @@ function peg$parse(input, options) {
         s3 = peg$parseweek_abbrev();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c276(s1);
+          s1 = peg$c326(s1);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -6001,31 +7377,31 @@ function peg$parse(input, options) {
     s2 = peg$parseunsignedInteger();
     if (s2 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 46) {
-        s3 = peg$c77;
+        s3 = peg$c89;
         peg$currPos++;
       } else {
         s3 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c78); }
+        if (peg$silentFails === 0) { peg$fail(peg$c90); }
       }
       if (s3 !== peg$FAILED) {
         s4 = peg$parseunsignedInteger();
         if (s4 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 46) {
-            s5 = peg$c77;
+            s5 = peg$c89;
             peg$currPos++;
           } else {
             s5 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c78); }
+            if (peg$silentFails === 0) { peg$fail(peg$c90); }
           }
           if (s5 !== peg$FAILED) {
             s6 = peg$parseunsignedInteger();
             if (s6 !== peg$FAILED) {
               if (input.charCodeAt(peg$currPos) === 46) {
-                s7 = peg$c77;
+                s7 = peg$c89;
                 peg$currPos++;
               } else {
                 s7 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c78); }
+                if (peg$silentFails === 0) { peg$fail(peg$c90); }
               }
               if (s7 !== peg$FAILED) {
                 s8 = peg$parseunsignedInteger();
@@ -6062,7 +7438,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c277(s1);
+      s1 = peg$c327(s1);
     }
     s0 = s1;
 
@@ -6074,17 +7450,17 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 58) {
-      s1 = peg$c192;
+      s1 = peg$c243;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c193); }
+      if (peg$silentFails === 0) { peg$fail(peg$c244); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsesuint();
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c35(s2);
+        s1 = peg$c38(s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6116,7 +7492,7 @@ function peg$parse(input, options) {
       s2 = peg$parseip6tail();
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c278(s1, s2);
+        s1 = peg$c328(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6137,12 +7513,12 @@ function peg$parse(input, options) {
           s3 = peg$parseh_append();
         }
         if (s2 !== peg$FAILED) {
-          if (input.substr(peg$currPos, 2) === peg$c279) {
-            s3 = peg$c279;
+          if (input.substr(peg$currPos, 2) === peg$c329) {
+            s3 = peg$c329;
             peg$currPos += 2;
           } else {
             s3 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c280); }
+            if (peg$silentFails === 0) { peg$fail(peg$c330); }
           }
           if (s3 !== peg$FAILED) {
             s4 = [];
@@ -6155,7 +7531,7 @@ function peg$parse(input, options) {
               s5 = peg$parseip6tail();
               if (s5 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c281(s1, s2, s4, s5);
+                s1 = peg$c331(s1, s2, s4, s5);
                 s0 = s1;
               } else {
                 peg$currPos = s0;
@@ -6179,12 +7555,12 @@ function peg$parse(input, options) {
       }
       if (s0 === peg$FAILED) {
         s0 = peg$currPos;
-        if (input.substr(peg$currPos, 2) === peg$c279) {
-          s1 = peg$c279;
+        if (input.substr(peg$currPos, 2) === peg$c329) {
+          s1 = peg$c329;
           peg$currPos += 2;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c280); }
+          if (peg$silentFails === 0) { peg$fail(peg$c330); }
         }
         if (s1 !== peg$FAILED) {
           s2 = [];
@@ -6197,7 +7573,7 @@ function peg$parse(input, options) {
             s3 = peg$parseip6tail();
             if (s3 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c282(s2, s3);
+              s1 = peg$c332(s2, s3);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -6222,16 +7598,16 @@ function peg$parse(input, options) {
               s3 = peg$parseh_append();
             }
             if (s2 !== peg$FAILED) {
-              if (input.substr(peg$currPos, 2) === peg$c279) {
-                s3 = peg$c279;
+              if (input.substr(peg$currPos, 2) === peg$c329) {
+                s3 = peg$c329;
                 peg$currPos += 2;
               } else {
                 s3 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c280); }
+                if (peg$silentFails === 0) { peg$fail(peg$c330); }
               }
               if (s3 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c283(s1, s2);
+                s1 = peg$c333(s1, s2);
                 s0 = s1;
               } else {
                 peg$currPos = s0;
@@ -6247,16 +7623,16 @@ function peg$parse(input, options) {
           }
           if (s0 === peg$FAILED) {
             s0 = peg$currPos;
-            if (input.substr(peg$currPos, 2) === peg$c279) {
-              s1 = peg$c279;
+            if (input.substr(peg$currPos, 2) === peg$c329) {
+              s1 = peg$c329;
               peg$currPos += 2;
             } else {
               s1 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c280); }
+              if (peg$silentFails === 0) { peg$fail(peg$c330); }
             }
             if (s1 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c284();
+              s1 = peg$c334();
             }
             s0 = s1;
           }
@@ -6283,17 +7659,17 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 58) {
-      s1 = peg$c192;
+      s1 = peg$c243;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c193); }
+      if (peg$silentFails === 0) { peg$fail(peg$c244); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parseh16();
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c285(s2);
+        s1 = peg$c335(s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6314,15 +7690,15 @@ function peg$parse(input, options) {
     s1 = peg$parseh16();
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 58) {
-        s2 = peg$c192;
+        s2 = peg$c243;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c193); }
+        if (peg$silentFails === 0) { peg$fail(peg$c244); }
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c286(s1);
+        s1 = peg$c336(s1);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6346,21 +7722,21 @@ function peg$parse(input, options) {
       s2 = peg$parseunsignedInteger();
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 46) {
-          s3 = peg$c77;
+          s3 = peg$c89;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c78); }
+          if (peg$silentFails === 0) { peg$fail(peg$c90); }
         }
         if (s3 !== peg$FAILED) {
           s4 = peg$parseunsignedInteger();
           if (s4 !== peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 46) {
-              s5 = peg$c77;
+              s5 = peg$c89;
               peg$currPos++;
             } else {
               s5 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c78); }
+              if (peg$silentFails === 0) { peg$fail(peg$c90); }
             }
             if (s5 !== peg$FAILED) {
               s6 = peg$parseunsignedInteger();
@@ -6389,7 +7765,7 @@ function peg$parse(input, options) {
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c287(s1);
+        s1 = peg$c337(s1);
       }
       s0 = s1;
       if (s0 === peg$FAILED) {
@@ -6398,11 +7774,11 @@ function peg$parse(input, options) {
         s2 = peg$parseunsignedInteger();
         if (s2 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 46) {
-            s3 = peg$c77;
+            s3 = peg$c89;
             peg$currPos++;
           } else {
             s3 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c78); }
+            if (peg$silentFails === 0) { peg$fail(peg$c90); }
           }
           if (s3 !== peg$FAILED) {
             s4 = peg$parseunsignedInteger();
@@ -6423,7 +7799,7 @@ function peg$parse(input, options) {
         }
         if (s1 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c288(s1);
+          s1 = peg$c338(s1);
         }
         s0 = s1;
         if (s0 === peg$FAILED) {
@@ -6431,7 +7807,7 @@ function peg$parse(input, options) {
           s1 = peg$parseunsignedInteger();
           if (s1 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c289(s1);
+            s1 = peg$c339(s1);
           }
           s0 = s1;
         }
@@ -6448,17 +7824,17 @@ function peg$parse(input, options) {
     s1 = peg$parsesub_addr();
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 47) {
-        s2 = peg$c209;
+        s2 = peg$c260;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c210); }
+        if (peg$silentFails === 0) { peg$fail(peg$c261); }
       }
       if (s2 !== peg$FAILED) {
         s3 = peg$parseunsignedInteger();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c290(s1, s3);
+          s1 = peg$c340(s1, s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -6483,17 +7859,17 @@ function peg$parse(input, options) {
     s1 = peg$parseip6addr();
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 47) {
-        s2 = peg$c209;
+        s2 = peg$c260;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c210); }
+        if (peg$silentFails === 0) { peg$fail(peg$c261); }
       }
       if (s2 !== peg$FAILED) {
         s3 = peg$parseunsignedInteger();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c291(s1, s3);
+          s1 = peg$c340(s1, s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -6518,7 +7894,7 @@ function peg$parse(input, options) {
     s1 = peg$parsesuint();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c292(s1);
+      s1 = peg$c341(s1);
     }
     s0 = s1;
 
@@ -6530,22 +7906,22 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     s1 = [];
-    if (peg$c75.test(input.charAt(peg$currPos))) {
+    if (peg$c87.test(input.charAt(peg$currPos))) {
       s2 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s2 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c76); }
+      if (peg$silentFails === 0) { peg$fail(peg$c88); }
     }
     if (s2 !== peg$FAILED) {
       while (s2 !== peg$FAILED) {
         s1.push(s2);
-        if (peg$c75.test(input.charAt(peg$currPos))) {
+        if (peg$c87.test(input.charAt(peg$currPos))) {
           s2 = input.charAt(peg$currPos);
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c76); }
+          if (peg$silentFails === 0) { peg$fail(peg$c88); }
         }
       }
     } else {
@@ -6553,7 +7929,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c66();
+      s1 = peg$c78();
     }
     s0 = s1;
 
@@ -6567,7 +7943,7 @@ function peg$parse(input, options) {
     s1 = peg$parsesinteger();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c292(s1);
+      s1 = peg$c341(s1);
     }
     s0 = s1;
 
@@ -6578,12 +7954,12 @@ function peg$parse(input, options) {
     var s0, s1, s2;
 
     s0 = peg$currPos;
-    if (peg$c293.test(input.charAt(peg$currPos))) {
+    if (peg$c342.test(input.charAt(peg$currPos))) {
       s1 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c294); }
+      if (peg$silentFails === 0) { peg$fail(peg$c343); }
     }
     if (s1 === peg$FAILED) {
       s1 = null;
@@ -6592,7 +7968,7 @@ function peg$parse(input, options) {
       s2 = peg$parsesuint();
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c66();
+        s1 = peg$c78();
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6613,7 +7989,7 @@ function peg$parse(input, options) {
     s1 = peg$parsesdouble();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c295(s1);
+      s1 = peg$c344(s1);
     }
     s0 = s1;
 
@@ -6647,11 +8023,11 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 46) {
-          s3 = peg$c77;
+          s3 = peg$c89;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c78); }
+          if (peg$silentFails === 0) { peg$fail(peg$c90); }
         }
         if (s3 !== peg$FAILED) {
           s4 = [];
@@ -6671,7 +8047,7 @@ function peg$parse(input, options) {
             }
             if (s5 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c296();
+              s1 = peg$c345();
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -6707,11 +8083,11 @@ function peg$parse(input, options) {
       }
       if (s1 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 46) {
-          s2 = peg$c77;
+          s2 = peg$c89;
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c78); }
+          if (peg$silentFails === 0) { peg$fail(peg$c90); }
         }
         if (s2 !== peg$FAILED) {
           s3 = [];
@@ -6731,7 +8107,7 @@ function peg$parse(input, options) {
             }
             if (s4 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c296();
+              s1 = peg$c345();
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -6758,38 +8134,38 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     if (input.charCodeAt(peg$currPos) === 48) {
-      s0 = peg$c297;
+      s0 = peg$c346;
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c298); }
+      if (peg$silentFails === 0) { peg$fail(peg$c347); }
     }
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (peg$c299.test(input.charAt(peg$currPos))) {
+      if (peg$c348.test(input.charAt(peg$currPos))) {
         s1 = input.charAt(peg$currPos);
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c300); }
+        if (peg$silentFails === 0) { peg$fail(peg$c349); }
       }
       if (s1 !== peg$FAILED) {
         s2 = [];
-        if (peg$c75.test(input.charAt(peg$currPos))) {
+        if (peg$c87.test(input.charAt(peg$currPos))) {
           s3 = input.charAt(peg$currPos);
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c76); }
+          if (peg$silentFails === 0) { peg$fail(peg$c88); }
         }
         while (s3 !== peg$FAILED) {
           s2.push(s3);
-          if (peg$c75.test(input.charAt(peg$currPos))) {
+          if (peg$c87.test(input.charAt(peg$currPos))) {
             s3 = input.charAt(peg$currPos);
             peg$currPos++;
           } else {
             s3 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c76); }
+            if (peg$silentFails === 0) { peg$fail(peg$c88); }
           }
         }
         if (s2 !== peg$FAILED) {
@@ -6811,12 +8187,12 @@ function peg$parse(input, options) {
   function peg$parsedoubleDigit() {
     var s0;
 
-    if (peg$c75.test(input.charAt(peg$currPos))) {
+    if (peg$c87.test(input.charAt(peg$currPos))) {
       s0 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c76); }
+      if (peg$silentFails === 0) { peg$fail(peg$c88); }
     }
 
     return s0;
@@ -6826,12 +8202,12 @@ function peg$parse(input, options) {
     var s0, s1, s2;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 1).toLowerCase() === peg$c301) {
+    if (input.substr(peg$currPos, 1).toLowerCase() === peg$c350) {
       s1 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c302); }
+      if (peg$silentFails === 0) { peg$fail(peg$c351); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsesinteger();
@@ -6866,7 +8242,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c303(s1);
+      s1 = peg$c352(s1);
     }
     s0 = s1;
 
@@ -6876,12 +8252,12 @@ function peg$parse(input, options) {
   function peg$parsehexdigit() {
     var s0;
 
-    if (peg$c304.test(input.charAt(peg$currPos))) {
+    if (peg$c353.test(input.charAt(peg$currPos))) {
       s0 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c305); }
+      if (peg$silentFails === 0) { peg$fail(peg$c354); }
     }
 
     return s0;
@@ -6903,7 +8279,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c306(s1);
+      s1 = peg$c355(s1);
     }
     s0 = s1;
 
@@ -6915,11 +8291,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 92) {
-      s1 = peg$c307;
+      s1 = peg$c356;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c308); }
+      if (peg$silentFails === 0) { peg$fail(peg$c357); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parseescapeSequence();
@@ -6942,12 +8318,12 @@ function peg$parse(input, options) {
       s0 = peg$currPos;
       s1 = peg$currPos;
       peg$silentFails++;
-      if (peg$c309.test(input.charAt(peg$currPos))) {
+      if (peg$c358.test(input.charAt(peg$currPos))) {
         s2 = input.charAt(peg$currPos);
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c310); }
+        if (peg$silentFails === 0) { peg$fail(peg$c359); }
       }
       if (s2 === peg$FAILED) {
         s2 = peg$parsews();
@@ -6965,11 +8341,11 @@ function peg$parse(input, options) {
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c311); }
+          if (peg$silentFails === 0) { peg$fail(peg$c360); }
         }
         if (s2 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c66();
+          s1 = peg$c78();
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -6989,11 +8365,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 34) {
-      s1 = peg$c312;
+      s1 = peg$c361;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c313); }
+      if (peg$silentFails === 0) { peg$fail(peg$c362); }
     }
     if (s1 !== peg$FAILED) {
       s2 = [];
@@ -7004,15 +8380,15 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 34) {
-          s3 = peg$c312;
+          s3 = peg$c361;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c313); }
+          if (peg$silentFails === 0) { peg$fail(peg$c362); }
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c314(s2);
+          s1 = peg$c363(s2);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -7029,11 +8405,11 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 39) {
-        s1 = peg$c315;
+        s1 = peg$c364;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c316); }
+        if (peg$silentFails === 0) { peg$fail(peg$c365); }
       }
       if (s1 !== peg$FAILED) {
         s2 = [];
@@ -7044,15 +8420,15 @@ function peg$parse(input, options) {
         }
         if (s2 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 39) {
-            s3 = peg$c315;
+            s3 = peg$c364;
             peg$currPos++;
           } else {
             s3 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c316); }
+            if (peg$silentFails === 0) { peg$fail(peg$c365); }
           }
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c314(s2);
+            s1 = peg$c363(s2);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -7078,11 +8454,11 @@ function peg$parse(input, options) {
     s1 = peg$currPos;
     peg$silentFails++;
     if (input.charCodeAt(peg$currPos) === 34) {
-      s2 = peg$c312;
+      s2 = peg$c361;
       peg$currPos++;
     } else {
       s2 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c313); }
+      if (peg$silentFails === 0) { peg$fail(peg$c362); }
     }
     if (s2 === peg$FAILED) {
       s2 = peg$parseescapedChar();
@@ -7100,11 +8476,11 @@ function peg$parse(input, options) {
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c311); }
+        if (peg$silentFails === 0) { peg$fail(peg$c360); }
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c66();
+        s1 = peg$c78();
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -7117,11 +8493,11 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 92) {
-        s1 = peg$c307;
+        s1 = peg$c356;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c308); }
+        if (peg$silentFails === 0) { peg$fail(peg$c357); }
       }
       if (s1 !== peg$FAILED) {
         s2 = peg$parseescapeSequence();
@@ -7149,11 +8525,11 @@ function peg$parse(input, options) {
     s1 = peg$currPos;
     peg$silentFails++;
     if (input.charCodeAt(peg$currPos) === 39) {
-      s2 = peg$c315;
+      s2 = peg$c364;
       peg$currPos++;
     } else {
       s2 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c316); }
+      if (peg$silentFails === 0) { peg$fail(peg$c365); }
     }
     if (s2 === peg$FAILED) {
       s2 = peg$parseescapedChar();
@@ -7171,11 +8547,11 @@ function peg$parse(input, options) {
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c311); }
+        if (peg$silentFails === 0) { peg$fail(peg$c360); }
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c66();
+        s1 = peg$c78();
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -7188,11 +8564,11 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 92) {
-        s1 = peg$c307;
+        s1 = peg$c356;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c308); }
+        if (peg$silentFails === 0) { peg$fail(peg$c357); }
       }
       if (s1 !== peg$FAILED) {
         s2 = peg$parseescapeSequence();
@@ -7218,11 +8594,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 120) {
-      s1 = peg$c317;
+      s1 = peg$c366;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c318); }
+      if (peg$silentFails === 0) { peg$fail(peg$c367); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsehexdigit();
@@ -7230,7 +8606,7 @@ function peg$parse(input, options) {
         s3 = peg$parsehexdigit();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c319();
+          s1 = peg$c368();
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -7258,110 +8634,110 @@ function peg$parse(input, options) {
     var s0, s1;
 
     if (input.charCodeAt(peg$currPos) === 39) {
-      s0 = peg$c315;
+      s0 = peg$c364;
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c316); }
+      if (peg$silentFails === 0) { peg$fail(peg$c365); }
     }
     if (s0 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 34) {
-        s0 = peg$c312;
+        s0 = peg$c361;
         peg$currPos++;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c313); }
+        if (peg$silentFails === 0) { peg$fail(peg$c362); }
       }
       if (s0 === peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 92) {
-          s0 = peg$c307;
+          s0 = peg$c356;
           peg$currPos++;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c308); }
+          if (peg$silentFails === 0) { peg$fail(peg$c357); }
         }
         if (s0 === peg$FAILED) {
           s0 = peg$currPos;
           if (input.charCodeAt(peg$currPos) === 98) {
-            s1 = peg$c320;
+            s1 = peg$c369;
             peg$currPos++;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c321); }
+            if (peg$silentFails === 0) { peg$fail(peg$c370); }
           }
           if (s1 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c322();
+            s1 = peg$c371();
           }
           s0 = s1;
           if (s0 === peg$FAILED) {
             s0 = peg$currPos;
             if (input.charCodeAt(peg$currPos) === 102) {
-              s1 = peg$c323;
+              s1 = peg$c372;
               peg$currPos++;
             } else {
               s1 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c324); }
+              if (peg$silentFails === 0) { peg$fail(peg$c373); }
             }
             if (s1 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c325();
+              s1 = peg$c374();
             }
             s0 = s1;
             if (s0 === peg$FAILED) {
               s0 = peg$currPos;
               if (input.charCodeAt(peg$currPos) === 110) {
-                s1 = peg$c326;
+                s1 = peg$c375;
                 peg$currPos++;
               } else {
                 s1 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c327); }
+                if (peg$silentFails === 0) { peg$fail(peg$c376); }
               }
               if (s1 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c328();
+                s1 = peg$c377();
               }
               s0 = s1;
               if (s0 === peg$FAILED) {
                 s0 = peg$currPos;
                 if (input.charCodeAt(peg$currPos) === 114) {
-                  s1 = peg$c329;
+                  s1 = peg$c378;
                   peg$currPos++;
                 } else {
                   s1 = peg$FAILED;
-                  if (peg$silentFails === 0) { peg$fail(peg$c330); }
+                  if (peg$silentFails === 0) { peg$fail(peg$c379); }
                 }
                 if (s1 !== peg$FAILED) {
                   peg$savedPos = s0;
-                  s1 = peg$c331();
+                  s1 = peg$c380();
                 }
                 s0 = s1;
                 if (s0 === peg$FAILED) {
                   s0 = peg$currPos;
                   if (input.charCodeAt(peg$currPos) === 116) {
-                    s1 = peg$c332;
+                    s1 = peg$c381;
                     peg$currPos++;
                   } else {
                     s1 = peg$FAILED;
-                    if (peg$silentFails === 0) { peg$fail(peg$c333); }
+                    if (peg$silentFails === 0) { peg$fail(peg$c382); }
                   }
                   if (s1 !== peg$FAILED) {
                     peg$savedPos = s0;
-                    s1 = peg$c334();
+                    s1 = peg$c383();
                   }
                   s0 = s1;
                   if (s0 === peg$FAILED) {
                     s0 = peg$currPos;
                     if (input.charCodeAt(peg$currPos) === 118) {
-                      s1 = peg$c335;
+                      s1 = peg$c384;
                       peg$currPos++;
                     } else {
                       s1 = peg$FAILED;
-                      if (peg$silentFails === 0) { peg$fail(peg$c336); }
+                      if (peg$silentFails === 0) { peg$fail(peg$c385); }
                     }
                     if (s1 !== peg$FAILED) {
                       peg$savedPos = s0;
-                      s1 = peg$c337();
+                      s1 = peg$c386();
                     }
                     s0 = s1;
                   }
@@ -7381,15 +8757,15 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 61) {
-      s1 = peg$c138;
+      s1 = peg$c65;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c139); }
+      if (peg$silentFails === 0) { peg$fail(peg$c66); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c338();
+      s1 = peg$c387();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -7403,7 +8779,7 @@ function peg$parse(input, options) {
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c339();
+        s1 = peg$c388();
       }
       s0 = s1;
     }
@@ -7416,11 +8792,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 117) {
-      s1 = peg$c340;
+      s1 = peg$c389;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c341); }
+      if (peg$silentFails === 0) { peg$fail(peg$c390); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$currPos;
@@ -7452,7 +8828,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c342(s2);
+        s1 = peg$c391(s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -7465,19 +8841,19 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 117) {
-        s1 = peg$c340;
+        s1 = peg$c389;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c341); }
+        if (peg$silentFails === 0) { peg$fail(peg$c390); }
       }
       if (s1 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 123) {
-          s2 = peg$c343;
+          s2 = peg$c392;
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c344); }
+          if (peg$silentFails === 0) { peg$fail(peg$c393); }
         }
         if (s2 !== peg$FAILED) {
           s3 = peg$currPos;
@@ -7536,15 +8912,15 @@ function peg$parse(input, options) {
           }
           if (s3 !== peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 125) {
-              s4 = peg$c345;
+              s4 = peg$c394;
               peg$currPos++;
             } else {
               s4 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c346); }
+              if (peg$silentFails === 0) { peg$fail(peg$c395); }
             }
             if (s4 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c342(s3);
+              s1 = peg$c391(s3);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -7572,25 +8948,25 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 47) {
-      s1 = peg$c209;
+      s1 = peg$c260;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c210); }
+      if (peg$silentFails === 0) { peg$fail(peg$c261); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsereBody();
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 47) {
-          s3 = peg$c209;
+          s3 = peg$c260;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c210); }
+          if (peg$silentFails === 0) { peg$fail(peg$c261); }
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c35(s2);
+          s1 = peg$c38(s2);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -7613,39 +8989,39 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     s1 = [];
-    if (peg$c347.test(input.charAt(peg$currPos))) {
+    if (peg$c396.test(input.charAt(peg$currPos))) {
       s2 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s2 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c348); }
+      if (peg$silentFails === 0) { peg$fail(peg$c397); }
     }
     if (s2 === peg$FAILED) {
-      if (input.substr(peg$currPos, 2) === peg$c349) {
-        s2 = peg$c349;
+      if (input.substr(peg$currPos, 2) === peg$c398) {
+        s2 = peg$c398;
         peg$currPos += 2;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c350); }
+        if (peg$silentFails === 0) { peg$fail(peg$c399); }
       }
     }
     if (s2 !== peg$FAILED) {
       while (s2 !== peg$FAILED) {
         s1.push(s2);
-        if (peg$c347.test(input.charAt(peg$currPos))) {
+        if (peg$c396.test(input.charAt(peg$currPos))) {
           s2 = input.charAt(peg$currPos);
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c348); }
+          if (peg$silentFails === 0) { peg$fail(peg$c397); }
         }
         if (s2 === peg$FAILED) {
-          if (input.substr(peg$currPos, 2) === peg$c349) {
-            s2 = peg$c349;
+          if (input.substr(peg$currPos, 2) === peg$c398) {
+            s2 = peg$c398;
             peg$currPos += 2;
           } else {
             s2 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c350); }
+            if (peg$silentFails === 0) { peg$fail(peg$c399); }
           }
         }
       }
@@ -7654,7 +9030,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c66();
+      s1 = peg$c78();
     }
     s0 = s1;
 
@@ -7664,12 +9040,12 @@ function peg$parse(input, options) {
   function peg$parseescapedChar() {
     var s0;
 
-    if (peg$c351.test(input.charAt(peg$currPos))) {
+    if (peg$c400.test(input.charAt(peg$currPos))) {
       s0 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c352); }
+      if (peg$silentFails === 0) { peg$fail(peg$c401); }
     }
 
     return s0;
@@ -7679,51 +9055,51 @@ function peg$parse(input, options) {
     var s0;
 
     if (input.charCodeAt(peg$currPos) === 9) {
-      s0 = peg$c353;
+      s0 = peg$c402;
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c354); }
+      if (peg$silentFails === 0) { peg$fail(peg$c403); }
     }
     if (s0 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 11) {
-        s0 = peg$c355;
+        s0 = peg$c404;
         peg$currPos++;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c356); }
+        if (peg$silentFails === 0) { peg$fail(peg$c405); }
       }
       if (s0 === peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 12) {
-          s0 = peg$c357;
+          s0 = peg$c406;
           peg$currPos++;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c358); }
+          if (peg$silentFails === 0) { peg$fail(peg$c407); }
         }
         if (s0 === peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 32) {
-            s0 = peg$c359;
+            s0 = peg$c408;
             peg$currPos++;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c360); }
+            if (peg$silentFails === 0) { peg$fail(peg$c409); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 160) {
-              s0 = peg$c361;
+              s0 = peg$c410;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c362); }
+              if (peg$silentFails === 0) { peg$fail(peg$c411); }
             }
             if (s0 === peg$FAILED) {
               if (input.charCodeAt(peg$currPos) === 65279) {
-                s0 = peg$c363;
+                s0 = peg$c412;
                 peg$currPos++;
               } else {
                 s0 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c364); }
+                if (peg$silentFails === 0) { peg$fail(peg$c413); }
               }
             }
           }
@@ -7751,7 +9127,7 @@ function peg$parse(input, options) {
     peg$silentFails--;
     if (s0 === peg$FAILED) {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c365); }
+      if (peg$silentFails === 0) { peg$fail(peg$c414); }
     }
 
     return s0;
@@ -7780,7 +9156,7 @@ function peg$parse(input, options) {
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c311); }
+      if (peg$silentFails === 0) { peg$fail(peg$c360); }
     }
     peg$silentFails--;
     if (s1 === peg$FAILED) {
@@ -7835,10 +9211,18 @@ function peg$parse(input, options) {
     return { op: "CompareField", comparator, field, value };
   }
 
+  function makeCompareBareword(comparator, field, word) {
+    let [value_field, text] = word;
+    let value = makeLiteral("string", text);
+    return { op: "CompareField", comparator, field, value, value_field };
+  }
+
   function makeCompareAny(comparator, recursive, value) {
     return { op: "CompareAny", comparator, recursive, value };
   }
 
+  function makeEvaluate(expr) { return { op: "Evaluate", expr }; }
+
   function makeLogicalNot(expr) { return { op: "LogicalNot", expr }; }
 
   function makeChain(first, rest, op) {
@@ -7886,14 +9270,36 @@ function peg$parse(input, options) {
   }
 
   function makeCutProc(fields) { return { op: "CutProc", fields }; }
+  function makeDropProc(fields) { return { op: "DropProc", fields }; }
+  function makeFieldAssignment(target, source) { return { target, source }; }
+  function makeRenameProc(fields) { return { op: "RenameProc", fields }; }
   function makeHeadProc(count) { return { op: "HeadProc", count }; }
   function makeTailProc(count) { return { op: "TailProc", count }; }
   function makeUniqProc(cflag) { return { op: "TailProc", cflag }; }
   function makeFilterProc(filter) { return { op: "FilterProc", filter }; }
   function makePutProc(target, expression) { return { op: "PutProc", target, expression }; }
+  function makeJoinProc(kind, left_key, right_key, fields) {
+    if (kind === null) { kind = "inner"; }
+    if (fields === null) { fields = undefined; }
+    return { op: "JoinProc", kind, left_key, right_key, fields };
+  }
+  function makeExpressionAssignment(target, expression) {
+    if (target === null) { target = undefined; }
+    return { target, expression };
+  }
   function makeReducer(op, var_, field) {
-    if (field === null) { field = undefined; }
-    return { op, var: var_, field };
+    if (field === null) {
+      return { op, var: var_ };
+    }
+    if (field.op === "FieldRead" || field.op === "FieldCall") {
+      return { op, var: var_, field };
+    }
+    return { op, var: var_, expr: field };
+  }
+  function makeParamReducer(op, var_, field, param) {
+    let reducer = makeReducer(op, var_, field);
+    reducer.param = param;
+    return reducer;
   }
   function overrideReducerVar(reducer, v) {
     reducer.var = v;
@@ -2,6 +2,7 @@ package zql
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"os"
 	"testing"
@@ -22,6 +23,59 @@ func TestValid(t *testing.T) {
 	}
 }
 
+// TestFormat checks that the ZQL source of each valid query formatted by
+// ast.FormatProc parses to the same AST.
+func TestFormat(t *testing.T) {
+	file, err := os.Open("valid.zql")
+	require.NoError(t, err)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		expected, err := ParseProc(line)
+		require.NoError(t, err, "zql: %q", line)
+		formatted := ast.FormatProc(expected)
+		actual, err := ParseProc(formatted)
+		require.NoError(t, err, "zql: %q formatted as %q", line, formatted)
+		expectedJSON, err := json.Marshal(expected)
+		require.NoError(t, err)
+		actualJSON, err := json.Marshal(actual)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expectedJSON), string(actualJSON), "zql: %q formatted as %q", line, formatted)
+	}
+}
+
+// TestUnpack checks that the JSON of the AST of each valid query unpacks
+// to the same AST.
+func TestUnpack(t *testing.T) {
+	file, err := os.Open("valid.zql")
+	require.NoError(t, err)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		expected, err := ParseProc(line)
+		require.NoError(t, err, "zql: %q", line)
+		expectedJSON, err := json.Marshal(expected)
+		require.NoError(t, err)
+		actual, err := ast.UnpackProc(nil, expectedJSON)
+		require.NoError(t, err, "zql: %q", line)
+		actualJSON, err := json.Marshal(actual)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expectedJSON), string(actualJSON), "zql: %q", line)
+	}
+}
+
+// TestUnpackFieldKeys checks that groupby keys in the format from before
+// keys could be expressions unpack as expressions.
+func TestUnpackFieldKeys(t *testing.T) {
+	const old = `{"op":"GroupByProc","keys":[{"op":"FieldRead","field":"_path"},{"op":"FieldCall","fn":"RecordFieldRead","field":{"op":"FieldRead","field":"id"},"param":"resp_h"}],"reducers":[{"op":"Count","var":"count"}]}`
+	proc, err := ParseProc("count() by _path, id.resp_h")
+	require.NoError(t, err)
+	expected := proc.(*ast.SequentialProc).Procs[1]
+	actual, err := ast.UnpackProc(nil, []byte(old))
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
 func TestInvalid(t *testing.T) {
 	file, err := os.Open("invalid.zql")
 	require.NoError(t, err)
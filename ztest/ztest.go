@@ -15,8 +15,8 @@
 //      0:[2;]
 //
 // Input format is detected automatically and can be anything recognized by
-// "zq -i auto" (including optional gzip compression).  Output format defaults
-// to zng but can be set to anything accepted by "zq -f".
+// "zq -i auto" (including optional gzip, bzip2, or zstd compression).  Output
+// format defaults to zng but can be set to anything accepted by "zq -f".
 //
 //    zql: count()
 //
@@ -273,9 +273,10 @@ func FromYAMLFile(filename string) (*ZTest, error) {
 
 // Run runs the query in ZQL over inputs and returns the output formatted
 // according to outputFormat. inputs may be in any format recognized by "zq -i
-// auto" and maybe be gzip-compressed.  outputFormat may be any string accepted
-// by "zq -f".  If zq is empty, the query runs in the current process.  If zq is
-// not empty, it specifies a zq executable that will be used to run the query.
+// auto" and maybe be gzip-, bzip2-, or zstd-compressed.  outputFormat may be
+// any string accepted by "zq -f".  If zq is empty, the query runs in the
+// current process.  If zq is not empty, it specifies a zq executable that will
+// be used to run the query.
 func run(zq, ZQL, outputFormat, outputFlags string, inputs ...string) (out string, warnOrError string, err error) {
 	var outbuf bytes.Buffer
 	var errbuf bytes.Buffer
@@ -344,11 +345,12 @@ func run(zq, ZQL, outputFormat, outputFlags string, inputs ...string) (out strin
 func loadInputs(inputs []string, zctx *resolver.Context) (*scanner.Combiner, error) {
 	var readers []zbuf.Reader
 	for _, input := range inputs {
-		zr, err := detector.NewReader(detector.GzipReader(strings.NewReader(input)), zctx)
+		r := detector.DecompressReader(strings.NewReader(input))
+		zr, err := detector.NewReader(r, zctx)
 		if err != nil {
 			return nil, err
 		}
-		readers = append(readers, zr)
+		readers = append(readers, zbuf.NewReadCloser(zr, r))
 	}
 	return scanner.NewCombiner(readers), nil
 }